@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/AINative-studio/ainative-code/internal/cache"
+	"github.com/AINative-studio/ainative-code/internal/provider"
+	"github.com/AINative-studio/ainative-code/internal/provider/anthropic"
+)
+
+func main() {
+	// Get API key from environment
+	apiKey := os.Getenv("ANTHROPIC_API_KEY")
+	if apiKey == "" {
+		log.Fatal("ANTHROPIC_API_KEY environment variable is required")
+	}
+
+	// Create Anthropic provider with prompt caching enabled
+	config := anthropic.Config{
+		APIKey: apiKey,
+		Cache:  cache.NewManager(cache.DefaultConfig()),
+	}
+
+	anthropicProvider, err := anthropic.NewAnthropicProvider(config)
+	if err != nil {
+		log.Fatalf("Failed to create provider: %v", err)
+	}
+	defer anthropicProvider.Close()
+
+	fmt.Println("=== Anthropic Provider Examples ===")
+
+	// Example 1: Simple chat
+	example1SimpleChat(anthropicProvider)
+
+	// Example 2: Streaming chat
+	example2StreamingChat(anthropicProvider)
+
+	// Example 3: Multi-turn conversation
+	example3MultiTurn(anthropicProvider)
+
+	// Example 4: With system prompt
+	example4SystemPrompt(anthropicProvider)
+
+	// Example 5: Extended thinking
+	example5ExtendedThinking(anthropicProvider)
+}
+
+func example1SimpleChat(p *anthropic.AnthropicProvider) {
+	fmt.Println("Example 1: Simple Chat Request")
+	fmt.Println("-------------------------------")
+
+	ctx := context.Background()
+	messages := []provider.Message{
+		{Role: "user", Content: "What are the three laws of robotics?"},
+	}
+
+	response, err := p.Chat(ctx, messages,
+		provider.WithModel("claude-sonnet-4-5"),
+		provider.WithMaxTokens(200),
+		provider.WithTemperature(0.7),
+	)
+
+	if err != nil {
+		log.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Response: %s\n", response.Content)
+	fmt.Printf("Tokens: %d prompt + %d completion = %d total\n\n",
+		response.Usage.PromptTokens,
+		response.Usage.CompletionTokens,
+		response.Usage.TotalTokens)
+}
+
+func example2StreamingChat(p *anthropic.AnthropicProvider) {
+	fmt.Println("Example 2: Streaming Chat")
+	fmt.Println("-------------------------")
+
+	ctx := context.Background()
+	messages := []provider.Message{
+		{Role: "user", Content: "Write a haiku about artificial intelligence"},
+	}
+
+	eventChan, err := p.Stream(ctx, messages,
+		provider.StreamWithModel("claude-sonnet-4-5"),
+		provider.StreamWithTemperature(0.8),
+	)
+
+	if err != nil {
+		log.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Print("Response: ")
+	for event := range eventChan {
+		switch event.Type {
+		case provider.EventTypeContentDelta:
+			fmt.Print(event.Content)
+		case provider.EventTypeContentEnd:
+			fmt.Println()
+		case provider.EventTypeError:
+			log.Printf("Stream error: %v\n", event.Error)
+		}
+	}
+	fmt.Println()
+}
+
+func example3MultiTurn(p *anthropic.AnthropicProvider) {
+	fmt.Println("Example 3: Multi-Turn Conversation")
+	fmt.Println("----------------------------------")
+
+	ctx := context.Background()
+	messages := []provider.Message{
+		{Role: "user", Content: "What is the capital of France?"},
+		{Role: "assistant", Content: "The capital of France is Paris."},
+		{Role: "user", Content: "What is the population of that city?"},
+	}
+
+	response, err := p.Chat(ctx, messages,
+		provider.WithModel("claude-sonnet-4-5"),
+		provider.WithMaxTokens(150),
+	)
+
+	if err != nil {
+		log.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Response: %s\n\n", response.Content)
+}
+
+func example4SystemPrompt(p *anthropic.AnthropicProvider) {
+	fmt.Println("Example 4: Using System Prompt")
+	fmt.Println("------------------------------")
+
+	ctx := context.Background()
+	messages := []provider.Message{
+		{Role: "user", Content: "Explain quantum entanglement"},
+	}
+
+	response, err := p.Chat(ctx, messages,
+		provider.WithModel("claude-sonnet-4-5"),
+		provider.WithSystemPrompt("You are a physics teacher explaining concepts to high school students. Use simple analogies and avoid jargon."),
+		provider.WithMaxTokens(200),
+		provider.WithTemperature(0.5),
+	)
+
+	if err != nil {
+		log.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Response: %s\n\n", response.Content)
+}
+
+func example5ExtendedThinking(p *anthropic.AnthropicProvider) {
+	fmt.Println("Example 5: Extended Thinking")
+	fmt.Println("----------------------------")
+
+	ctx := context.Background()
+	messages := []provider.Message{
+		{Role: "user", Content: "How many prime numbers are there between 100 and 150?"},
+	}
+
+	eventChan, err := p.Stream(ctx, messages,
+		provider.StreamWithModel("claude-sonnet-4-5"),
+		provider.StreamWithMaxTokens(2048),
+		provider.StreamWithMetadata("thinking_budget", "1024"),
+	)
+
+	if err != nil {
+		log.Printf("Error: %v\n", err)
+		return
+	}
+
+	for event := range eventChan {
+		switch event.Type {
+		case provider.EventTypeThinking:
+			fmt.Printf("[thinking] %s", event.Content)
+		case provider.EventTypeContentDelta:
+			fmt.Print(event.Content)
+		case provider.EventTypeContentEnd:
+			fmt.Println()
+		case provider.EventTypeError:
+			log.Printf("Stream error: %v\n", event.Error)
+		}
+	}
+	fmt.Println()
+}
@@ -214,30 +214,16 @@ func errorHandlingExample() {
 
 	_, err = p.Chat(ctx, messages, provider.WithModel("gpt-4"))
 	if err != nil {
-		// Check error type
+		// Classify the error with the provider sentinels
 		switch {
-		case isAuthenticationError(err):
+		case provider.IsAuthentication(err):
 			fmt.Println("Authentication error: Check your API key")
-		case isRateLimitError(err):
+		case provider.IsRateLimited(err):
 			fmt.Println("Rate limit error: Please retry later")
-		case isContextLengthError(err):
+		case provider.IsContextLength(err):
 			fmt.Println("Context length error: Reduce message length")
 		default:
 			fmt.Printf("Other error: %v\n", err)
 		}
 	}
 }
-
-// Helper functions to check error types
-func isAuthenticationError(err error) bool {
-	return err != nil && (err.Error() == "authentication" ||
-		err.Error() == "invalid_api_key")
-}
-
-func isRateLimitError(err error) bool {
-	return err != nil && err.Error() == "rate_limit"
-}
-
-func isContextLengthError(err error) bool {
-	return err != nil && err.Error() == "context_length"
-}
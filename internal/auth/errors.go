@@ -39,6 +39,15 @@ var (
 	// This occurs when the user doesn't complete authorization within the timeout period.
 	ErrAuthorizationTimeout = errors.New("authorization timeout")
 
+	// ErrRevocationFailed indicates the server rejected a token revocation
+	// request during logout. Local tokens are still cleared, but the
+	// refresh token may stay valid on the server until it expires.
+	ErrRevocationFailed = errors.New("failed to revoke tokens on server")
+
+	// ErrNotLoggedIn indicates no tokens are stored for the current user.
+	// The user should authenticate with the login command.
+	ErrNotLoggedIn = errors.New("not logged in")
+
 	// ErrMissingAuthorizationCode indicates no authorization code was received.
 	ErrMissingAuthorizationCode = errors.New("missing authorization code in callback")
 )
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"time"
+)
+
+// Identity describes the authenticated user, decoded from the stored
+// access token. It is the result type of Client.WhoAmI.
+type Identity struct {
+	// UserID is the unique user identifier (from "sub" claim)
+	UserID string
+
+	// Email is the user's email address (from "email" claim)
+	Email string
+
+	// Roles are the user's authorization roles (from "roles" claim)
+	Roles []string
+
+	// Issuer is the token issuer (from "iss" claim)
+	Issuer string
+
+	// ExpiresAt is when the access token expires
+	ExpiresAt time.Time
+}
+
+// TimeUntilExpiry returns how long the access token remains valid.
+// The result is negative when the token has already expired.
+func (i *Identity) TimeUntilExpiry() time.Duration {
+	return time.Until(i.ExpiresAt)
+}
+
+// NewIdentity builds an Identity from a parsed access token.
+func NewIdentity(token *AccessToken) *Identity {
+	if token == nil {
+		return nil
+	}
+
+	return &Identity{
+		UserID:    token.UserID,
+		Email:     token.Email,
+		Roles:     token.Roles,
+		Issuer:    token.Issuer,
+		ExpiresAt: token.ExpiresAt,
+	}
+}
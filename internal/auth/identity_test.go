@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+// TestNewIdentity tests building an Identity from an access token.
+func TestNewIdentity(t *testing.T) {
+	expiresAt := time.Now().Add(1 * time.Hour)
+	token := &AccessToken{
+		UserID:    "user-123",
+		Email:     "test@example.com",
+		Roles:     []string{"user", "admin"},
+		Issuer:    "ainative-auth",
+		ExpiresAt: expiresAt,
+	}
+
+	identity := NewIdentity(token)
+	if identity == nil {
+		t.Fatal("NewIdentity() returned nil for non-nil token")
+	}
+
+	if identity.UserID != "user-123" {
+		t.Errorf("UserID = %q, want %q", identity.UserID, "user-123")
+	}
+	if identity.Email != "test@example.com" {
+		t.Errorf("Email = %q, want %q", identity.Email, "test@example.com")
+	}
+	if len(identity.Roles) != 2 {
+		t.Errorf("len(Roles) = %d, want 2", len(identity.Roles))
+	}
+	if identity.Issuer != "ainative-auth" {
+		t.Errorf("Issuer = %q, want %q", identity.Issuer, "ainative-auth")
+	}
+	if !identity.ExpiresAt.Equal(expiresAt) {
+		t.Errorf("ExpiresAt = %v, want %v", identity.ExpiresAt, expiresAt)
+	}
+}
+
+// TestNewIdentityNilToken tests that a nil token yields a nil identity.
+func TestNewIdentityNilToken(t *testing.T) {
+	if identity := NewIdentity(nil); identity != nil {
+		t.Errorf("NewIdentity(nil) = %v, want nil", identity)
+	}
+}
+
+// TestIdentityTimeUntilExpiry tests the TimeUntilExpiry method.
+func TestIdentityTimeUntilExpiry(t *testing.T) {
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		wantSign  int // +1 for positive, -1 for negative
+	}{
+		{
+			name:      "valid token (expires in 1 hour)",
+			expiresAt: time.Now().Add(1 * time.Hour),
+			wantSign:  1,
+		},
+		{
+			name:      "expired token (1 hour ago)",
+			expiresAt: time.Now().Add(-1 * time.Hour),
+			wantSign:  -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity := &Identity{ExpiresAt: tt.expiresAt}
+			remaining := identity.TimeUntilExpiry()
+
+			if tt.wantSign > 0 && remaining <= 0 {
+				t.Errorf("TimeUntilExpiry() = %v, want positive", remaining)
+			}
+			if tt.wantSign < 0 && remaining >= 0 {
+				t.Errorf("TimeUntilExpiry() = %v, want negative", remaining)
+			}
+		})
+	}
+}
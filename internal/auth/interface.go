@@ -183,6 +183,54 @@ type Client interface {
 	//	}
 	ClearTokens(ctx context.Context) error
 
+	// Logout revokes tokens server-side and clears local credentials.
+	//
+	// This method:
+	//   1. Calls the token-revocation endpoint for the access token
+	//   2. Calls the token-revocation endpoint for the refresh token
+	//   3. Removes tokens from OS keychain
+	//   4. Stops any running background refresh manager
+	//
+	// Local credentials are cleared even when revocation fails; in that
+	// case the returned error describes the failed revocation so callers
+	// can warn the user that the refresh token may stay valid until it
+	// expires. This matters on shared machines.
+	//
+	// Returns:
+	//   - ErrRevocationFailed if the server rejects the revocation request
+	//   - ErrKeychainDelete if clearing local tokens fails
+	//
+	// Example:
+	//
+	//	if err := client.Logout(ctx); err != nil {
+	//	    log.Printf("Warning: %v", err)
+	//	}
+	Logout(ctx context.Context) error
+
+	// WhoAmI returns the identity of the currently authenticated user.
+	//
+	// This method:
+	//   1. Loads the stored access token from OS keychain
+	//   2. Refreshes the token first if it has expired
+	//   3. Validates the token and decodes its claims
+	//   4. Returns the identity (user ID, email, roles, issuer, expiry)
+	//
+	// Returns:
+	//   - ErrNotLoggedIn if no tokens are stored
+	//   - ErrTokenExpired if the token expired and could not be refreshed
+	//   - ErrTokenParseFailed if the stored token data is corrupted
+	//
+	// Example:
+	//
+	//	identity, err := client.WhoAmI(ctx)
+	//	if errors.Is(err, auth.ErrNotLoggedIn) {
+	//	    fmt.Println("Run 'ainative-code login' to authenticate")
+	//	    return nil
+	//	}
+	//	fmt.Printf("Logged in as %s (expires in %s)\n",
+	//	    identity.Email, identity.TimeUntilExpiry())
+	WhoAmI(ctx context.Context) (*Identity, error)
+
 	// ValidateToken checks if an access token is valid and not expired.
 	//
 	// This method:
@@ -0,0 +1,205 @@
+package jwt
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DefaultJWKSTTL is how long fetched JWKS keys are cached before a refresh.
+const DefaultJWKSTTL = 5 * time.Minute
+
+// KeySet maps JWT key IDs to RSA public keys so validation can pick the
+// right key during signing key rotation. A KeySet may be populated manually
+// with Add or backed by a JWKS endpoint via NewKeySetFromJWKS; tokens
+// without a kid header fall back to the static key passed to validation.
+type KeySet struct {
+	mu        sync.RWMutex
+	keys      map[string]*rsa.PublicKey
+	jwksURL   string
+	ttl       time.Duration
+	fetchedAt time.Time
+	client    *http.Client
+}
+
+// NewKeySet creates an empty key set populated manually via Add.
+func NewKeySet() *KeySet {
+	return &KeySet{
+		keys: make(map[string]*rsa.PublicKey),
+	}
+}
+
+// NewKeySetFromJWKS creates a key set backed by a JWKS endpoint. Keys are
+// loaded lazily on first use, cached for ttl, and refreshed early when a
+// token presents an unknown kid (the usual signal that keys have rotated).
+func NewKeySetFromJWKS(url string, ttl time.Duration) *KeySet {
+	if ttl <= 0 {
+		ttl = DefaultJWKSTTL
+	}
+
+	return &KeySet{
+		keys:    make(map[string]*rsa.PublicKey),
+		jwksURL: url,
+		ttl:     ttl,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Add registers a public key under the given key ID.
+func (s *KeySet) Add(kid string, key *rsa.PublicKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[kid] = key
+}
+
+// KeyIDs returns the key IDs currently held by the set.
+func (s *KeySet) KeyIDs() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := make([]string, 0, len(s.keys))
+	for kid := range s.keys {
+		ids = append(ids, kid)
+	}
+	return ids
+}
+
+// keyFor resolves the verification key for a token. Tokens without a kid
+// header use the fallback key; tokens with an unknown kid trigger a JWKS
+// refresh before failing.
+func (s *KeySet) keyFor(token *jwt.Token, fallback *rsa.PublicKey) (*rsa.PublicKey, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok || kid == "" {
+		if fallback == nil {
+			return nil, fmt.Errorf("token has no kid header and no static key is configured")
+		}
+		return fallback, nil
+	}
+
+	if err := s.ensureFresh(false); err != nil {
+		return nil, err
+	}
+
+	if key := s.lookup(kid); key != nil {
+		return key, nil
+	}
+
+	// Unknown kid: the signing keys may have rotated since the last fetch
+	if s.jwksURL != "" {
+		if err := s.ensureFresh(true); err != nil {
+			return nil, err
+		}
+		if key := s.lookup(kid); key != nil {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown key id: %s", kid)
+}
+
+// lookup returns the key for kid, or nil when absent.
+func (s *KeySet) lookup(kid string) *rsa.PublicKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.keys[kid]
+}
+
+// ensureFresh fetches the JWKS when the cache is empty, stale, or a forced
+// refresh is requested. Manual key sets without a JWKS URL are never fetched.
+func (s *KeySet) ensureFresh(force bool) error {
+	if s.jwksURL == "" {
+		return nil
+	}
+
+	s.mu.RLock()
+	fresh := !s.fetchedAt.IsZero() && time.Since(s.fetchedAt) < s.ttl
+	s.mu.RUnlock()
+
+	if fresh && !force {
+		return nil
+	}
+
+	return s.fetch()
+}
+
+// jwks mirrors the subset of RFC 7517 needed for RSA verification keys.
+type jwks struct {
+	Keys []jwksKey `json:"keys"`
+}
+
+type jwksKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// fetch downloads and parses the JWKS document, replacing the cached keys.
+func (s *KeySet) fetch() error {
+	resp, err := s.client.Get(s.jwksURL)
+	if err != nil {
+		return fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch JWKS: unexpected status %d", resp.StatusCode)
+	}
+
+	var doc jwks
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(doc.Keys))
+	for _, k := range doc.Keys {
+		if k.Kty != "RSA" || k.Kid == "" {
+			continue
+		}
+		key, err := parseJWKSKey(k)
+		if err != nil {
+			return fmt.Errorf("failed to parse JWKS key %s: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+
+	s.mu.Lock()
+	s.keys = keys
+	s.fetchedAt = time.Now()
+	s.mu.Unlock()
+
+	return nil
+}
+
+// parseJWKSKey converts a JWKS RSA key entry into an rsa.PublicKey.
+func parseJWKSKey(k jwksKey) (*rsa.PublicKey, error) {
+	nBytes, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+
+	eBytes, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+
+	e := 0
+	for _, b := range eBytes {
+		e = e<<8 | int(b)
+	}
+	if e <= 0 {
+		return nil, fmt.Errorf("invalid exponent")
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(nBytes),
+		E: e,
+	}, nil
+}
@@ -0,0 +1,185 @@
+package jwt_test
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/AINative-studio/ainative-code/internal/auth/jwt"
+	jwtlib "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jwksDocument builds a JWKS JSON document for the given kid/key pairs.
+func jwksDocument(t *testing.T, keys map[string]*rsa.PublicKey) []byte {
+	t.Helper()
+
+	type jwksKey struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	}
+
+	doc := struct {
+		Keys []jwksKey `json:"keys"`
+	}{}
+
+	for kid, key := range keys {
+		doc.Keys = append(doc.Keys, jwksKey{
+			Kty: "RSA",
+			Kid: kid,
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+
+	data, err := json.Marshal(doc)
+	require.NoError(t, err)
+	return data
+}
+
+// signAccessTokenWithKid signs a valid access token with an optional kid header.
+func signAccessTokenWithKid(t *testing.T, privateKey *rsa.PrivateKey, kid string) string {
+	t.Helper()
+
+	now := time.Now()
+	claims := &jwt.AccessTokenClaims{
+		RegisteredClaims: jwtlib.RegisteredClaims{
+			Issuer:    jwt.Issuer,
+			Audience:  jwtlib.ClaimStrings{jwt.Audience},
+			ExpiresAt: jwtlib.NewNumericDate(now.Add(1 * time.Hour)),
+			IssuedAt:  jwtlib.NewNumericDate(now),
+			NotBefore: jwtlib.NewNumericDate(now),
+		},
+		UserID: "user-123",
+	}
+
+	token := jwtlib.NewWithClaims(jwtlib.SigningMethodRS256, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	signed, err := token.SignedString(privateKey)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestKeySet_SelectsKeyByKid(t *testing.T) {
+	key1, pub1, err := generateTestKeyPair()
+	require.NoError(t, err)
+	key2, pub2, err := generateTestKeyPair()
+	require.NoError(t, err)
+
+	keySet := jwt.NewKeySet()
+	keySet.Add("key-1", pub1)
+	keySet.Add("key-2", pub2)
+
+	t.Run("validates against the key named by kid", func(t *testing.T) {
+		for kid, privateKey := range map[string]*rsa.PrivateKey{"key-1": key1, "key-2": key2} {
+			tokenString := signAccessTokenWithKid(t, privateKey, kid)
+
+			claims, err := jwt.ValidateAccessToken(tokenString, nil, jwt.WithKeySet(keySet))
+			require.NoError(t, err, "kid %s", kid)
+			assert.Equal(t, "user-123", claims.UserID)
+		}
+	})
+
+	t.Run("rejects a token signed by the wrong key", func(t *testing.T) {
+		// Signed by key1 but claiming key-2
+		tokenString := signAccessTokenWithKid(t, key1, "key-2")
+
+		_, err := jwt.ValidateAccessToken(tokenString, nil, jwt.WithKeySet(keySet))
+		assert.Error(t, err)
+	})
+
+	t.Run("errors on unknown kid", func(t *testing.T) {
+		tokenString := signAccessTokenWithKid(t, key1, "key-999")
+
+		_, err := jwt.ValidateAccessToken(tokenString, nil, jwt.WithKeySet(keySet))
+		assert.Error(t, err)
+	})
+
+	t.Run("falls back to static key without kid", func(t *testing.T) {
+		tokenString := signAccessTokenWithKid(t, key1, "")
+
+		claims, err := jwt.ValidateAccessToken(tokenString, pub1, jwt.WithKeySet(keySet))
+		require.NoError(t, err)
+		assert.Equal(t, "user-123", claims.UserID)
+	})
+
+	t.Run("errors without kid and without static key", func(t *testing.T) {
+		tokenString := signAccessTokenWithKid(t, key1, "")
+
+		_, err := jwt.ValidateAccessToken(tokenString, nil, jwt.WithKeySet(keySet))
+		assert.Error(t, err)
+	})
+}
+
+func TestNewKeySetFromJWKS(t *testing.T) {
+	key1, pub1, err := generateTestKeyPair()
+	require.NoError(t, err)
+	key2, pub2, err := generateTestKeyPair()
+	require.NoError(t, err)
+
+	t.Run("lazily loads and caches keys", func(t *testing.T) {
+		var fetches int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&fetches, 1)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(jwksDocument(t, map[string]*rsa.PublicKey{"key-1": pub1}))
+		}))
+		defer server.Close()
+
+		keySet := jwt.NewKeySetFromJWKS(server.URL, 5*time.Minute)
+
+		tokenString := signAccessTokenWithKid(t, key1, "key-1")
+
+		// Two validations, one fetch: the document is cached
+		for i := 0; i < 2; i++ {
+			_, err := jwt.ValidateAccessToken(tokenString, nil, jwt.WithKeySet(keySet))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+	})
+
+	t.Run("refreshes on unknown kid after rotation", func(t *testing.T) {
+		var fetches int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if atomic.AddInt32(&fetches, 1) == 1 {
+				w.Write(jwksDocument(t, map[string]*rsa.PublicKey{"key-1": pub1}))
+				return
+			}
+			// Rotated: key-2 replaces key-1
+			w.Write(jwksDocument(t, map[string]*rsa.PublicKey{"key-2": pub2}))
+		}))
+		defer server.Close()
+
+		keySet := jwt.NewKeySetFromJWKS(server.URL, 5*time.Minute)
+
+		// Prime the cache with the pre-rotation document
+		_, err := jwt.ValidateAccessToken(signAccessTokenWithKid(t, key1, "key-1"), nil, jwt.WithKeySet(keySet))
+		require.NoError(t, err)
+
+		// A token signed by the rotated key triggers a refresh
+		claims, err := jwt.ValidateAccessToken(signAccessTokenWithKid(t, key2, "key-2"), nil, jwt.WithKeySet(keySet))
+		require.NoError(t, err)
+		assert.Equal(t, "user-123", claims.UserID)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+	})
+
+	t.Run("errors when the endpoint is unreachable", func(t *testing.T) {
+		keySet := jwt.NewKeySetFromJWKS("http://127.0.0.1:1/jwks.json", time.Minute)
+
+		_, err := jwt.ValidateAccessToken(signAccessTokenWithKid(t, key1, "key-1"), nil, jwt.WithKeySet(keySet))
+		assert.Error(t, err)
+	})
+}
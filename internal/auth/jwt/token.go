@@ -8,6 +8,55 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// DefaultLeeway is the clock-skew tolerance applied to expiry, not-before,
+// and issued-at checks during validation. Clients with clocks a few seconds
+// off would otherwise reject freshly issued or barely expired tokens.
+const DefaultLeeway = 60 * time.Second
+
+// ValidateOption configures token validation.
+type ValidateOption func(*validateOptions)
+
+type validateOptions struct {
+	leeway time.Duration
+	keySet *KeySet
+}
+
+// WithLeeway overrides the clock-skew tolerance for expiry, not-before, and
+// issued-at checks. Pass zero for strict validation.
+func WithLeeway(d time.Duration) ValidateOption {
+	return func(o *validateOptions) {
+		o.leeway = d
+	}
+}
+
+// WithKeySet selects the verification key by the token's kid header,
+// supporting signing key rotation. Tokens without a kid header still verify
+// against the static public key passed to validation.
+func WithKeySet(ks *KeySet) ValidateOption {
+	return func(o *validateOptions) {
+		o.keySet = ks
+	}
+}
+
+// verificationKey resolves the key used to verify a token: the key set when
+// one is configured, otherwise the static key.
+func (o validateOptions) verificationKey(token *jwt.Token, publicKey *rsa.PublicKey) (interface{}, error) {
+	if o.keySet != nil {
+		return o.keySet.keyFor(token, publicKey)
+	}
+	return publicKey, nil
+}
+
+// applyValidateOptions resolves the validation options, starting from the
+// default leeway.
+func applyValidateOptions(opts []ValidateOption) validateOptions {
+	options := validateOptions{leeway: DefaultLeeway}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
 // CreateAccessToken creates a new access token with the given claims.
 func CreateAccessToken(userID, email string, roles []string, privateKey *rsa.PrivateKey) (string, error) {
 	now := time.Now()
@@ -82,7 +131,10 @@ func CreateTokenPair(userID, email string, roles []string, sessionID string, pri
 }
 
 // ValidateAccessToken validates an access token and returns the claims.
-func ValidateAccessToken(tokenString string, publicKey *rsa.PublicKey) (*AccessTokenClaims, error) {
+// Time-based claims are checked with a clock-skew leeway of DefaultLeeway,
+// adjustable via WithLeeway.
+func ValidateAccessToken(tokenString string, publicKey *rsa.PublicKey, opts ...ValidateOption) (*AccessTokenClaims, error) {
+	options := applyValidateOptions(opts)
 	claims := &AccessTokenClaims{}
 
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
@@ -90,8 +142,8 @@ func ValidateAccessToken(tokenString string, publicKey *rsa.PublicKey) (*AccessT
 		if token.Method.Alg() != SigningMethod {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return publicKey, nil
-	})
+		return options.verificationKey(token, publicKey)
+	}, jwt.WithLeeway(options.leeway))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse token: %w", err)
@@ -121,7 +173,10 @@ func ValidateAccessToken(tokenString string, publicKey *rsa.PublicKey) (*AccessT
 }
 
 // ValidateRefreshToken validates a refresh token and returns the claims.
-func ValidateRefreshToken(tokenString string, publicKey *rsa.PublicKey) (*RefreshTokenClaims, error) {
+// Time-based claims are checked with a clock-skew leeway of DefaultLeeway,
+// adjustable via WithLeeway.
+func ValidateRefreshToken(tokenString string, publicKey *rsa.PublicKey, opts ...ValidateOption) (*RefreshTokenClaims, error) {
+	options := applyValidateOptions(opts)
 	claims := &RefreshTokenClaims{}
 
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
@@ -129,8 +184,8 @@ func ValidateRefreshToken(tokenString string, publicKey *rsa.PublicKey) (*Refres
 		if token.Method.Alg() != SigningMethod {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return publicKey, nil
-	})
+		return options.verificationKey(token, publicKey)
+	}, jwt.WithLeeway(options.leeway))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse token: %w", err)
@@ -232,3 +287,19 @@ func GetTokenExpiration(tokenString string) (time.Time, error) {
 
 	return claims.ExpiresAt.Time, nil
 }
+
+// DecodeAccessTokenClaims decodes the claims of an access token without
+// verifying its signature. Use this for display purposes only (e.g. a
+// whoami command); authorization decisions must go through
+// ValidateAccessToken.
+func DecodeAccessTokenClaims(tokenString string) (*AccessTokenClaims, error) {
+	claims := &AccessTokenClaims{}
+	parser := jwt.NewParser()
+
+	_, _, err := parser.ParseUnverified(tokenString, claims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	return claims, nil
+}
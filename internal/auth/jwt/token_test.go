@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/AINative-studio/ainative-code/internal/auth/jwt"
+	jwtlib "github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -299,3 +300,137 @@ func TestTokenConstants(t *testing.T) {
 		assert.Equal(t, "RS256", jwt.SigningMethod)
 	})
 }
+
+func TestValidateAccessToken_Leeway(t *testing.T) {
+	privateKey, publicKey, err := generateTestKeyPair()
+	require.NoError(t, err)
+
+	// Sign an access token that expired 30 seconds ago
+	now := time.Now()
+	claims := &jwt.AccessTokenClaims{
+		RegisteredClaims: jwtlib.RegisteredClaims{
+			Issuer:    jwt.Issuer,
+			Audience:  jwtlib.ClaimStrings{jwt.Audience},
+			ExpiresAt: jwtlib.NewNumericDate(now.Add(-30 * time.Second)),
+			IssuedAt:  jwtlib.NewNumericDate(now.Add(-1 * time.Hour)),
+			NotBefore: jwtlib.NewNumericDate(now.Add(-1 * time.Hour)),
+		},
+		UserID: "user-123",
+		Email:  "user@example.com",
+	}
+	token := jwtlib.NewWithClaims(jwtlib.SigningMethodRS256, claims)
+	tokenString, err := token.SignedString(privateKey)
+	require.NoError(t, err)
+
+	t.Run("expired token passes under default leeway", func(t *testing.T) {
+		validated, err := jwt.ValidateAccessToken(tokenString, publicKey)
+		require.NoError(t, err)
+		assert.Equal(t, "user-123", validated.UserID)
+	})
+
+	t.Run("expired token fails under zero leeway", func(t *testing.T) {
+		_, err := jwt.ValidateAccessToken(tokenString, publicKey, jwt.WithLeeway(0))
+		assert.Error(t, err)
+	})
+
+	t.Run("not-before slightly in the future passes with leeway", func(t *testing.T) {
+		futureClaims := &jwt.AccessTokenClaims{
+			RegisteredClaims: jwtlib.RegisteredClaims{
+				Issuer:    jwt.Issuer,
+				Audience:  jwtlib.ClaimStrings{jwt.Audience},
+				ExpiresAt: jwtlib.NewNumericDate(now.Add(1 * time.Hour)),
+				IssuedAt:  jwtlib.NewNumericDate(now.Add(30 * time.Second)),
+				NotBefore: jwtlib.NewNumericDate(now.Add(30 * time.Second)),
+			},
+			UserID: "user-123",
+		}
+		futureToken := jwtlib.NewWithClaims(jwtlib.SigningMethodRS256, futureClaims)
+		futureString, err := futureToken.SignedString(privateKey)
+		require.NoError(t, err)
+
+		_, err = jwt.ValidateAccessToken(futureString, publicKey)
+		assert.NoError(t, err)
+
+		_, err = jwt.ValidateAccessToken(futureString, publicKey, jwt.WithLeeway(0))
+		assert.Error(t, err)
+	})
+}
+
+func TestValidateRefreshToken_Leeway(t *testing.T) {
+	privateKey, publicKey, err := generateTestKeyPair()
+	require.NoError(t, err)
+
+	// Sign a refresh token that expired 30 seconds ago
+	now := time.Now()
+	claims := &jwt.RefreshTokenClaims{
+		RegisteredClaims: jwtlib.RegisteredClaims{
+			Issuer:    jwt.Issuer,
+			Audience:  jwtlib.ClaimStrings{jwt.Audience},
+			ExpiresAt: jwtlib.NewNumericDate(now.Add(-30 * time.Second)),
+			IssuedAt:  jwtlib.NewNumericDate(now.Add(-1 * time.Hour)),
+			NotBefore: jwtlib.NewNumericDate(now.Add(-1 * time.Hour)),
+		},
+		UserID:    "user-123",
+		SessionID: "session-456",
+	}
+	token := jwtlib.NewWithClaims(jwtlib.SigningMethodRS256, claims)
+	tokenString, err := token.SignedString(privateKey)
+	require.NoError(t, err)
+
+	t.Run("expired token passes under default leeway", func(t *testing.T) {
+		validated, err := jwt.ValidateRefreshToken(tokenString, publicKey)
+		require.NoError(t, err)
+		assert.Equal(t, "session-456", validated.SessionID)
+	})
+
+	t.Run("expired token fails under zero leeway", func(t *testing.T) {
+		_, err := jwt.ValidateRefreshToken(tokenString, publicKey, jwt.WithLeeway(0))
+		assert.Error(t, err)
+	})
+}
+
+func TestDecodeAccessTokenClaims(t *testing.T) {
+	privateKey, _, err := generateTestKeyPair()
+	require.NoError(t, err)
+
+	t.Run("decodes claims without verification", func(t *testing.T) {
+		tokenString, err := jwt.CreateAccessToken("user-123", "test@example.com", []string{"user", "admin"}, privateKey)
+		require.NoError(t, err)
+
+		claims, err := jwt.DecodeAccessTokenClaims(tokenString)
+		require.NoError(t, err)
+
+		assert.Equal(t, "user-123", claims.UserID)
+		assert.Equal(t, "test@example.com", claims.Email)
+		assert.Equal(t, []string{"user", "admin"}, claims.Roles)
+		assert.Equal(t, jwt.Issuer, claims.Issuer)
+		require.NotNil(t, claims.ExpiresAt)
+		assert.True(t, claims.ExpiresAt.After(time.Now()))
+	})
+
+	t.Run("decodes expired token", func(t *testing.T) {
+		now := time.Now()
+		claims := &jwt.AccessTokenClaims{
+			RegisteredClaims: jwtlib.RegisteredClaims{
+				Issuer:    jwt.Issuer,
+				Audience:  jwtlib.ClaimStrings{jwt.Audience},
+				ExpiresAt: jwtlib.NewNumericDate(now.Add(-1 * time.Hour)),
+				IssuedAt:  jwtlib.NewNumericDate(now.Add(-2 * time.Hour)),
+			},
+			UserID: "user-123",
+			Email:  "test@example.com",
+		}
+		token := jwtlib.NewWithClaims(jwtlib.SigningMethodRS256, claims)
+		tokenString, err := token.SignedString(privateKey)
+		require.NoError(t, err)
+
+		decoded, err := jwt.DecodeAccessTokenClaims(tokenString)
+		require.NoError(t, err)
+		assert.Equal(t, "user-123", decoded.UserID)
+	})
+
+	t.Run("fails on malformed token", func(t *testing.T) {
+		_, err := jwt.DecodeAccessTokenClaims("not-a-jwt")
+		assert.Error(t, err)
+	})
+}
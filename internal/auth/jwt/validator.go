@@ -46,13 +46,13 @@ func (v *Validator) SetInvalidateFunc(fn func() error) {
 }
 
 // ValidateAccessToken validates an access token using cached public key.
-func (v *Validator) ValidateAccessToken(tokenString string) (*AccessTokenClaims, error) {
+func (v *Validator) ValidateAccessToken(tokenString string, opts ...ValidateOption) (*AccessTokenClaims, error) {
 	publicKey, err := v.getPublicKey()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get public key: %w", err)
 	}
 
-	claims, err := ValidateAccessToken(tokenString, publicKey)
+	claims, err := ValidateAccessToken(tokenString, publicKey, opts...)
 	if err != nil {
 		// Invalidate cache on validation failure
 		if invalidateErr := v.InvalidateCache(); invalidateErr != nil {
@@ -66,13 +66,13 @@ func (v *Validator) ValidateAccessToken(tokenString string) (*AccessTokenClaims,
 }
 
 // ValidateRefreshToken validates a refresh token using cached public key.
-func (v *Validator) ValidateRefreshToken(tokenString string) (*RefreshTokenClaims, error) {
+func (v *Validator) ValidateRefreshToken(tokenString string, opts ...ValidateOption) (*RefreshTokenClaims, error) {
 	publicKey, err := v.getPublicKey()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get public key: %w", err)
 	}
 
-	claims, err := ValidateRefreshToken(tokenString, publicKey)
+	claims, err := ValidateRefreshToken(tokenString, publicKey, opts...)
 	if err != nil {
 		// Invalidate cache on validation failure
 		if invalidateErr := v.InvalidateCache(); invalidateErr != nil {
@@ -6,6 +6,8 @@
 //   - macOS: Keychain Services
 //   - Linux: Secret Service (libsecret)
 //   - Windows: Credential Manager (via Windows Credential API)
+//   - Fallback: AES-256-GCM encrypted file (headless hosts without an
+//     OS keychain, e.g. Linux CI boxes without libsecret)
 //
 // Features:
 //   - Store access tokens securely
@@ -0,0 +1,450 @@
+package keychain
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/AINative-studio/ainative-code/internal/auth/jwt"
+)
+
+// CredentialsFileName is the name of the encrypted credentials file used by
+// the file-based fallback backend.
+const CredentialsFileName = "credentials.enc"
+
+// DefaultCredentialsPath returns the default location of the encrypted
+// credentials file, ~/.config/ainative-code/credentials.enc on Linux.
+func DefaultCredentialsPath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine config directory: %w", err)
+	}
+	return filepath.Join(configDir, ServiceName, CredentialsFileName), nil
+}
+
+// FileBackend stores credentials in an AES-256-GCM encrypted file. It is the
+// documented fallback used by Get on hosts without an OS keychain, such as
+// headless Linux machines without libsecret. The encryption key is derived
+// from a passphrase, or from the machine ID when no passphrase is given, so
+// the file is not portable between machines by default.
+type FileBackend struct {
+	path string
+	key  []byte
+	mu   sync.Mutex
+}
+
+// NewFileBackend creates a file-based credential store at the given path. An
+// empty path uses DefaultCredentialsPath. An empty passphrase derives the
+// encryption key from the machine ID instead.
+func NewFileBackend(path, passphrase string) (*FileBackend, error) {
+	if path == "" {
+		defaultPath, err := DefaultCredentialsPath()
+		if err != nil {
+			return nil, err
+		}
+		path = defaultPath
+	}
+
+	if passphrase == "" {
+		passphrase = machineSecret()
+	}
+
+	// Bind the key to this application so other users of the same
+	// passphrase scheme produce different keys
+	sum := sha256.Sum256([]byte(ServiceName + ":" + passphrase))
+
+	return &FileBackend{
+		path: path,
+		key:  sum[:],
+	}, nil
+}
+
+// machineSecret returns a stable per-machine value used to derive the
+// encryption key when no passphrase is configured.
+func machineSecret() string {
+	for _, path := range []string{"/etc/machine-id", "/var/lib/dbus/machine-id"} {
+		if data, err := os.ReadFile(path); err == nil {
+			if id := bytes.TrimSpace(data); len(id) > 0 {
+				return string(id)
+			}
+		}
+	}
+
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		return hostname
+	}
+
+	return ServiceName
+}
+
+// load reads and decrypts the credentials file, returning an empty map when
+// the file does not exist yet.
+func (f *FileBackend) load() (map[string]string, error) {
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return make(map[string]string), nil
+		}
+		return nil, fmt.Errorf("failed to read credentials file: %w", err)
+	}
+
+	plaintext, err := f.decrypt(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt credentials file: %w", err)
+	}
+
+	creds := make(map[string]string)
+	if err := json.Unmarshal(plaintext, &creds); err != nil {
+		return nil, fmt.Errorf("failed to parse credentials file: %w", err)
+	}
+
+	return creds, nil
+}
+
+// save encrypts and writes the credentials map, creating the parent
+// directory with restrictive permissions when needed.
+func (f *FileBackend) save(creds map[string]string) error {
+	plaintext, err := json.Marshal(creds)
+	if err != nil {
+		return fmt.Errorf("failed to marshal credentials: %w", err)
+	}
+
+	ciphertext, err := f.encrypt(plaintext)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt credentials: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(f.path), 0700); err != nil {
+		return fmt.Errorf("failed to create credentials directory: %w", err)
+	}
+
+	if err := os.WriteFile(f.path, ciphertext, 0600); err != nil {
+		return fmt.Errorf("failed to write credentials file: %w", err)
+	}
+
+	return nil
+}
+
+// encrypt seals plaintext with AES-256-GCM, prefixing the random nonce.
+func (f *FileBackend) encrypt(plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(f.key)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// decrypt opens data sealed by encrypt.
+func (f *FileBackend) decrypt(data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(f.key)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("credentials file is truncated")
+	}
+
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+// set stores a single credential under key.
+func (f *FileBackend) set(key, value string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	creds, err := f.load()
+	if err != nil {
+		return err
+	}
+
+	creds[key] = value
+	return f.save(creds)
+}
+
+// get retrieves a single credential by key.
+func (f *FileBackend) get(key string) (string, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	creds, err := f.load()
+	if err != nil {
+		return "", false, err
+	}
+
+	value, ok := creds[key]
+	return value, ok, nil
+}
+
+// SetAccessToken stores an access token.
+func (f *FileBackend) SetAccessToken(token string) error {
+	if err := f.set(AccessTokenKey, token); err != nil {
+		return fmt.Errorf("failed to store access token: %w", err)
+	}
+	return nil
+}
+
+// GetAccessToken retrieves the access token.
+func (f *FileBackend) GetAccessToken() (string, error) {
+	token, ok, err := f.get(AccessTokenKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve access token: %w", err)
+	}
+	if !ok {
+		return "", fmt.Errorf("access token not found")
+	}
+	return token, nil
+}
+
+// SetRefreshToken stores a refresh token.
+func (f *FileBackend) SetRefreshToken(token string) error {
+	if err := f.set(RefreshTokenKey, token); err != nil {
+		return fmt.Errorf("failed to store refresh token: %w", err)
+	}
+	return nil
+}
+
+// GetRefreshToken retrieves the refresh token.
+func (f *FileBackend) GetRefreshToken() (string, error) {
+	token, ok, err := f.get(RefreshTokenKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve refresh token: %w", err)
+	}
+	if !ok {
+		return "", fmt.Errorf("refresh token not found")
+	}
+	return token, nil
+}
+
+// SetTokenPair stores both access and refresh tokens as a JSON object.
+func (f *FileBackend) SetTokenPair(tokens *jwt.TokenPair) error {
+	if tokens == nil {
+		return fmt.Errorf("tokens cannot be nil")
+	}
+
+	data, err := json.Marshal(tokens)
+	if err != nil {
+		return fmt.Errorf("failed to marshal tokens: %w", err)
+	}
+
+	if err := f.set(TokenPairKey, string(data)); err != nil {
+		return fmt.Errorf("failed to store token pair: %w", err)
+	}
+
+	// Also store individually for convenience
+	if err := f.SetAccessToken(tokens.AccessToken); err != nil {
+		return err
+	}
+
+	return f.SetRefreshToken(tokens.RefreshToken)
+}
+
+// GetTokenPair retrieves both access and refresh tokens.
+func (f *FileBackend) GetTokenPair() (*jwt.TokenPair, error) {
+	data, ok, err := f.get(TokenPairKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve token pair: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("token pair not found")
+	}
+
+	var tokens jwt.TokenPair
+	if err := json.Unmarshal([]byte(data), &tokens); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tokens: %w", err)
+	}
+
+	return &tokens, nil
+}
+
+// SetAPIKey stores an API key.
+func (f *FileBackend) SetAPIKey(key string) error {
+	if err := f.set(APIKeyKey, key); err != nil {
+		return fmt.Errorf("failed to store API key: %w", err)
+	}
+	return nil
+}
+
+// GetAPIKey retrieves the API key.
+func (f *FileBackend) GetAPIKey() (string, error) {
+	key, ok, err := f.get(APIKeyKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve API key: %w", err)
+	}
+	if !ok {
+		return "", fmt.Errorf("API key not found")
+	}
+	return key, nil
+}
+
+// SetUserEmail stores the user's email.
+func (f *FileBackend) SetUserEmail(email string) error {
+	if err := f.set(UserEmailKey, email); err != nil {
+		return fmt.Errorf("failed to store user email: %w", err)
+	}
+	return nil
+}
+
+// GetUserEmail retrieves the user's email.
+func (f *FileBackend) GetUserEmail() (string, error) {
+	email, ok, err := f.get(UserEmailKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve user email: %w", err)
+	}
+	if !ok {
+		return "", fmt.Errorf("user email not found")
+	}
+	return email, nil
+}
+
+// SetAccessTokenFor stores an access token for a named account.
+func (f *FileBackend) SetAccessTokenFor(account, token string) error {
+	if account == "" || account == DefaultAccount {
+		return f.SetAccessToken(token)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	creds, err := f.load()
+	if err != nil {
+		return fmt.Errorf("failed to store access token for account %s: %w", account, err)
+	}
+
+	creds[accountKey(account, AccessTokenKey)] = token
+	creds[accountIndexKey] = addToAccountIndex(creds[accountIndexKey], account)
+
+	if err := f.save(creds); err != nil {
+		return fmt.Errorf("failed to store access token for account %s: %w", account, err)
+	}
+	return nil
+}
+
+// GetAccessTokenFor retrieves the access token for a named account.
+func (f *FileBackend) GetAccessTokenFor(account string) (string, error) {
+	if account == "" || account == DefaultAccount {
+		return f.GetAccessToken()
+	}
+
+	token, ok, err := f.get(accountKey(account, AccessTokenKey))
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve access token for account %s: %w", account, err)
+	}
+	if !ok {
+		return "", fmt.Errorf("access token not found for account %s", account)
+	}
+	return token, nil
+}
+
+// ListAccounts returns the names of accounts with stored credentials.
+func (f *FileBackend) ListAccounts() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	creds, err := f.load()
+	if err != nil {
+		return nil
+	}
+
+	var accounts []string
+	for _, key := range []string{AccessTokenKey, TokenPairKey, APIKeyKey} {
+		if _, ok := creds[key]; ok {
+			accounts = append(accounts, DefaultAccount)
+			break
+		}
+	}
+
+	var indexed []string
+	if raw, ok := creds[accountIndexKey]; ok {
+		if err := json.Unmarshal([]byte(raw), &indexed); err == nil {
+			accounts = append(accounts, indexed...)
+		}
+	}
+
+	return accounts
+}
+
+// addToAccountIndex returns the JSON account index with account added,
+// keeping the list sorted and free of duplicates.
+func addToAccountIndex(raw, account string) string {
+	var accounts []string
+	if raw != "" {
+		_ = json.Unmarshal([]byte(raw), &accounts)
+	}
+
+	for _, existing := range accounts {
+		if existing == account {
+			return raw
+		}
+	}
+
+	accounts = append(accounts, account)
+	sort.Strings(accounts)
+
+	data, err := json.Marshal(accounts)
+	if err != nil {
+		return raw
+	}
+	return string(data)
+}
+
+// Delete removes a specific key. Deleting a missing key is not an error.
+func (f *FileBackend) Delete(key string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	creds, err := f.load()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := creds[key]; !ok {
+		return nil
+	}
+
+	delete(creds, key)
+	return f.save(creds)
+}
+
+// DeleteAll removes all stored credentials by wiping the credentials file.
+func (f *FileBackend) DeleteAll() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := os.Remove(f.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete credentials file: %w", err)
+	}
+	return nil
+}
+
+// Exists checks if a key exists.
+func (f *FileBackend) Exists(key string) bool {
+	_, ok, err := f.get(key)
+	return err == nil && ok
+}
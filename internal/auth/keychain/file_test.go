@@ -0,0 +1,214 @@
+package keychain_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/AINative-studio/ainative-code/internal/auth/jwt"
+	"github.com/AINative-studio/ainative-code/internal/auth/keychain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFileBackend(t *testing.T) (*keychain.FileBackend, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "credentials.enc")
+	backend, err := keychain.NewFileBackend(path, "test-passphrase")
+	require.NoError(t, err)
+	return backend, path
+}
+
+func TestFileBackend_TokenRoundTrip(t *testing.T) {
+	backend, _ := newTestFileBackend(t)
+
+	require.NoError(t, backend.SetAccessToken("access-123"))
+	require.NoError(t, backend.SetRefreshToken("refresh-456"))
+
+	access, err := backend.GetAccessToken()
+	require.NoError(t, err)
+	assert.Equal(t, "access-123", access)
+
+	refresh, err := backend.GetRefreshToken()
+	require.NoError(t, err)
+	assert.Equal(t, "refresh-456", refresh)
+}
+
+func TestFileBackend_TokenPair(t *testing.T) {
+	backend, _ := newTestFileBackend(t)
+
+	pair := &jwt.TokenPair{
+		AccessToken:  "access-token",
+		RefreshToken: "refresh-token",
+	}
+	require.NoError(t, backend.SetTokenPair(pair))
+
+	got, err := backend.GetTokenPair()
+	require.NoError(t, err)
+	assert.Equal(t, pair.AccessToken, got.AccessToken)
+	assert.Equal(t, pair.RefreshToken, got.RefreshToken)
+
+	// Individual tokens are stored for convenience, matching keychainImpl
+	access, err := backend.GetAccessToken()
+	require.NoError(t, err)
+	assert.Equal(t, "access-token", access)
+
+	t.Run("nil pair is rejected", func(t *testing.T) {
+		assert.Error(t, backend.SetTokenPair(nil))
+	})
+}
+
+func TestFileBackend_APIKeyAndEmail(t *testing.T) {
+	backend, _ := newTestFileBackend(t)
+
+	require.NoError(t, backend.SetAPIKey("sk-test-key"))
+	require.NoError(t, backend.SetUserEmail("user@example.com"))
+
+	key, err := backend.GetAPIKey()
+	require.NoError(t, err)
+	assert.Equal(t, "sk-test-key", key)
+
+	email, err := backend.GetUserEmail()
+	require.NoError(t, err)
+	assert.Equal(t, "user@example.com", email)
+}
+
+func TestFileBackend_MissingKeys(t *testing.T) {
+	backend, _ := newTestFileBackend(t)
+
+	_, err := backend.GetAccessToken()
+	assert.Error(t, err)
+
+	_, err = backend.GetTokenPair()
+	assert.Error(t, err)
+
+	assert.False(t, backend.Exists(keychain.AccessTokenKey))
+}
+
+func TestFileBackend_Delete(t *testing.T) {
+	backend, _ := newTestFileBackend(t)
+
+	require.NoError(t, backend.SetAPIKey("sk-test-key"))
+	assert.True(t, backend.Exists(keychain.APIKeyKey))
+
+	require.NoError(t, backend.Delete(keychain.APIKeyKey))
+	assert.False(t, backend.Exists(keychain.APIKeyKey))
+
+	// Deleting a missing key is not an error
+	assert.NoError(t, backend.Delete(keychain.APIKeyKey))
+}
+
+func TestFileBackend_DeleteAllWipesFile(t *testing.T) {
+	backend, path := newTestFileBackend(t)
+
+	require.NoError(t, backend.SetAccessToken("access-123"))
+	require.NoError(t, backend.SetAPIKey("sk-test-key"))
+
+	_, err := os.Stat(path)
+	require.NoError(t, err, "credentials file should exist after storing")
+
+	require.NoError(t, backend.DeleteAll())
+
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err), "DeleteAll should remove the credentials file")
+
+	// DeleteAll on an already-empty store is not an error
+	assert.NoError(t, backend.DeleteAll())
+}
+
+func TestFileBackend_PersistsAcrossInstances(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.enc")
+
+	first, err := keychain.NewFileBackend(path, "test-passphrase")
+	require.NoError(t, err)
+	require.NoError(t, first.SetAccessToken("persisted-token"))
+
+	second, err := keychain.NewFileBackend(path, "test-passphrase")
+	require.NoError(t, err)
+
+	token, err := second.GetAccessToken()
+	require.NoError(t, err)
+	assert.Equal(t, "persisted-token", token)
+}
+
+func TestFileBackend_WrongPassphraseFails(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.enc")
+
+	first, err := keychain.NewFileBackend(path, "correct-passphrase")
+	require.NoError(t, err)
+	require.NoError(t, first.SetAccessToken("secret-token"))
+
+	second, err := keychain.NewFileBackend(path, "wrong-passphrase")
+	require.NoError(t, err)
+
+	_, err = second.GetAccessToken()
+	assert.Error(t, err, "reading with the wrong passphrase should fail")
+}
+
+func TestFileBackend_Accounts(t *testing.T) {
+	backend, path := newTestFileBackend(t)
+
+	require.NoError(t, backend.SetAccessToken("default-token"))
+	require.NoError(t, backend.SetAccessTokenFor("work", "work-token"))
+	require.NoError(t, backend.SetAccessTokenFor("personal", "personal-token"))
+
+	t.Run("accounts are independent", func(t *testing.T) {
+		token, err := backend.GetAccessToken()
+		require.NoError(t, err)
+		assert.Equal(t, "default-token", token)
+
+		token, err = backend.GetAccessTokenFor("work")
+		require.NoError(t, err)
+		assert.Equal(t, "work-token", token)
+
+		token, err = backend.GetAccessTokenFor("personal")
+		require.NoError(t, err)
+		assert.Equal(t, "personal-token", token)
+	})
+
+	t.Run("default account aliases", func(t *testing.T) {
+		token, err := backend.GetAccessTokenFor(keychain.DefaultAccount)
+		require.NoError(t, err)
+		assert.Equal(t, "default-token", token)
+
+		token, err = backend.GetAccessTokenFor("")
+		require.NoError(t, err)
+		assert.Equal(t, "default-token", token)
+	})
+
+	t.Run("list accounts", func(t *testing.T) {
+		accounts := backend.ListAccounts()
+		assert.ElementsMatch(t, []string{keychain.DefaultAccount, "work", "personal"}, accounts)
+	})
+
+	t.Run("unknown account", func(t *testing.T) {
+		_, err := backend.GetAccessTokenFor("nonexistent")
+		assert.Error(t, err)
+	})
+
+	t.Run("delete all wipes every account", func(t *testing.T) {
+		require.NoError(t, backend.DeleteAll())
+
+		_, err := os.Stat(path)
+		assert.True(t, os.IsNotExist(err))
+
+		_, err = backend.GetAccessTokenFor("work")
+		assert.Error(t, err)
+		assert.Empty(t, backend.ListAccounts())
+	})
+}
+
+func TestFileBackend_FileIsEncrypted(t *testing.T) {
+	backend, path := newTestFileBackend(t)
+
+	require.NoError(t, backend.SetAccessToken("plaintext-secret-token"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "plaintext-secret-token")
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
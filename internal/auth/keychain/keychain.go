@@ -3,6 +3,7 @@ package keychain
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/99designs/keyring"
@@ -27,8 +28,23 @@ const (
 
 	// UserEmailKey is the key for storing user email
 	UserEmailKey = "user_email"
+
+	// DefaultAccount is the account the non-namespaced methods operate on
+	DefaultAccount = "default"
+
+	// accountIndexKey tracks which named accounts have stored credentials
+	accountIndexKey = "account_index"
 )
 
+// accountKey prefixes a credential key with its account namespace. The
+// default account keeps unprefixed keys so existing credentials stay valid.
+func accountKey(account, key string) string {
+	if account == "" || account == DefaultAccount {
+		return key
+	}
+	return account + ":" + key
+}
+
 // Keychain provides secure credential storage using OS-level services.
 type Keychain interface {
 	// SetAccessToken stores an access token
@@ -61,6 +77,17 @@ type Keychain interface {
 	// GetUserEmail retrieves the user's email
 	GetUserEmail() (string, error)
 
+	// SetAccessTokenFor stores an access token for a named account,
+	// allowing several accounts to be logged in at once
+	SetAccessTokenFor(account, token string) error
+
+	// GetAccessTokenFor retrieves the access token for a named account
+	GetAccessTokenFor(account string) (string, error)
+
+	// ListAccounts returns the names of accounts with stored credentials,
+	// including the default account when it holds any
+	ListAccounts() []string
+
 	// Delete removes a specific key
 	Delete(key string) error
 
@@ -81,7 +108,10 @@ var (
 	defaultKeychain Keychain
 )
 
-// Get returns the platform-specific keychain instance.
+// Get returns the platform-specific keychain instance. When no OS keychain
+// backend is available (e.g. headless Linux without libsecret), it falls back
+// to a FileBackend storing credentials in an encrypted file under the user's
+// config directory.
 func Get() Keychain {
 	if defaultKeychain == nil {
 		defaultKeychain = newKeychain()
@@ -106,24 +136,28 @@ func newKeychain() Keychain {
 	config := keyring.Config{
 		ServiceName: ServiceName,
 
-		// Allowed backends in order of preference
+		// OS-level backends in order of preference
 		AllowedBackends: []keyring.BackendType{
-			keyring.KeychainBackend,        // macOS Keychain
-			keyring.SecretServiceBackend,   // Linux Secret Service
-			keyring.WinCredBackend,         // Windows Credential Manager
-			keyring.FileBackend,            // Fallback encrypted file
+			keyring.KeychainBackend,      // macOS Keychain
+			keyring.SecretServiceBackend, // Linux Secret Service
+			keyring.WinCredBackend,       // Windows Credential Manager
 		},
 	}
 
 	ring, err := keyring.Open(config)
-	if err != nil {
-		// If all backends fail, return a no-op implementation
-		return &noopKeychain{}
+	if err == nil {
+		return &keychainImpl{
+			ring: ring,
+		}
 	}
 
-	return &keychainImpl{
-		ring: ring,
+	// No OS keychain available: fall back to the encrypted file backend
+	if fileBackend, fileErr := NewFileBackend("", ""); fileErr == nil {
+		return fileBackend
 	}
+
+	// If all backends fail, return a no-op implementation
+	return &noopKeychain{}
 }
 
 // SetAccessToken stores an access token.
@@ -284,6 +318,89 @@ func (k *keychainImpl) GetUserEmail() (string, error) {
 	return string(item.Data), nil
 }
 
+// SetAccessTokenFor stores an access token for a named account.
+func (k *keychainImpl) SetAccessTokenFor(account, token string) error {
+	if account == "" || account == DefaultAccount {
+		return k.SetAccessToken(token)
+	}
+
+	item := keyring.Item{
+		Key:  accountKey(account, AccessTokenKey),
+		Data: []byte(token),
+	}
+
+	if err := k.ring.Set(item); err != nil {
+		return fmt.Errorf("failed to store access token for account %s: %w", account, err)
+	}
+
+	return k.addAccountToIndex(account)
+}
+
+// GetAccessTokenFor retrieves the access token for a named account.
+func (k *keychainImpl) GetAccessTokenFor(account string) (string, error) {
+	if account == "" || account == DefaultAccount {
+		return k.GetAccessToken()
+	}
+
+	item, err := k.ring.Get(accountKey(account, AccessTokenKey))
+	if err != nil {
+		if err == keyring.ErrKeyNotFound {
+			return "", fmt.Errorf("access token not found for account %s", account)
+		}
+		return "", fmt.Errorf("failed to retrieve access token for account %s: %w", account, err)
+	}
+
+	return string(item.Data), nil
+}
+
+// ListAccounts returns the names of accounts with stored credentials.
+func (k *keychainImpl) ListAccounts() []string {
+	var accounts []string
+	if k.Exists(AccessTokenKey) || k.Exists(TokenPairKey) || k.Exists(APIKeyKey) {
+		accounts = append(accounts, DefaultAccount)
+	}
+	return append(accounts, k.readAccountIndex()...)
+}
+
+// readAccountIndex returns the stored list of named accounts.
+func (k *keychainImpl) readAccountIndex() []string {
+	item, err := k.ring.Get(accountIndexKey)
+	if err != nil {
+		return nil
+	}
+
+	var accounts []string
+	if err := json.Unmarshal(item.Data, &accounts); err != nil {
+		return nil
+	}
+	return accounts
+}
+
+// addAccountToIndex records a named account so ListAccounts and DeleteAll
+// can find its entries.
+func (k *keychainImpl) addAccountToIndex(account string) error {
+	accounts := k.readAccountIndex()
+	for _, existing := range accounts {
+		if existing == account {
+			return nil
+		}
+	}
+
+	accounts = append(accounts, account)
+	sort.Strings(accounts)
+
+	data, err := json.Marshal(accounts)
+	if err != nil {
+		return fmt.Errorf("failed to marshal account index: %w", err)
+	}
+
+	if err := k.ring.Set(keyring.Item{Key: accountIndexKey, Data: data}); err != nil {
+		return fmt.Errorf("failed to store account index: %w", err)
+	}
+
+	return nil
+}
+
 // Delete removes a specific key from the keychain.
 func (k *keychainImpl) Delete(key string) error {
 	if err := k.ring.Remove(key); err != nil {
@@ -301,9 +418,10 @@ func (k *keychainImpl) Delete(key string) error {
 	return nil
 }
 
-// DeleteAll removes all stored credentials.
+// DeleteAll removes all stored credentials, including every named account's
+// entries.
 func (k *keychainImpl) DeleteAll() error {
-	keys := []string{
+	baseKeys := []string{
 		AccessTokenKey,
 		RefreshTokenKey,
 		TokenPairKey,
@@ -311,6 +429,14 @@ func (k *keychainImpl) DeleteAll() error {
 		UserEmailKey,
 	}
 
+	keys := append([]string{}, baseKeys...)
+	for _, account := range k.readAccountIndex() {
+		for _, base := range baseKeys {
+			keys = append(keys, accountKey(account, base))
+		}
+	}
+	keys = append(keys, accountIndexKey)
+
 	var errs []error
 	for _, key := range keys {
 		if err := k.Delete(key); err != nil {
@@ -344,6 +470,13 @@ func (n *noopKeychain) SetAPIKey(key string) error              { return fmt.Err
 func (n *noopKeychain) GetAPIKey() (string, error)              { return "", fmt.Errorf("no keychain available") }
 func (n *noopKeychain) SetUserEmail(email string) error         { return fmt.Errorf("no keychain available") }
 func (n *noopKeychain) GetUserEmail() (string, error)           { return "", fmt.Errorf("no keychain available") }
+func (n *noopKeychain) SetAccessTokenFor(account, token string) error {
+	return fmt.Errorf("no keychain available")
+}
+func (n *noopKeychain) GetAccessTokenFor(account string) (string, error) {
+	return "", fmt.Errorf("no keychain available")
+}
+func (n *noopKeychain) ListAccounts() []string                  { return nil }
 func (n *noopKeychain) Delete(key string) error                 { return nil }
 func (n *noopKeychain) DeleteAll() error                        { return nil }
 func (n *noopKeychain) Exists(key string) bool                  { return false }
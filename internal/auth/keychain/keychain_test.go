@@ -354,3 +354,56 @@ func TestCompleteWorkflow(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestAccounts(t *testing.T) {
+	kc := getTestKeychain(t)
+
+	t.Run("stores tokens per account", func(t *testing.T) {
+		require.NoError(t, kc.SetAccessToken("default-token"))
+		require.NoError(t, kc.SetAccessTokenFor("work", "work-token"))
+		require.NoError(t, kc.SetAccessTokenFor("personal", "personal-token"))
+
+		token, err := kc.GetAccessTokenFor("work")
+		require.NoError(t, err)
+		assert.Equal(t, "work-token", token)
+
+		token, err = kc.GetAccessTokenFor("personal")
+		require.NoError(t, err)
+		assert.Equal(t, "personal-token", token)
+
+		// The default account is untouched by named accounts
+		token, err = kc.GetAccessToken()
+		require.NoError(t, err)
+		assert.Equal(t, "default-token", token)
+	})
+
+	t.Run("default account aliases plain methods", func(t *testing.T) {
+		require.NoError(t, kc.SetAccessTokenFor(keychain.DefaultAccount, "updated-default"))
+
+		token, err := kc.GetAccessToken()
+		require.NoError(t, err)
+		assert.Equal(t, "updated-default", token)
+	})
+
+	t.Run("lists all accounts", func(t *testing.T) {
+		accounts := kc.ListAccounts()
+		assert.ElementsMatch(t, []string{keychain.DefaultAccount, "work", "personal"}, accounts)
+	})
+
+	t.Run("errors for unknown account", func(t *testing.T) {
+		_, err := kc.GetAccessTokenFor("nonexistent")
+		assert.Error(t, err)
+	})
+
+	t.Run("delete all removes every account", func(t *testing.T) {
+		require.NoError(t, kc.DeleteAll())
+
+		_, err := kc.GetAccessTokenFor("work")
+		assert.Error(t, err)
+
+		_, err = kc.GetAccessToken()
+		assert.Error(t, err)
+
+		assert.Empty(t, kc.ListAccounts())
+	})
+}
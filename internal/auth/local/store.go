@@ -2,9 +2,13 @@ package local
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
 	"database/sql"
 	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/AINative-studio/ainative-code/internal/auth/jwt"
@@ -13,7 +17,7 @@ import (
 )
 
 const (
-	// BcryptCost is the cost factor for bcrypt hashing (12 rounds)
+	// BcryptCost is the default cost factor for bcrypt hashing (12 rounds)
 	BcryptCost = 12
 
 	// LocalTokenDuration is the lifetime of local access tokens
@@ -21,20 +25,69 @@ const (
 
 	// LocalRefreshDuration is the lifetime of local refresh tokens
 	LocalRefreshDuration = 7 * 24 * time.Hour
+
+	// DefaultMaxFailedAttempts is the number of consecutive failed logins
+	// before an account is temporarily locked
+	DefaultMaxFailedAttempts = 5
+
+	// DefaultLockoutDuration is how long an account stays locked after too
+	// many failed logins
+	DefaultLockoutDuration = 15 * time.Minute
+
+	// ResetTokenDuration is the lifetime of password reset tokens
+	ResetTokenDuration = 1 * time.Hour
 )
 
+// ErrAccountLocked is returned by Authenticate when an account is temporarily
+// locked after too many failed login attempts.
+var ErrAccountLocked = errors.New("account temporarily locked")
+
 // Store manages local authentication and credentials.
 type Store struct {
 	db *sql.DB
+
+	bcryptCost        int
+	maxFailedAttempts int
+	lockoutDuration   time.Duration
+}
+
+// StoreOption configures a Store.
+type StoreOption func(*Store)
+
+// WithBcryptCost overrides the bcrypt cost factor used when hashing
+// passwords. Values outside bcrypt's supported range are ignored. Existing
+// hashes at a lower cost are transparently upgraded on the next successful
+// login.
+func WithBcryptCost(cost int) StoreOption {
+	return func(s *Store) {
+		if cost >= bcrypt.MinCost && cost <= bcrypt.MaxCost {
+			s.bcryptCost = cost
+		}
+	}
+}
+
+// WithLockoutPolicy overrides the failed-login threshold and lockout window
+// used for brute-force protection.
+func WithLockoutPolicy(maxAttempts int, duration time.Duration) StoreOption {
+	return func(s *Store) {
+		if maxAttempts > 0 {
+			s.maxFailedAttempts = maxAttempts
+		}
+		if duration > 0 {
+			s.lockoutDuration = duration
+		}
+	}
 }
 
 // User represents a local user account.
 type User struct {
-	ID           int64
-	Email        string
-	PasswordHash string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID             int64
+	Email          string
+	PasswordHash   string
+	FailedAttempts int
+	LockedUntil    time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 // Session represents an authentication session.
@@ -48,7 +101,7 @@ type Session struct {
 }
 
 // NewStore creates a new local auth store with SQLite backend.
-func NewStore(dbPath string) (*Store, error) {
+func NewStore(dbPath string, opts ...StoreOption) (*Store, error) {
 	// Open database with connection parameters for better concurrency
 	db, err := sql.Open("sqlite", dbPath+"?_journal_mode=WAL&_busy_timeout=5000")
 	if err != nil {
@@ -60,7 +113,16 @@ func NewStore(dbPath string) (*Store, error) {
 	db.SetMaxOpenConns(1)
 	db.SetMaxIdleConns(1)
 
-	store := &Store{db: db}
+	store := &Store{
+		db:                db,
+		bcryptCost:        BcryptCost,
+		maxFailedAttempts: DefaultMaxFailedAttempts,
+		lockoutDuration:   DefaultLockoutDuration,
+	}
+
+	for _, opt := range opts {
+		opt(store)
+	}
 
 	// Initialize database schema
 	if err := store.initSchema(); err != nil {
@@ -83,6 +145,8 @@ func (s *Store) initSchema() error {
 		id INTEGER PRIMARY KEY AUTOINCREMENT,
 		email TEXT UNIQUE NOT NULL,
 		password_hash TEXT NOT NULL,
+		failed_attempts INTEGER NOT NULL DEFAULT 0,
+		locked_until DATETIME,
 		created_at DATETIME NOT NULL,
 		updated_at DATETIME NOT NULL
 	);
@@ -97,14 +161,36 @@ func (s *Store) initSchema() error {
 		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
 	);
 
+	CREATE TABLE IF NOT EXISTS reset_tokens (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		user_id INTEGER NOT NULL,
+		token_hash TEXT UNIQUE NOT NULL,
+		expires_at DATETIME NOT NULL,
+		created_at DATETIME NOT NULL,
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+	);
+
 	CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions(user_id);
 	CREATE INDEX IF NOT EXISTS idx_sessions_access_token ON sessions(access_token);
+	CREATE INDEX IF NOT EXISTS idx_reset_tokens_user_id ON reset_tokens(user_id);
 	`
 
 	if _, err := s.db.Exec(schema); err != nil {
 		return fmt.Errorf("failed to create schema: %w", err)
 	}
 
+	// Add lockout columns to databases created before they existed. SQLite
+	// has no ADD COLUMN IF NOT EXISTS, so ignore duplicate-column errors.
+	migrations := []string{
+		"ALTER TABLE users ADD COLUMN failed_attempts INTEGER NOT NULL DEFAULT 0",
+		"ALTER TABLE users ADD COLUMN locked_until DATETIME",
+	}
+	for _, stmt := range migrations {
+		if _, err := s.db.Exec(stmt); err != nil && !strings.Contains(err.Error(), "duplicate column") {
+			return fmt.Errorf("failed to migrate schema: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -114,12 +200,12 @@ func (s *Store) Register(email, password string) error {
 	if email == "" {
 		return fmt.Errorf("email cannot be empty")
 	}
-	if password == "" {
-		return fmt.Errorf("password cannot be empty")
+	if err := validatePassword(password); err != nil {
+		return err
 	}
 
-	// Hash password with bcrypt (12 rounds)
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), BcryptCost)
+	// Hash password with bcrypt at the configured cost
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), s.bcryptCost)
 	if err != nil {
 		return fmt.Errorf("failed to hash password: %w", err)
 	}
@@ -137,14 +223,18 @@ func (s *Store) Register(email, password string) error {
 	return nil
 }
 
-// Authenticate validates credentials and creates a session.
+// Authenticate validates credentials and creates a session. After too many
+// consecutive failed attempts the account is temporarily locked and
+// ErrAccountLocked is returned until the lockout window expires; a successful
+// login resets the counter.
 func (s *Store) Authenticate(email, password string) (*Session, error) {
 	// Get user
 	var user User
+	var lockedUntil sql.NullTime
 	err := s.db.QueryRow(
-		"SELECT id, email, password_hash, created_at, updated_at FROM users WHERE email = ?",
+		"SELECT id, email, password_hash, failed_attempts, locked_until, created_at, updated_at FROM users WHERE email = ?",
 		email,
-	).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.CreatedAt, &user.UpdatedAt)
+	).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.FailedAttempts, &lockedUntil, &user.CreatedAt, &user.UpdatedAt)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("invalid credentials")
@@ -153,11 +243,35 @@ func (s *Store) Authenticate(email, password string) (*Session, error) {
 		return nil, fmt.Errorf("failed to query user: %w", err)
 	}
 
+	// Check for an active lockout
+	if lockedUntil.Valid && time.Now().Before(lockedUntil.Time) {
+		return nil, ErrAccountLocked
+	}
+
 	// Verify password
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		if recordErr := s.recordFailedAttempt(&user); recordErr != nil {
+			return nil, recordErr
+		}
 		return nil, fmt.Errorf("invalid credentials")
 	}
 
+	// Upgrade hashes created at a lower cost so old accounts keep pace with
+	// the configured cost. Best effort: a failure here must not block login.
+	if cost, err := bcrypt.Cost([]byte(user.PasswordHash)); err == nil && cost < s.bcryptCost {
+		_ = s.updatePassword(user.ID, password)
+	}
+
+	// Successful login clears any accumulated failures
+	if user.FailedAttempts > 0 || lockedUntil.Valid {
+		if _, err := s.db.Exec(
+			"UPDATE users SET failed_attempts = 0, locked_until = NULL WHERE id = ?",
+			user.ID,
+		); err != nil {
+			return nil, fmt.Errorf("failed to reset failed attempts: %w", err)
+		}
+	}
+
 	// Generate tokens
 	accessToken, err := generateToken()
 	if err != nil {
@@ -195,6 +309,180 @@ func (s *Store) Authenticate(email, password string) (*Session, error) {
 	return session, nil
 }
 
+// recordFailedAttempt increments the user's failed-login counter and locks
+// the account once the threshold is reached.
+func (s *Store) recordFailedAttempt(user *User) error {
+	attempts := user.FailedAttempts + 1
+
+	if attempts >= s.maxFailedAttempts {
+		lockedUntil := time.Now().Add(s.lockoutDuration)
+		if _, err := s.db.Exec(
+			"UPDATE users SET failed_attempts = ?, locked_until = ? WHERE id = ?",
+			attempts, lockedUntil, user.ID,
+		); err != nil {
+			return fmt.Errorf("failed to record failed attempt: %w", err)
+		}
+		return nil
+	}
+
+	if _, err := s.db.Exec(
+		"UPDATE users SET failed_attempts = ? WHERE id = ?",
+		attempts, user.ID,
+	); err != nil {
+		return fmt.Errorf("failed to record failed attempt: %w", err)
+	}
+	return nil
+}
+
+// Unlock clears a user's lockout and failed-login counter, allowing an admin
+// to restore access before the lockout window expires.
+func (s *Store) Unlock(email string) error {
+	result, err := s.db.Exec(
+		"UPDATE users SET failed_attempts = 0, locked_until = NULL WHERE email = ?",
+		email,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to unlock user: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to unlock user: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("user not found")
+	}
+
+	return nil
+}
+
+// ChangePassword updates a user's password after verifying the old one. The
+// new password goes through the same validation as registration.
+func (s *Store) ChangePassword(email, oldPassword, newPassword string) error {
+	var userID int64
+	var passwordHash string
+
+	err := s.db.QueryRow(
+		"SELECT id, password_hash FROM users WHERE email = ?",
+		email,
+	).Scan(&userID, &passwordHash)
+
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("invalid credentials")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to query user: %w", err)
+	}
+
+	// Verify the old password before accepting a new one
+	if err := bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(oldPassword)); err != nil {
+		return fmt.Errorf("invalid credentials")
+	}
+
+	if err := validatePassword(newPassword); err != nil {
+		return err
+	}
+
+	return s.updatePassword(userID, newPassword)
+}
+
+// GenerateResetToken creates a single-use, time-limited password reset token
+// for the user. Only a hash of the token is stored; the plaintext is returned
+// once and cannot be recovered.
+func (s *Store) GenerateResetToken(email string) (string, error) {
+	var userID int64
+
+	err := s.db.QueryRow("SELECT id FROM users WHERE email = ?", email).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return "", fmt.Errorf("user not found")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to query user: %w", err)
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate reset token: %w", err)
+	}
+
+	now := time.Now()
+	_, err = s.db.Exec(
+		"INSERT INTO reset_tokens (user_id, token_hash, expires_at, created_at) VALUES (?, ?, ?, ?)",
+		userID, hashResetToken(token), now.Add(ResetTokenDuration), now,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to store reset token: %w", err)
+	}
+
+	return token, nil
+}
+
+// ResetPassword sets a new password using a reset token. The token is
+// consumed on use regardless of outcome once matched, so it cannot be
+// replayed. The new password goes through the same validation as
+// registration.
+func (s *Store) ResetPassword(token, newPassword string) error {
+	var tokenID int64
+	var userID int64
+	var expiresAt time.Time
+
+	err := s.db.QueryRow(
+		"SELECT id, user_id, expires_at FROM reset_tokens WHERE token_hash = ?",
+		hashResetToken(token),
+	).Scan(&tokenID, &userID, &expiresAt)
+
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("invalid reset token")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to query reset token: %w", err)
+	}
+
+	// Single use: remove the token before touching the password
+	if _, err := s.db.Exec("DELETE FROM reset_tokens WHERE id = ?", tokenID); err != nil {
+		return fmt.Errorf("failed to consume reset token: %w", err)
+	}
+
+	if time.Now().After(expiresAt) {
+		return fmt.Errorf("reset token expired")
+	}
+
+	if err := validatePassword(newPassword); err != nil {
+		return err
+	}
+
+	if err := s.updatePassword(userID, newPassword); err != nil {
+		return err
+	}
+
+	// The user proved ownership, so clear any lockout state
+	if _, err := s.db.Exec(
+		"UPDATE users SET failed_attempts = 0, locked_until = NULL WHERE id = ?",
+		userID,
+	); err != nil {
+		return fmt.Errorf("failed to reset failed attempts: %w", err)
+	}
+
+	return nil
+}
+
+// updatePassword hashes and stores a new password for the user.
+func (s *Store) updatePassword(userID int64, password string) error {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), s.bcryptCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	if _, err := s.db.Exec(
+		"UPDATE users SET password_hash = ?, updated_at = ? WHERE id = ?",
+		string(passwordHash), time.Now(), userID,
+	); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	return nil
+}
+
 // ValidateToken validates an access token and returns the user ID.
 func (s *Store) ValidateToken(accessToken string) (int64, error) {
 	var userID int64
@@ -283,11 +571,12 @@ func (s *Store) RefreshSession(refreshToken string) (*Session, error) {
 // GetUser returns a user by ID.
 func (s *Store) GetUser(userID int64) (*User, error) {
 	var user User
+	var lockedUntil sql.NullTime
 
 	err := s.db.QueryRow(
-		"SELECT id, email, password_hash, created_at, updated_at FROM users WHERE id = ?",
+		"SELECT id, email, password_hash, failed_attempts, locked_until, created_at, updated_at FROM users WHERE id = ?",
 		userID,
-	).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.CreatedAt, &user.UpdatedAt)
+	).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.FailedAttempts, &lockedUntil, &user.CreatedAt, &user.UpdatedAt)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("user not found")
@@ -296,6 +585,10 @@ func (s *Store) GetUser(userID int64) (*User, error) {
 		return nil, fmt.Errorf("failed to query user: %w", err)
 	}
 
+	if lockedUntil.Valid {
+		user.LockedUntil = lockedUntil.Time
+	}
+
 	return &user, nil
 }
 
@@ -327,6 +620,21 @@ func (s *Session) ToTokenPair() *jwt.TokenPair {
 	}
 }
 
+// validatePassword enforces the password rules applied at registration.
+func validatePassword(password string) error {
+	if password == "" {
+		return fmt.Errorf("password cannot be empty")
+	}
+	return nil
+}
+
+// hashResetToken returns the hex-encoded SHA-256 hash under which a reset
+// token is stored.
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
 // generateToken generates a random token string.
 func generateToken() (string, error) {
 	b := make([]byte, 32)
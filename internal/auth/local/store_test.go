@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // setupTestStore creates a temporary SQLite database for testing.
@@ -221,6 +222,227 @@ func TestRefreshSession(t *testing.T) {
 	})
 }
 
+func TestAccountLockout(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	email := "lockout@example.com"
+	password := "correct-password"
+	err := store.Register(email, password)
+	require.NoError(t, err)
+
+	t.Run("locks after repeated failures", func(t *testing.T) {
+		for i := 0; i < DefaultMaxFailedAttempts; i++ {
+			_, err := store.Authenticate(email, "wrong-password")
+			assert.Error(t, err)
+			assert.NotErrorIs(t, err, ErrAccountLocked)
+		}
+
+		// Even the correct password is rejected while locked
+		session, err := store.Authenticate(email, password)
+		assert.ErrorIs(t, err, ErrAccountLocked)
+		assert.Nil(t, session)
+	})
+
+	t.Run("unlock restores access", func(t *testing.T) {
+		err := store.Unlock(email)
+		require.NoError(t, err)
+
+		session, err := store.Authenticate(email, password)
+		assert.NoError(t, err)
+		assert.NotNil(t, session)
+	})
+
+	t.Run("unlock unknown user errors", func(t *testing.T) {
+		err := store.Unlock("nobody@example.com")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "user not found")
+	})
+}
+
+func TestAccountLockoutReset(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	email := "reset@example.com"
+	password := "correct-password"
+	err := store.Register(email, password)
+	require.NoError(t, err)
+
+	// A few failures followed by a success should reset the counter
+	for i := 0; i < DefaultMaxFailedAttempts-1; i++ {
+		_, err := store.Authenticate(email, "wrong-password")
+		assert.Error(t, err)
+	}
+
+	session, err := store.Authenticate(email, password)
+	require.NoError(t, err)
+
+	user, err := store.GetUser(session.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, user.FailedAttempts)
+	assert.True(t, user.LockedUntil.IsZero())
+
+	// The reset counter means one more failure does not lock the account
+	_, err = store.Authenticate(email, "wrong-password")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrAccountLocked)
+}
+
+func TestAccountLockoutSurvivesRestart(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "local-auth-test-lockout-*")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	store, err := NewStore(dbPath, WithLockoutPolicy(2, time.Hour))
+	require.NoError(t, err)
+
+	email := "restart@example.com"
+	password := "correct-password"
+	require.NoError(t, store.Register(email, password))
+
+	for i := 0; i < 2; i++ {
+		_, err := store.Authenticate(email, "wrong-password")
+		assert.Error(t, err)
+	}
+	require.NoError(t, store.Close())
+
+	// Reopen: the lockout is persisted in SQLite
+	store, err = NewStore(dbPath, WithLockoutPolicy(2, time.Hour))
+	require.NoError(t, err)
+	defer store.Close()
+
+	_, err = store.Authenticate(email, password)
+	assert.ErrorIs(t, err, ErrAccountLocked)
+}
+
+func TestChangePassword(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	email := "change@example.com"
+	oldPassword := "old-password"
+	newPassword := "new-password"
+	require.NoError(t, store.Register(email, oldPassword))
+
+	t.Run("wrong old password", func(t *testing.T) {
+		err := store.ChangePassword(email, "not-the-password", newPassword)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid credentials")
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		err := store.ChangePassword("nobody@example.com", oldPassword, newPassword)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid credentials")
+	})
+
+	t.Run("empty new password", func(t *testing.T) {
+		err := store.ChangePassword(email, oldPassword, "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "password cannot be empty")
+	})
+
+	t.Run("successful change", func(t *testing.T) {
+		err := store.ChangePassword(email, oldPassword, newPassword)
+		require.NoError(t, err)
+
+		// Old password no longer works, new one does
+		_, err = store.Authenticate(email, oldPassword)
+		assert.Error(t, err)
+
+		session, err := store.Authenticate(email, newPassword)
+		assert.NoError(t, err)
+		assert.NotNil(t, session)
+	})
+}
+
+func TestResetPassword(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	email := "resettoken@example.com"
+	password := "original-password"
+	require.NoError(t, store.Register(email, password))
+
+	t.Run("unknown user", func(t *testing.T) {
+		_, err := store.GenerateResetToken("nobody@example.com")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "user not found")
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		err := store.ResetPassword("not-a-real-token", "whatever-password")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid reset token")
+	})
+
+	t.Run("successful reset consumes the token", func(t *testing.T) {
+		token, err := store.GenerateResetToken(email)
+		require.NoError(t, err)
+		assert.NotEmpty(t, token)
+
+		err = store.ResetPassword(token, "reset-password")
+		require.NoError(t, err)
+
+		session, err := store.Authenticate(email, "reset-password")
+		assert.NoError(t, err)
+		assert.NotNil(t, session)
+
+		// Reusing the token fails: it was single use
+		err = store.ResetPassword(token, "another-password")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid reset token")
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		token, err := store.GenerateResetToken(email)
+		require.NoError(t, err)
+
+		// Age the token past its expiry
+		_, err = store.db.Exec(
+			"UPDATE reset_tokens SET expires_at = ? WHERE token_hash = ?",
+			time.Now().Add(-1*time.Minute), hashResetToken(token),
+		)
+		require.NoError(t, err)
+
+		err = store.ResetPassword(token, "late-password")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "reset token expired")
+
+		// Expired tokens are also consumed
+		err = store.ResetPassword(token, "late-password")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid reset token")
+	})
+
+	t.Run("empty new password", func(t *testing.T) {
+		token, err := store.GenerateResetToken(email)
+		require.NoError(t, err)
+
+		err = store.ResetPassword(token, "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "password cannot be empty")
+	})
+
+	t.Run("reset clears lockout", func(t *testing.T) {
+		for i := 0; i < DefaultMaxFailedAttempts; i++ {
+			_, err := store.Authenticate(email, "wrong-password")
+			assert.Error(t, err)
+		}
+
+		token, err := store.GenerateResetToken(email)
+		require.NoError(t, err)
+		require.NoError(t, store.ResetPassword(token, "unlocked-password"))
+
+		session, err := store.Authenticate(email, "unlocked-password")
+		assert.NoError(t, err)
+		assert.NotNil(t, session)
+	})
+}
+
 func TestGetUser(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
@@ -376,6 +598,51 @@ func TestPasswordHashing(t *testing.T) {
 	})
 }
 
+func TestBcryptCostUpgrade(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "local-auth-test-cost-*")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	dbPath := filepath.Join(tmpDir, "test.db")
+	email := "cost@example.com"
+	password := "upgrade-password"
+
+	// Register at a low cost, as a weak CI machine might
+	lowStore, err := NewStore(dbPath, WithBcryptCost(bcrypt.MinCost))
+	require.NoError(t, err)
+	require.NoError(t, lowStore.Register(email, password))
+
+	session, err := lowStore.Authenticate(email, password)
+	require.NoError(t, err)
+
+	user, err := lowStore.GetUser(session.UserID)
+	require.NoError(t, err)
+
+	cost, err := bcrypt.Cost([]byte(user.PasswordHash))
+	require.NoError(t, err)
+	assert.Equal(t, bcrypt.MinCost, cost)
+	require.NoError(t, lowStore.Close())
+
+	// Reopen at a higher cost: a successful login rehashes in place
+	highStore, err := NewStore(dbPath, WithBcryptCost(bcrypt.MinCost+2))
+	require.NoError(t, err)
+	defer highStore.Close()
+
+	_, err = highStore.Authenticate(email, password)
+	require.NoError(t, err)
+
+	user, err = highStore.GetUser(session.UserID)
+	require.NoError(t, err)
+
+	cost, err = bcrypt.Cost([]byte(user.PasswordHash))
+	require.NoError(t, err)
+	assert.Equal(t, bcrypt.MinCost+2, cost)
+
+	// The upgraded hash still authenticates
+	_, err = highStore.Authenticate(email, password)
+	assert.NoError(t, err)
+}
+
 func TestGenerateToken(t *testing.T) {
 	token1, err := generateToken()
 	assert.NoError(t, err)
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -32,6 +34,14 @@ type Config struct {
 	// TokenURL is the token endpoint URL
 	TokenURL string
 
+	// DeviceAuthURL is the device authorization endpoint URL used by the
+	// RFC 8628 device-code flow (optional)
+	DeviceAuthURL string
+
+	// RevokeURL is the token revocation endpoint URL used by logout
+	// (RFC 7009, optional)
+	RevokeURL string
+
 	// ClientID is the OAuth client identifier
 	ClientID string
 
@@ -52,10 +62,29 @@ type Config struct {
 type Client struct {
 	config     Config
 	httpClient *http.Client
+
+	// Callback port range tried in order when starting the local server
+	portStart int
+	portEnd   int
+}
+
+// ClientOption configures a Client.
+type ClientOption func(*Client)
+
+// WithCallbackPorts sets an inclusive port range for the local callback
+// server. Ports are tried in order until one binds, and the chosen port is
+// threaded into the redirect URL. Invalid ranges are ignored.
+func WithCallbackPorts(start, end int) ClientOption {
+	return func(c *Client) {
+		if start > 0 && end >= start {
+			c.portStart = start
+			c.portEnd = end
+		}
+	}
 }
 
 // NewClient creates a new OAuth client with PKCE support.
-func NewClient(config Config) *Client {
+func NewClient(config Config, opts ...ClientOption) *Client {
 	if config.CallbackPort == 0 {
 		config.CallbackPort = DefaultCallbackPort
 	}
@@ -66,10 +95,18 @@ func NewClient(config Config) *Client {
 		}
 	}
 
-	return &Client{
+	client := &Client{
 		config:     config,
 		httpClient: config.HTTPClient,
+		portStart:  config.CallbackPort,
+		portEnd:    config.CallbackPort,
 	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client
 }
 
 // Authenticate performs the OAuth 2.0 authorization code flow with PKCE.
@@ -95,15 +132,28 @@ func (c *Client) Authenticate(ctx context.Context) (*jwt.TokenPair, error) {
 		return nil, fmt.Errorf("failed to generate state: %w", err)
 	}
 
+	// Bind the callback listener first so the redirect URL carries the port
+	// that actually bound
+	listener, port, err := c.bindCallbackListener()
+	if err != nil {
+		return nil, err
+	}
+
+	redirectURI, err := c.redirectURLForPort(port)
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
 	// Build authorization URL
-	authURL := c.buildAuthorizationURL(pkcePair.Challenge, state)
+	authURL := c.buildAuthorizationURL(pkcePair.Challenge, state, redirectURI)
 
 	// Print authorization URL for user to open
 	// In a real implementation, this would open the browser automatically
 	fmt.Printf("Please visit this URL to authorize:\n%s\n", authURL)
 
 	// Start callback server and get authorization code
-	code, receivedState, err := c.startCallbackServer(ctx)
+	code, receivedState, err := c.startCallbackServer(ctx, listener)
 	if err != nil {
 		return nil, fmt.Errorf("failed to receive authorization code: %w", err)
 	}
@@ -114,7 +164,7 @@ func (c *Client) Authenticate(ctx context.Context) (*jwt.TokenPair, error) {
 	}
 
 	// Exchange authorization code for tokens
-	tokens, err := c.exchangeCodeForTokens(ctx, code, pkcePair.Verifier)
+	tokens, err := c.exchangeCodeForTokens(ctx, code, pkcePair.Verifier, redirectURI)
 	if err != nil {
 		return nil, fmt.Errorf("failed to exchange code for tokens: %w", err)
 	}
@@ -122,12 +172,45 @@ func (c *Client) Authenticate(ctx context.Context) (*jwt.TokenPair, error) {
 	return tokens, nil
 }
 
+// bindCallbackListener tries each port in the configured range until one
+// binds, returning the listener and the port that succeeded.
+func (c *Client) bindCallbackListener() (net.Listener, int, error) {
+	for port := c.portStart; port <= c.portEnd; port++ {
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+		if err == nil {
+			return listener, port, nil
+		}
+	}
+
+	if c.portStart == c.portEnd {
+		return nil, 0, fmt.Errorf("callback port %d is in use: free it or configure a port range with WithCallbackPorts", c.portStart)
+	}
+	return nil, 0, fmt.Errorf("no free callback port in range %d-%d: free a port or configure a different range", c.portStart, c.portEnd)
+}
+
+// redirectURLForPort rewrites the configured redirect URL to use the port the
+// callback server actually bound.
+func (c *Client) redirectURLForPort(port int) (string, error) {
+	redirectURL, err := url.Parse(c.config.RedirectURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid redirect URL: %w", err)
+	}
+
+	host := redirectURL.Hostname()
+	if host == "" {
+		host = "localhost"
+	}
+	redirectURL.Host = net.JoinHostPort(host, strconv.Itoa(port))
+
+	return redirectURL.String(), nil
+}
+
 // buildAuthorizationURL builds the OAuth authorization URL with PKCE parameters.
-func (c *Client) buildAuthorizationURL(codeChallenge, state string) string {
+func (c *Client) buildAuthorizationURL(codeChallenge, state, redirectURI string) string {
 	params := url.Values{}
 	params.Set("response_type", "code")
 	params.Set("client_id", c.config.ClientID)
-	params.Set("redirect_uri", c.config.RedirectURL)
+	params.Set("redirect_uri", redirectURI)
 	params.Set("code_challenge", codeChallenge)
 	params.Set("code_challenge_method", PKCEChallengeMethod)
 	params.Set("state", state)
@@ -139,8 +222,9 @@ func (c *Client) buildAuthorizationURL(codeChallenge, state string) string {
 	return c.config.AuthURL + "?" + params.Encode()
 }
 
-// startCallbackServer starts a local HTTP server to receive the authorization code.
-func (c *Client) startCallbackServer(ctx context.Context) (code, state string, err error) {
+// startCallbackServer serves the callback on an already-bound listener and
+// waits for the authorization code.
+func (c *Client) startCallbackServer(ctx context.Context, listener net.Listener) (code, state string, err error) {
 	// Parse redirect URL to get path
 	redirectURL, err := url.Parse(c.config.RedirectURL)
 	if err != nil {
@@ -199,7 +283,6 @@ func (c *Client) startCallbackServer(ctx context.Context) (code, state string, e
 	})
 
 	server := &http.Server{
-		Addr:    fmt.Sprintf(":%d", c.config.CallbackPort),
 		Handler: mux,
 		// Security: Prevent Slowloris attacks
 		ReadHeaderTimeout: 5 * time.Second,
@@ -210,7 +293,7 @@ func (c *Client) startCallbackServer(ctx context.Context) (code, state string, e
 
 	// Start server in goroutine
 	go func() {
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
 			errChan <- fmt.Errorf("callback server error: %w", err)
 		}
 	}()
@@ -234,12 +317,12 @@ func (c *Client) startCallbackServer(ctx context.Context) (code, state string, e
 }
 
 // exchangeCodeForTokens exchanges an authorization code for access and refresh tokens.
-func (c *Client) exchangeCodeForTokens(ctx context.Context, code, codeVerifier string) (*jwt.TokenPair, error) {
+func (c *Client) exchangeCodeForTokens(ctx context.Context, code, codeVerifier, redirectURI string) (*jwt.TokenPair, error) {
 	// Build token request
 	data := url.Values{}
 	data.Set("grant_type", "authorization_code")
 	data.Set("code", code)
-	data.Set("redirect_uri", c.config.RedirectURL)
+	data.Set("redirect_uri", redirectURI)
 	data.Set("client_id", c.config.ClientID)
 	data.Set("code_verifier", codeVerifier)
 
@@ -351,14 +434,14 @@ func (c *Client) GetAuthorizationURL() (string, *PKCECodePair, string, error) {
 		return "", nil, "", fmt.Errorf("failed to generate state: %w", err)
 	}
 
-	authURL := c.buildAuthorizationURL(pkcePair.Challenge, state)
+	authURL := c.buildAuthorizationURL(pkcePair.Challenge, state, c.config.RedirectURL)
 
 	return authURL, pkcePair, state, nil
 }
 
 // ExchangeCode exchanges an authorization code for tokens (for manual flow).
 func (c *Client) ExchangeCode(ctx context.Context, code, codeVerifier string) (*jwt.TokenPair, error) {
-	return c.exchangeCodeForTokens(ctx, code, codeVerifier)
+	return c.exchangeCodeForTokens(ctx, code, codeVerifier, c.config.RedirectURL)
 }
 
 // TokenResponse represents the OAuth token endpoint response.
@@ -0,0 +1,97 @@
+package oauth
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// occupyPort grabs an OS-assigned port and keeps it bound for the test.
+func occupyPort(t *testing.T) int {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+func TestBindCallbackListener(t *testing.T) {
+	t.Run("falls back to the next free port", func(t *testing.T) {
+		busyPort := occupyPort(t)
+
+		client := NewClient(Config{
+			RedirectURL: fmt.Sprintf("http://localhost:%d/callback", busyPort),
+		}, WithCallbackPorts(busyPort, busyPort+20))
+
+		listener, port, err := client.bindCallbackListener()
+		require.NoError(t, err)
+		defer listener.Close()
+
+		assert.NotEqual(t, busyPort, port)
+		assert.Greater(t, port, busyPort)
+		assert.LessOrEqual(t, port, busyPort+20)
+	})
+
+	t.Run("errors when the only port is in use", func(t *testing.T) {
+		busyPort := occupyPort(t)
+
+		client := NewClient(Config{
+			CallbackPort: busyPort,
+			RedirectURL:  fmt.Sprintf("http://localhost:%d/callback", busyPort),
+		})
+
+		listener, _, err := client.bindCallbackListener()
+		require.Error(t, err)
+		assert.Nil(t, listener)
+		assert.Contains(t, err.Error(), "in use")
+		assert.Contains(t, err.Error(), "WithCallbackPorts")
+	})
+
+	t.Run("errors when the whole range is exhausted", func(t *testing.T) {
+		busyPort := occupyPort(t)
+
+		client := NewClient(Config{
+			RedirectURL: fmt.Sprintf("http://localhost:%d/callback", busyPort),
+		}, WithCallbackPorts(busyPort, busyPort))
+
+		listener, _, err := client.bindCallbackListener()
+		require.Error(t, err)
+		assert.Nil(t, listener)
+	})
+}
+
+func TestRedirectURLForPort(t *testing.T) {
+	client := NewClient(Config{
+		RedirectURL: "http://localhost:8080/callback",
+	})
+
+	redirectURI, err := client.redirectURLForPort(9999)
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:9999/callback", redirectURI)
+}
+
+func TestAuthorizationURLUsesChosenPort(t *testing.T) {
+	busyPort := occupyPort(t)
+
+	client := NewClient(Config{
+		AuthURL:     "https://auth.example.com/authorize",
+		ClientID:    "test-client-id",
+		RedirectURL: fmt.Sprintf("http://localhost:%d/callback", busyPort),
+	}, WithCallbackPorts(busyPort, busyPort+20))
+
+	listener, port, err := client.bindCallbackListener()
+	require.NoError(t, err)
+	defer listener.Close()
+
+	redirectURI, err := client.redirectURLForPort(port)
+	require.NoError(t, err)
+
+	authURL := client.buildAuthorizationURL("challenge", "state", redirectURI)
+	assert.Contains(t, authURL, "%3A"+strconv.Itoa(port)+"%2Fcallback")
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/AINative-studio/ainative-code/internal/auth/jwt"
 	"github.com/AINative-studio/ainative-code/internal/auth/oauth"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -460,3 +461,105 @@ func simulateOAuthCallback(t *testing.T, redirectURL, code, state string) {
 		defer resp.Body.Close()
 	}
 }
+
+func TestRevokeToken(t *testing.T) {
+	t.Run("revokes token at endpoint", func(t *testing.T) {
+		var gotToken, gotHint, gotClientID string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, r.ParseForm())
+			gotToken = r.Form.Get("token")
+			gotHint = r.Form.Get("token_type_hint")
+			gotClientID = r.Form.Get("client_id")
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := oauth.NewClient(oauth.Config{
+			ClientID:  "test-client",
+			RevokeURL: server.URL,
+		})
+
+		err := client.RevokeToken(context.Background(), "the-token", "refresh_token")
+		require.NoError(t, err)
+		assert.Equal(t, "the-token", gotToken)
+		assert.Equal(t, "refresh_token", gotHint)
+		assert.Equal(t, "test-client", gotClientID)
+	})
+
+	t.Run("empty token is a no-op", func(t *testing.T) {
+		called := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer server.Close()
+
+		client := oauth.NewClient(oauth.Config{RevokeURL: server.URL})
+		require.NoError(t, client.RevokeToken(context.Background(), "", "access_token"))
+		assert.False(t, called)
+	})
+
+	t.Run("errors without revocation endpoint", func(t *testing.T) {
+		client := oauth.NewClient(oauth.Config{})
+		err := client.RevokeToken(context.Background(), "token", "access_token")
+		assert.Error(t, err)
+	})
+
+	t.Run("errors on server failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client := oauth.NewClient(oauth.Config{RevokeURL: server.URL})
+		err := client.RevokeToken(context.Background(), "token", "access_token")
+		assert.Error(t, err)
+	})
+}
+
+func TestRevokeTokens(t *testing.T) {
+	t.Run("revokes both tokens", func(t *testing.T) {
+		var hints []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, r.ParseForm())
+			hints = append(hints, r.Form.Get("token_type_hint"))
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := oauth.NewClient(oauth.Config{RevokeURL: server.URL})
+		err := client.RevokeTokens(context.Background(), &jwt.TokenPair{
+			AccessToken:  "access",
+			RefreshToken: "refresh",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"access_token", "refresh_token"}, hints)
+	})
+
+	t.Run("continues past access token failure", func(t *testing.T) {
+		var hints []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, r.ParseForm())
+			hint := r.Form.Get("token_type_hint")
+			hints = append(hints, hint)
+			if hint == "access_token" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := oauth.NewClient(oauth.Config{RevokeURL: server.URL})
+		err := client.RevokeTokens(context.Background(), &jwt.TokenPair{
+			AccessToken:  "access",
+			RefreshToken: "refresh",
+		})
+		assert.Error(t, err)
+		assert.Equal(t, []string{"access_token", "refresh_token"}, hints)
+	})
+
+	t.Run("nil pair is a no-op", func(t *testing.T) {
+		client := oauth.NewClient(oauth.Config{})
+		assert.NoError(t, client.RevokeTokens(context.Background(), nil))
+	})
+}
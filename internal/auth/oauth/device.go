@@ -0,0 +1,218 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/AINative-studio/ainative-code/internal/auth/jwt"
+)
+
+const (
+	// DeviceGrantType is the RFC 8628 device authorization grant type
+	DeviceGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	// DefaultDeviceInterval is the polling interval used when the server
+	// does not specify one
+	DefaultDeviceInterval = 5 * time.Second
+
+	// slowDownBackoff is how much the polling interval grows on a
+	// slow_down response, per RFC 8628 section 3.5
+	slowDownBackoff = 5 * time.Second
+)
+
+// DeviceCodeResponse represents the device authorization endpoint response.
+type DeviceCodeResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval,omitempty"`
+}
+
+// deviceErrorResponse represents an OAuth error response from the token
+// endpoint during device-code polling.
+type deviceErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// AuthenticateDevice performs the OAuth 2.0 device authorization grant
+// (RFC 8628) for environments without a browser, such as SSH sessions or CI.
+//
+// This method:
+//  1. Requests a device code and user code from the device endpoint
+//  2. Prints the verification URL and user code for the user to enter
+//     on another device
+//  3. Polls the token endpoint at the server-specified interval until the
+//     user authorizes, the code expires, or the context is cancelled
+//
+// The authorization_pending and slow_down responses are handled per the RFC.
+func (c *Client) AuthenticateDevice(ctx context.Context) (*jwt.TokenPair, error) {
+	deviceResp, err := c.requestDeviceCode(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request device code: %w", err)
+	}
+
+	// Print the verification details for the user to complete on another
+	// device
+	fmt.Printf("To authenticate, visit:\n%s\nand enter the code: %s\n",
+		deviceResp.VerificationURI, deviceResp.UserCode)
+	if deviceResp.VerificationURIComplete != "" {
+		fmt.Printf("Or visit directly:\n%s\n", deviceResp.VerificationURIComplete)
+	}
+
+	return c.pollDeviceToken(ctx, deviceResp)
+}
+
+// requestDeviceCode requests a device and user code from the device
+// authorization endpoint.
+func (c *Client) requestDeviceCode(ctx context.Context) (*DeviceCodeResponse, error) {
+	if c.config.DeviceAuthURL == "" {
+		return nil, fmt.Errorf("no device authorization URL configured")
+	}
+
+	data := url.Values{}
+	data.Set("client_id", c.config.ClientID)
+	if len(c.config.Scopes) > 0 {
+		data.Set("scope", strings.Join(c.config.Scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.DeviceAuthURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("device code request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("device code request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var deviceResp DeviceCodeResponse
+	if err := json.Unmarshal(body, &deviceResp); err != nil {
+		return nil, fmt.Errorf("failed to parse device code response: %w", err)
+	}
+
+	if deviceResp.DeviceCode == "" || deviceResp.UserCode == "" {
+		return nil, fmt.Errorf("device code response missing device_code or user_code")
+	}
+
+	return &deviceResp, nil
+}
+
+// pollDeviceToken polls the token endpoint until the user authorizes, the
+// device code expires, or the context is cancelled.
+func (c *Client) pollDeviceToken(ctx context.Context, deviceResp *DeviceCodeResponse) (*jwt.TokenPair, error) {
+	interval := DefaultDeviceInterval
+	if deviceResp.Interval > 0 {
+		interval = time.Duration(deviceResp.Interval) * time.Second
+	}
+
+	var deadline <-chan time.Time
+	if deviceResp.ExpiresIn > 0 {
+		expiry := time.NewTimer(time.Duration(deviceResp.ExpiresIn) * time.Second)
+		defer expiry.Stop()
+		deadline = expiry.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline:
+			return nil, fmt.Errorf("device code expired before authorization")
+		case <-time.After(interval):
+		}
+
+		tokens, retryable, err := c.requestDeviceToken(ctx, deviceResp.DeviceCode)
+		if err != nil {
+			if !retryable {
+				return nil, err
+			}
+			// slow_down grows the interval per RFC 8628
+			if strings.Contains(err.Error(), "slow_down") {
+				interval += slowDownBackoff
+			}
+			continue
+		}
+
+		return tokens, nil
+	}
+}
+
+// requestDeviceToken makes one poll of the token endpoint. The retryable
+// return reports whether polling should continue (authorization_pending or
+// slow_down).
+func (c *Client) requestDeviceToken(ctx context.Context, deviceCode string) (*jwt.TokenPair, bool, error) {
+	data := url.Values{}
+	data.Set("grant_type", DeviceGrantType)
+	data.Set("device_code", deviceCode)
+	data.Set("client_id", c.config.ClientID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.TokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, false, fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp deviceErrorResponse
+		if json.Unmarshal(body, &errResp) == nil && errResp.Error != "" {
+			switch errResp.Error {
+			case "authorization_pending", "slow_down":
+				return nil, true, fmt.Errorf("authorization not complete: %s", errResp.Error)
+			case "expired_token":
+				return nil, false, fmt.Errorf("device code expired before authorization")
+			case "access_denied":
+				return nil, false, fmt.Errorf("authorization denied by user")
+			default:
+				return nil, false, fmt.Errorf("token request failed: %s", errResp.Error)
+			}
+		}
+		return nil, false, fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var tokenResp TokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return nil, false, fmt.Errorf("failed to parse token response: %w", err)
+	}
+
+	return &jwt.TokenPair{
+		AccessToken:  tokenResp.AccessToken,
+		RefreshToken: tokenResp.RefreshToken,
+		ExpiresIn:    tokenResp.ExpiresIn,
+		TokenType:    tokenResp.TokenType,
+	}, false, nil
+}
@@ -0,0 +1,137 @@
+package oauth_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/AINative-studio/ainative-code/internal/auth/oauth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newDeviceServer builds a test server serving the device authorization and
+// token endpoints. tokenHandler is invoked for each token poll.
+func newDeviceServer(t *testing.T, tokenHandler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "test-client-id", r.Form.Get("client_id"))
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"device_code":      "device-code-123",
+			"user_code":        "ABCD-EFGH",
+			"verification_uri": "https://auth.example.com/activate",
+			"expires_in":       60,
+			"interval":         1,
+		})
+	})
+	mux.HandleFunc("/token", tokenHandler)
+
+	return httptest.NewServer(mux)
+}
+
+func deviceClient(server *httptest.Server) *oauth.Client {
+	return oauth.NewClient(oauth.Config{
+		TokenURL:      server.URL + "/token",
+		DeviceAuthURL: server.URL + "/device",
+		ClientID:      "test-client-id",
+	})
+}
+
+func writeOAuthError(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
+
+func TestAuthenticateDevice(t *testing.T) {
+	t.Run("polls until authorized", func(t *testing.T) {
+		var polls int32
+		server := newDeviceServer(t, func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, oauth.DeviceGrantType, r.Form.Get("grant_type"))
+			assert.Equal(t, "device-code-123", r.Form.Get("device_code"))
+
+			// Pending on the first poll, authorized on the second
+			if atomic.AddInt32(&polls, 1) == 1 {
+				writeOAuthError(w, "authorization_pending")
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token":  "device-access-token",
+				"refresh_token": "device-refresh-token",
+				"token_type":    "Bearer",
+				"expires_in":    3600,
+			})
+		})
+		defer server.Close()
+
+		tokens, err := deviceClient(server).AuthenticateDevice(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "device-access-token", tokens.AccessToken)
+		assert.Equal(t, "device-refresh-token", tokens.RefreshToken)
+		assert.Equal(t, "Bearer", tokens.TokenType)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&polls))
+	})
+
+	t.Run("stops on access_denied", func(t *testing.T) {
+		server := newDeviceServer(t, func(w http.ResponseWriter, r *http.Request) {
+			writeOAuthError(w, "access_denied")
+		})
+		defer server.Close()
+
+		tokens, err := deviceClient(server).AuthenticateDevice(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, tokens)
+		assert.Contains(t, err.Error(), "denied")
+	})
+
+	t.Run("stops on expired_token", func(t *testing.T) {
+		server := newDeviceServer(t, func(w http.ResponseWriter, r *http.Request) {
+			writeOAuthError(w, "expired_token")
+		})
+		defer server.Close()
+
+		tokens, err := deviceClient(server).AuthenticateDevice(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, tokens)
+		assert.Contains(t, err.Error(), "expired")
+	})
+
+	t.Run("respects context cancellation while pending", func(t *testing.T) {
+		server := newDeviceServer(t, func(w http.ResponseWriter, r *http.Request) {
+			writeOAuthError(w, "authorization_pending")
+		})
+		defer server.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+		defer cancel()
+
+		tokens, err := deviceClient(server).AuthenticateDevice(ctx)
+		assert.Error(t, err)
+		assert.Nil(t, tokens)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("requires a device authorization URL", func(t *testing.T) {
+		client := oauth.NewClient(oauth.Config{
+			TokenURL: "https://auth.example.com/token",
+			ClientID: "test-client-id",
+		})
+
+		tokens, err := client.AuthenticateDevice(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, tokens)
+		assert.Contains(t, err.Error(), "no device authorization URL configured")
+	})
+}
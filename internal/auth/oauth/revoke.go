@@ -0,0 +1,74 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/AINative-studio/ainative-code/internal/auth/jwt"
+)
+
+// RevokeToken revokes a single token at the revocation endpoint (RFC 7009).
+// The tokenTypeHint is "access_token" or "refresh_token" and helps the
+// server locate the token; an empty hint is allowed.
+func (c *Client) RevokeToken(ctx context.Context, token, tokenTypeHint string) error {
+	if c.config.RevokeURL == "" {
+		return fmt.Errorf("no revocation endpoint configured")
+	}
+
+	if token == "" {
+		return nil
+	}
+
+	// Build revocation request
+	data := url.Values{}
+	data.Set("token", token)
+	if tokenTypeHint != "" {
+		data.Set("token_type_hint", tokenTypeHint)
+	}
+	data.Set("client_id", c.config.ClientID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.RevokeURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	// Execute request
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("revocation request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// RFC 7009: the server responds 200 even for tokens it does not know
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("revocation request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// RevokeTokens revokes both tokens of a pair, continuing past individual
+// failures so a dead access token does not leave the refresh token valid.
+// The first error encountered is returned.
+func (c *Client) RevokeTokens(ctx context.Context, tokens *jwt.TokenPair) error {
+	if tokens == nil {
+		return nil
+	}
+
+	var firstErr error
+	if err := c.RevokeToken(ctx, tokens.AccessToken, "access_token"); err != nil {
+		firstErr = err
+	}
+	if err := c.RevokeToken(ctx, tokens.RefreshToken, "refresh_token"); err != nil && firstErr == nil {
+		firstErr = err
+	}
+
+	return firstErr
+}
@@ -0,0 +1,112 @@
+package refresh
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AINative-studio/ainative-code/internal/auth/oauth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	base := 1 * time.Second
+
+	t.Run("grows exponentially with bounded jitter", func(t *testing.T) {
+		for retry := 1; retry <= 5; retry++ {
+			expected := base << (retry - 1)
+			delay := backoffDelay(retry, base)
+			assert.GreaterOrEqual(t, delay, expected, "retry %d", retry)
+			assert.LessOrEqual(t, delay, expected+expected/4, "retry %d", retry)
+		}
+	})
+
+	t.Run("caps at the maximum delay", func(t *testing.T) {
+		delay := backoffDelay(30, base)
+		assert.LessOrEqual(t, delay, maxBackoffDelay+maxBackoffDelay/4)
+	})
+}
+
+func TestRefreshWithRetry_BackoffGrowsAcrossFailures(t *testing.T) {
+	// OAuth server that always fails
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	failCalls := 0
+	manager := NewManager(Config{
+		OAuthClient: oauth.NewClient(oauth.Config{
+			TokenURL: server.URL,
+			ClientID: "test-client",
+		}),
+		MaxRetries:  4,
+		BackoffBase: 1 * time.Second,
+		OnRefreshFail: func(err error) bool {
+			failCalls++
+			return false
+		},
+	})
+
+	// Fake clock: record the requested delays instead of sleeping
+	var delays []time.Duration
+	manager.sleep = func(ctx context.Context, d time.Duration) bool {
+		delays = append(delays, d)
+		return true
+	}
+
+	err := manager.refreshWithRetry(context.Background(), "bad-refresh-token")
+	require.Error(t, err)
+
+	// Three sleeps between four attempts, each longer than the last
+	require.Len(t, delays, 3)
+	for i := 1; i < len(delays); i++ {
+		assert.Greater(t, delays[i], delays[i-1], "delay %d should exceed delay %d", i, i-1)
+	}
+
+	// OnRefreshFail fires once, after all retries are exhausted
+	assert.Equal(t, 1, failCalls)
+}
+
+func TestRefreshWithRetry_StopInterruptsBackoff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	failCalls := 0
+	manager := NewManager(Config{
+		OAuthClient: oauth.NewClient(oauth.Config{
+			TokenURL: server.URL,
+			ClientID: "test-client",
+		}),
+		MaxRetries:  10,
+		BackoffBase: 1 * time.Hour,
+		OnRefreshFail: func(err error) bool {
+			failCalls++
+			return false
+		},
+	})
+
+	// Closing stopChan while the manager waits must end the retry loop;
+	// the hour-long backoff would otherwise hang the test
+	close(manager.stopChan)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- manager.refreshWithRetry(context.Background(), "bad-refresh-token")
+	}()
+
+	select {
+	case err := <-done:
+		require.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("refreshWithRetry did not return after stop")
+	}
+
+	// The retry loop was abandoned early, so OnRefreshFail is not invoked
+	assert.Equal(t, 0, failCalls)
+}
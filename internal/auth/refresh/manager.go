@@ -3,6 +3,7 @@ package refresh
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -19,6 +20,12 @@ const (
 
 	// MinRefreshThreshold is the minimum allowed refresh threshold
 	MinRefreshThreshold = 1 * time.Minute
+
+	// DefaultBackoffBase is the initial delay between failed refresh attempts
+	DefaultBackoffBase = 1 * time.Second
+
+	// maxBackoffDelay caps the exponential backoff between refresh attempts
+	maxBackoffDelay = 2 * time.Minute
 )
 
 // Config represents the configuration for the refresh manager.
@@ -39,6 +46,16 @@ type Config struct {
 	// CheckInterval is how often to check expiration
 	// Default: 1 minute
 	CheckInterval time.Duration
+
+	// MaxRetries is how many consecutive refresh attempts are made before
+	// OnRefreshFail is invoked. The delay between attempts grows
+	// exponentially from BackoffBase with jitter.
+	// Default: 1 (fail after the first attempt)
+	MaxRetries int
+
+	// BackoffBase is the initial delay between failed refresh attempts
+	// Default: 1 second
+	BackoffBase time.Duration
 }
 
 // TokenStoreFunc is called to store refreshed tokens.
@@ -58,6 +75,21 @@ type Manager struct {
 	stoppedChan   chan struct{}
 	running       bool
 	lastRefreshAt time.Time
+
+	// sleep waits between retry attempts; it reports false when interrupted
+	// by Stop or context cancellation. Replaceable in tests for a fake clock.
+	sleep func(ctx context.Context, d time.Duration) bool
+
+	// flightMu guards inFlight so concurrent refreshes collapse into one
+	flightMu sync.Mutex
+	inFlight *flightCall
+}
+
+// flightCall tracks a refresh in progress so concurrent callers can wait for
+// its result instead of issuing duplicate requests.
+type flightCall struct {
+	done chan struct{}
+	err  error
 }
 
 // NewManager creates a new token refresh manager.
@@ -74,11 +106,38 @@ func NewManager(config Config) *Manager {
 		config.CheckInterval = DefaultCheckInterval
 	}
 
-	return &Manager{
+	if config.MaxRetries <= 0 {
+		config.MaxRetries = 1
+	}
+
+	if config.BackoffBase <= 0 {
+		config.BackoffBase = DefaultBackoffBase
+	}
+
+	m := &Manager{
 		config:      config,
 		stopChan:    make(chan struct{}),
 		stoppedChan: make(chan struct{}),
 	}
+	m.sleep = m.waitFor
+
+	return m
+}
+
+// waitFor sleeps for d, returning early with false when the manager is
+// stopped or the context is cancelled.
+func (m *Manager) waitFor(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-m.stopChan:
+		return false
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
 }
 
 // Start begins monitoring and refreshing tokens.
@@ -193,10 +252,78 @@ func (m *Manager) checkAndRefresh(ctx context.Context) error {
 	}
 
 	// Time to refresh
-	return m.performRefresh(ctx, refreshToken)
+	return m.singleFlightRefresh(ctx, refreshToken)
+}
+
+// singleFlightRefresh runs a refresh, collapsing concurrent callers (the
+// background goroutine and RefreshNow) into a single request whose result is
+// shared.
+func (m *Manager) singleFlightRefresh(ctx context.Context, refreshToken string) error {
+	m.flightMu.Lock()
+	if call := m.inFlight; call != nil {
+		m.flightMu.Unlock()
+		<-call.done
+		return call.err
+	}
+
+	call := &flightCall{done: make(chan struct{})}
+	m.inFlight = call
+	m.flightMu.Unlock()
+
+	call.err = m.refreshWithRetry(ctx, refreshToken)
+
+	m.flightMu.Lock()
+	m.inFlight = nil
+	m.flightMu.Unlock()
+	close(call.done)
+
+	return call.err
+}
+
+// refreshWithRetry attempts a refresh up to MaxRetries times, sleeping with
+// exponential backoff and jitter between failures. OnRefreshFail is invoked
+// only once all attempts are exhausted. Stop and context cancellation
+// interrupt the backoff sleep promptly.
+func (m *Manager) refreshWithRetry(ctx context.Context, refreshToken string) error {
+	var lastErr error
+
+	for attempt := 1; attempt <= m.config.MaxRetries; attempt++ {
+		if attempt > 1 {
+			if !m.sleep(ctx, backoffDelay(attempt-1, m.config.BackoffBase)) {
+				// Stopped or cancelled while waiting to retry
+				return lastErr
+			}
+		}
+
+		err := m.performRefresh(ctx, refreshToken)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+	}
+
+	if m.config.OnRefreshFail != nil {
+		if shouldReauth := m.config.OnRefreshFail(lastErr); shouldReauth {
+			return fmt.Errorf("refresh failed after %d attempts, re-authentication required: %w", m.config.MaxRetries, lastErr)
+		}
+	}
+
+	return fmt.Errorf("token refresh failed after %d attempts: %w", m.config.MaxRetries, lastErr)
+}
+
+// backoffDelay returns the delay before the given retry (1-based), doubling
+// from base each time with up to 25% random jitter, capped at maxBackoffDelay.
+func backoffDelay(retry int, base time.Duration) time.Duration {
+	delay := base << (retry - 1)
+	if delay <= 0 || delay > maxBackoffDelay {
+		delay = maxBackoffDelay
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(delay)/4 + 1))
+	return delay + jitter
 }
 
-// performRefresh executes the token refresh.
+// performRefresh executes a single token refresh attempt.
 func (m *Manager) performRefresh(ctx context.Context, refreshToken string) error {
 	if refreshToken == "" {
 		return fmt.Errorf("no refresh token available")
@@ -205,13 +332,6 @@ func (m *Manager) performRefresh(ctx context.Context, refreshToken string) error
 	// Call OAuth client to refresh
 	newTokens, err := m.config.OAuthClient.RefreshToken(ctx, refreshToken)
 	if err != nil {
-		// Handle refresh failure
-		if m.config.OnRefreshFail != nil {
-			shouldReauth := m.config.OnRefreshFail(err)
-			if shouldReauth {
-				return fmt.Errorf("refresh failed, re-authentication required: %w", err)
-			}
-		}
 		return fmt.Errorf("token refresh failed: %w", err)
 	}
 
@@ -236,6 +356,14 @@ func (m *Manager) performRefresh(ctx context.Context, refreshToken string) error
 
 // ForceRefresh immediately refreshes the tokens regardless of expiration time.
 func (m *Manager) ForceRefresh(ctx context.Context) error {
+	return m.RefreshNow(ctx)
+}
+
+// RefreshNow immediately refreshes the tokens. It coordinates with the
+// background goroutine through a single-flight mechanism, so a manual refresh
+// that overlaps a scheduled one shares its result instead of issuing a
+// duplicate request.
+func (m *Manager) RefreshNow(ctx context.Context) error {
 	m.mu.RLock()
 	refreshToken := ""
 	if m.tokens != nil {
@@ -247,7 +375,20 @@ func (m *Manager) ForceRefresh(ctx context.Context) error {
 		return fmt.Errorf("no refresh token available")
 	}
 
-	return m.performRefresh(ctx, refreshToken)
+	return m.singleFlightRefresh(ctx, refreshToken)
+}
+
+// NextRefreshAt returns when the next automatic refresh is scheduled: the
+// token expiry minus the refresh threshold. It returns the zero time when
+// monitoring hasn't started.
+func (m *Manager) NextRefreshAt() time.Time {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.expiresAt.IsZero() {
+		return time.Time{}
+	}
+	return m.expiresAt.Add(-m.config.RefreshThreshold)
 }
 
 // UpdateTokens manually updates the tokens (useful after re-authentication).
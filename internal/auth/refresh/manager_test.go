@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -481,3 +482,145 @@ func TestManager_TokenStoreError(t *testing.T) {
 		assert.True(t, manager.IsRunning())
 	})
 }
+
+func TestManager_NextRefreshAt(t *testing.T) {
+	t.Run("returns zero time before monitoring starts", func(t *testing.T) {
+		config := refresh.Config{
+			OAuthClient: &oauth.Client{},
+		}
+
+		manager := refresh.NewManager(config)
+		assert.True(t, manager.NextRefreshAt().IsZero())
+	})
+
+	t.Run("returns expiry minus threshold after start", func(t *testing.T) {
+		config := refresh.Config{
+			OAuthClient:      &oauth.Client{},
+			RefreshThreshold: 5 * time.Minute,
+		}
+
+		manager := refresh.NewManager(config)
+
+		tokens := &jwt.TokenPair{
+			AccessToken:  "test-access-token",
+			RefreshToken: "test-refresh-token",
+			ExpiresIn:    3600,
+			TokenType:    "Bearer",
+		}
+
+		err := manager.Start(context.Background(), tokens)
+		require.NoError(t, err)
+		defer manager.Stop()
+
+		expected := manager.GetExpiresAt().Add(-5 * time.Minute)
+		assert.Equal(t, expected, manager.NextRefreshAt())
+	})
+}
+
+func TestManager_RefreshNow(t *testing.T) {
+	t.Run("refreshes immediately", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(oauth.TokenResponse{
+				AccessToken:  "manual-access-token",
+				RefreshToken: "manual-refresh-token",
+				TokenType:    "Bearer",
+				ExpiresIn:    3600,
+			})
+		}))
+		defer server.Close()
+
+		oauthConfig := oauth.Config{
+			TokenURL: server.URL,
+			ClientID: "test-client",
+		}
+
+		config := refresh.Config{
+			OAuthClient: oauth.NewClient(oauthConfig),
+		}
+
+		manager := refresh.NewManager(config)
+
+		tokens := &jwt.TokenPair{
+			AccessToken:  "test-access-token",
+			RefreshToken: "test-refresh-token",
+			ExpiresIn:    3600,
+			TokenType:    "Bearer",
+		}
+
+		err := manager.Start(context.Background(), tokens)
+		require.NoError(t, err)
+		defer manager.Stop()
+
+		err = manager.RefreshNow(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, "manual-access-token", manager.GetTokens().AccessToken)
+	})
+
+	t.Run("concurrent refreshes collapse into one request", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			time.Sleep(100 * time.Millisecond)
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(oauth.TokenResponse{
+				AccessToken:  "shared-access-token",
+				RefreshToken: "shared-refresh-token",
+				TokenType:    "Bearer",
+				ExpiresIn:    3600,
+			})
+		}))
+		defer server.Close()
+
+		oauthConfig := oauth.Config{
+			TokenURL: server.URL,
+			ClientID: "test-client",
+		}
+
+		config := refresh.Config{
+			OAuthClient: oauth.NewClient(oauthConfig),
+		}
+
+		manager := refresh.NewManager(config)
+
+		tokens := &jwt.TokenPair{
+			AccessToken:  "test-access-token",
+			RefreshToken: "test-refresh-token",
+			ExpiresIn:    3600,
+			TokenType:    "Bearer",
+		}
+
+		err := manager.Start(context.Background(), tokens)
+		require.NoError(t, err)
+		defer manager.Stop()
+
+		var wg sync.WaitGroup
+		errs := make([]error, 4)
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = manager.RefreshNow(context.Background())
+			}(i)
+		}
+		wg.Wait()
+
+		for i, err := range errs {
+			assert.NoError(t, err, "caller %d", i)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests), "overlapping refreshes should share one request")
+	})
+
+	t.Run("fails when no refresh token", func(t *testing.T) {
+		config := refresh.Config{
+			OAuthClient: &oauth.Client{},
+		}
+
+		manager := refresh.NewManager(config)
+
+		err := manager.RefreshNow(context.Background())
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "no refresh token")
+	})
+}
@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding"
+	"encoding/hex"
+	"hash"
+	"strings"
+)
+
+// hashMemo remembers the hash state of the most recently hashed content so
+// that growing contexts (the common case: the same conversation plus a few
+// new messages) only hash the appended suffix instead of the full content.
+type hashMemo struct {
+	content string
+	state   []byte // marshaled sha256 state after hashing content
+}
+
+// ContentHash returns the stable SHA-256 hex digest of the given content.
+// Hashing is incremental: when content extends the previously hashed content,
+// only the new suffix is fed to the hash.
+func (m *Manager) ContentHash(content string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.contentHashLocked(content)
+}
+
+// contentHashLocked computes the content hash. Caller must hold m.mu.
+func (m *Manager) contentHashLocked(content string) string {
+	h := sha256.New()
+
+	if m.hashMemo != nil && strings.HasPrefix(content, m.hashMemo.content) {
+		// Resume from the saved state and hash only the appended suffix
+		if err := h.(encoding.BinaryUnmarshaler).UnmarshalBinary(m.hashMemo.state); err == nil {
+			h.Write([]byte(content[len(m.hashMemo.content):]))
+			m.saveHashMemo(h, content)
+			return digestHex(h)
+		}
+		h = sha256.New()
+	}
+
+	h.Write([]byte(content))
+	m.saveHashMemo(h, content)
+	return digestHex(h)
+}
+
+// saveHashMemo snapshots the hash state before finalization so a later call
+// can resume from it
+func (m *Manager) saveHashMemo(h hash.Hash, content string) {
+	if state, err := h.(encoding.BinaryMarshaler).MarshalBinary(); err == nil {
+		m.hashMemo = &hashMemo{content: content, state: state}
+	}
+}
+
+// digestHex finalizes the hash and returns the hex-encoded digest
+func digestHex(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ShouldCacheWithKey evaluates caching for the given content and returns the
+// cache-control along with the stable content hash. Identical content always
+// maps to the same hash, so the provider layer can attach the same cache
+// breakpoint across sessions and dedup ratios can be reported per hash.
+func (m *Manager) ShouldCacheWithKey(content string) (*CacheControl, string) {
+	hash := m.ContentHash(content)
+
+	control := m.ShouldCache(&CacheableContent{
+		Content:  content,
+		Type:     "system",
+		Length:   len(content),
+		CacheKey: hash,
+	})
+
+	return control, hash
+}
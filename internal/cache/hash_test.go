@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestManager() *Manager {
+	config := DefaultConfig()
+	config.AutoCleanup = false
+	return NewManager(config)
+}
+
+func TestContentHashStable(t *testing.T) {
+	manager := newTestManager()
+
+	content := "You are a helpful assistant."
+	want := sha256.Sum256([]byte(content))
+
+	hash1 := manager.ContentHash(content)
+	assert.Equal(t, hex.EncodeToString(want[:]), hash1)
+
+	// Same content from a different manager produces the same hash
+	other := newTestManager()
+	assert.Equal(t, hash1, other.ContentHash(content))
+}
+
+func TestContentHashIncremental(t *testing.T) {
+	manager := newTestManager()
+
+	base := strings.Repeat("context block ", 1000)
+	grown := base + "new message"
+
+	// Prime the memo, then hash the extended content
+	_ = manager.ContentHash(base)
+	got := manager.ContentHash(grown)
+
+	want := sha256.Sum256([]byte(grown))
+	assert.Equal(t, hex.EncodeToString(want[:]), got)
+
+	// Non-prefix content still hashes correctly
+	other := "completely different content"
+	wantOther := sha256.Sum256([]byte(other))
+	assert.Equal(t, hex.EncodeToString(wantOther[:]), manager.ContentHash(other))
+}
+
+func TestShouldCacheWithKey(t *testing.T) {
+	config := DefaultConfig()
+	config.AutoCleanup = false
+	config.MinPromptLength = 10
+	manager := NewManager(config)
+
+	content := strings.Repeat("system prompt ", 100)
+
+	control, hash := manager.ShouldCacheWithKey(content)
+	require.NotNil(t, control)
+	assert.Equal(t, hash, control.CacheKey)
+	assert.Equal(t, "ephemeral", control.Type)
+
+	// Identical content from another session maps to the same key
+	control2, hash2 := manager.ShouldCacheWithKey(content)
+	require.NotNil(t, control2)
+	assert.Equal(t, hash, hash2)
+
+	// Short content is not cacheable but still gets a stable hash
+	control3, hash3 := manager.ShouldCacheWithKey("short")
+	assert.Nil(t, control3)
+	assert.NotEmpty(t, hash3)
+}
+
+func TestShouldCacheWithKeyIsCachedLookup(t *testing.T) {
+	config := DefaultConfig()
+	config.AutoCleanup = false
+	config.MinPromptLength = 10
+	manager := NewManager(config)
+
+	content := strings.Repeat("shared system prompt ", 100)
+
+	_, hash := manager.ShouldCacheWithKey(content)
+	assert.False(t, manager.IsCached(hash))
+
+	manager.RecordCached(hash, int64(len(content)))
+	assert.True(t, manager.IsCached(hash))
+}
@@ -1,21 +1,27 @@
 package cache
 
 import (
+	"container/list"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 )
 
 // Manager manages prompt caching
 type Manager struct {
-	config  Config
-	metrics *CacheMetrics
-	cache   map[string]*CacheStatus
-	mu      sync.RWMutex
-	stopCh  chan struct{}
-	wg      sync.WaitGroup
+	config   Config
+	metrics  *CacheMetrics
+	cache    map[string]*CacheStatus
+	lru      *list.List               // Most-recently-used entries at the front
+	lruIndex map[string]*list.Element // Cache key -> LRU list element, for O(1) access
+	hashMemo *hashMemo                // Last hashed content state for incremental hashing
+	mu       sync.RWMutex
+	stopCh   chan struct{}
+	wg       sync.WaitGroup
 }
 
 // NewManager creates a new cache manager
@@ -23,11 +29,15 @@ func NewManager(config Config) *Manager {
 	manager := &Manager{
 		config: config,
 		metrics: &CacheMetrics{
-			LastReset:  time.Now(),
-			CacheByKey: make(map[string]*CacheKeyMetrics),
+			LastReset:       time.Now(),
+			CacheByKey:      make(map[string]*CacheKeyMetrics),
+			CacheByCategory: make(map[string]*CacheGroupMetrics),
+			CacheByModel:    make(map[string]*CacheGroupMetrics),
 		},
-		cache:  make(map[string]*CacheStatus),
-		stopCh: make(chan struct{}),
+		cache:    make(map[string]*CacheStatus),
+		lru:      list.New(),
+		lruIndex: make(map[string]*list.Element),
+		stopCh:   make(chan struct{}),
 	}
 
 	// Start auto-cleanup if enabled
@@ -80,9 +90,21 @@ func (m *Manager) ShouldCache(content *CacheableContent) *CacheControl {
 		Breakpoint: content.Breakpoint,
 	}
 
-	// Add TTL if configured
-	if m.config.TTL > 0 {
-		control.TTL = int(m.config.TTL.Seconds())
+	// Add TTL if configured, preferring the type-specific tier: stable
+	// system prompts usually outlive churning retrieved context
+	ttl := m.config.TTL
+	switch content.Type {
+	case "system":
+		if m.config.SystemPromptTTL > 0 {
+			ttl = m.config.SystemPromptTTL
+		}
+	case "context":
+		if m.config.ContextTTL > 0 {
+			ttl = m.config.ContextTTL
+		}
+	}
+	if ttl > 0 {
+		control.TTL = int(ttl.Seconds())
 	}
 
 	return control
@@ -97,14 +119,37 @@ func (m *Manager) generateCacheKey(content, contentType string) string {
 	return hex.EncodeToString(h.Sum(nil))[:16] // Use first 16 chars
 }
 
+// bytesPerToken is the rough byte-to-token ratio used to estimate how many
+// prompt tokens a cache hit avoided reprocessing
+const bytesPerToken = 4
+
 // RecordCacheHit records a cache hit for metrics
 func (m *Manager) RecordCacheHit(cacheKey string) {
+	m.RecordCacheHitFor(cacheKey, "", "")
+}
+
+// RecordCacheHitFor records a cache hit attributed to a prompt category
+// (CategorySystemPrompt or CategoryLargeContext) and a model. Empty category
+// or model skips the corresponding breakdown.
+func (m *Manager) RecordCacheHitFor(cacheKey, category, model string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	m.metrics.TotalRequests++
 	m.metrics.CacheHits++
 
+	// Estimate tokens saved from the cached entry size
+	var tokensSaved int64
+	if status, exists := m.cache[cacheKey]; exists {
+		tokensSaved = status.BytesSize / bytesPerToken
+	}
+	if category != "" {
+		m.recordGroupLocked(m.metrics.CacheByCategory, category, true, tokensSaved)
+	}
+	if model != "" {
+		m.recordGroupLocked(m.metrics.CacheByModel, model, true, tokensSaved)
+	}
+
 	// Update key-specific metrics
 	if keyMetrics, exists := m.metrics.CacheByKey[cacheKey]; exists {
 		keyMetrics.Hits++
@@ -125,17 +170,31 @@ func (m *Manager) RecordCacheHit(cacheKey string) {
 		status.LastAccess = time.Now()
 	}
 
+	m.touchLocked(cacheKey)
 	m.updateHitRate()
 }
 
 // RecordCacheMiss records a cache miss for metrics
 func (m *Manager) RecordCacheMiss(cacheKey string) {
+	m.RecordCacheMissFor(cacheKey, "", "")
+}
+
+// RecordCacheMissFor records a cache miss attributed to a prompt category
+// and a model. Empty category or model skips the corresponding breakdown.
+func (m *Manager) RecordCacheMissFor(cacheKey, category, model string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	m.metrics.TotalRequests++
 	m.metrics.CacheMisses++
 
+	if category != "" {
+		m.recordGroupLocked(m.metrics.CacheByCategory, category, false, 0)
+	}
+	if model != "" {
+		m.recordGroupLocked(m.metrics.CacheByModel, model, false, 0)
+	}
+
 	// Update key-specific metrics
 	if keyMetrics, exists := m.metrics.CacheByKey[cacheKey]; exists {
 		keyMetrics.Misses++
@@ -177,6 +236,65 @@ func (m *Manager) RecordCached(cacheKey string, bytesSize int64) {
 		HitCount:   0,
 		LastAccess: time.Now(),
 	}
+
+	m.touchLocked(cacheKey)
+	m.evictLocked()
+}
+
+// recordGroupLocked updates a category or model breakdown entry. Caller must
+// hold m.mu.
+func (m *Manager) recordGroupLocked(groups map[string]*CacheGroupMetrics, name string, hit bool, tokensSaved int64) {
+	group, exists := groups[name]
+	if !exists {
+		group = &CacheGroupMetrics{Name: name}
+		groups[name] = group
+	}
+
+	if hit {
+		group.Hits++
+		group.TokensSaved += tokensSaved
+	} else {
+		group.Misses++
+	}
+
+	group.HitRate = float64(group.Hits) / float64(group.Hits+group.Misses)
+}
+
+// touchLocked marks a key as most recently used. Caller must hold m.mu.
+func (m *Manager) touchLocked(cacheKey string) {
+	if elem, exists := m.lruIndex[cacheKey]; exists {
+		m.lru.MoveToFront(elem)
+		return
+	}
+	m.lruIndex[cacheKey] = m.lru.PushFront(cacheKey)
+}
+
+// evictLocked removes least-recently-used entries until the entry count is
+// within Config.MaxEntries. Caller must hold m.mu.
+func (m *Manager) evictLocked() {
+	if m.config.MaxEntries <= 0 {
+		return
+	}
+
+	for len(m.cache) > m.config.MaxEntries {
+		oldest := m.lru.Back()
+		if oldest == nil {
+			return
+		}
+		key := oldest.Value.(string)
+		m.removeLocked(key)
+		m.metrics.Evictions++
+	}
+}
+
+// removeLocked removes a key from the cache and LRU bookkeeping. Caller must
+// hold m.mu.
+func (m *Manager) removeLocked(cacheKey string) {
+	delete(m.cache, cacheKey)
+	if elem, exists := m.lruIndex[cacheKey]; exists {
+		m.lru.Remove(elem)
+		delete(m.lruIndex, cacheKey)
+	}
 }
 
 // RecordBytesSaved records bytes saved due to caching
@@ -194,14 +312,17 @@ func (m *Manager) GetStats() *CacheMetrics {
 
 	// Create a copy to avoid concurrent access issues
 	stats := &CacheMetrics{
-		TotalRequests:  m.metrics.TotalRequests,
-		CacheHits:      m.metrics.CacheHits,
-		CacheMisses:    m.metrics.CacheMisses,
-		BytesCached:    m.metrics.BytesCached,
-		BytesSaved:     m.metrics.BytesSaved,
-		AverageHitRate: m.metrics.AverageHitRate,
-		LastReset:      m.metrics.LastReset,
-		CacheByKey:     make(map[string]*CacheKeyMetrics),
+		TotalRequests:   m.metrics.TotalRequests,
+		CacheHits:       m.metrics.CacheHits,
+		CacheMisses:     m.metrics.CacheMisses,
+		Evictions:       m.metrics.Evictions,
+		BytesCached:     m.metrics.BytesCached,
+		BytesSaved:      m.metrics.BytesSaved,
+		AverageHitRate:  m.metrics.AverageHitRate,
+		LastReset:       m.metrics.LastReset,
+		CacheByKey:      make(map[string]*CacheKeyMetrics),
+		CacheByCategory: make(map[string]*CacheGroupMetrics),
+		CacheByModel:    make(map[string]*CacheGroupMetrics),
 	}
 
 	// Copy key metrics
@@ -210,6 +331,16 @@ func (m *Manager) GetStats() *CacheMetrics {
 		stats.CacheByKey[k] = &keyMetricsCopy
 	}
 
+	// Copy category and model breakdowns
+	for k, v := range m.metrics.CacheByCategory {
+		groupCopy := *v
+		stats.CacheByCategory[k] = &groupCopy
+	}
+	for k, v := range m.metrics.CacheByModel {
+		groupCopy := *v
+		stats.CacheByModel[k] = &groupCopy
+	}
+
 	return stats
 }
 
@@ -249,7 +380,7 @@ func (m *Manager) InvalidateCache(cacheKey string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	delete(m.cache, cacheKey)
+	m.removeLocked(cacheKey)
 }
 
 // ClearCache clears all cached entries
@@ -258,6 +389,8 @@ func (m *Manager) ClearCache() {
 	defer m.mu.Unlock()
 
 	m.cache = make(map[string]*CacheStatus)
+	m.lru.Init()
+	m.lruIndex = make(map[string]*list.Element)
 }
 
 // ResetMetrics resets all cache metrics
@@ -266,8 +399,10 @@ func (m *Manager) ResetMetrics() {
 	defer m.mu.Unlock()
 
 	m.metrics = &CacheMetrics{
-		LastReset:  time.Now(),
-		CacheByKey: make(map[string]*CacheKeyMetrics),
+		LastReset:       time.Now(),
+		CacheByKey:      make(map[string]*CacheKeyMetrics),
+		CacheByCategory: make(map[string]*CacheGroupMetrics),
+		CacheByModel:    make(map[string]*CacheGroupMetrics),
 	}
 }
 
@@ -317,7 +452,7 @@ func (m *Manager) cleanup() {
 	now := time.Now()
 	for key, status := range m.cache {
 		if now.After(status.ExpiresAt) {
-			delete(m.cache, key)
+			m.removeLocked(key)
 		}
 	}
 }
@@ -389,3 +524,49 @@ func (m *Manager) FormatStats() string {
 		len(stats.CacheByKey),
 		stats.LastReset.Format(time.RFC3339))
 }
+
+// StatsReport formats a cache-effectiveness report with per-category and
+// per-model breakdowns as a readable table. It is meant to answer whether
+// system-prompt or context caching is paying off for each model, e.g. when
+// deciding to raise MinPromptLength or disable caching for a model.
+func (m *Manager) StatsReport() string {
+	stats := m.GetStats()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Cache Effectiveness Report (since %s)\n", stats.LastReset.Format(time.RFC3339))
+	fmt.Fprintf(&b, "Overall: %d requests, %.2f%% hit rate, ~%d tokens saved\n",
+		stats.TotalRequests, stats.AverageHitRate*100, stats.BytesSaved/bytesPerToken)
+
+	b.WriteString("\nBy category:\n")
+	writeGroupTable(&b, stats.CacheByCategory)
+
+	b.WriteString("\nBy model:\n")
+	writeGroupTable(&b, stats.CacheByModel)
+
+	return b.String()
+}
+
+// writeGroupTable renders one breakdown table with stable row ordering
+func writeGroupTable(b *strings.Builder, groups map[string]*CacheGroupMetrics) {
+	if len(groups) == 0 {
+		b.WriteString("  (no data)\n")
+		return
+	}
+
+	names := make([]string, 0, len(groups))
+	width := len("Name")
+	for name := range groups {
+		names = append(names, name)
+		if len(name) > width {
+			width = len(name)
+		}
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(b, "  %-*s  %8s  %8s  %8s  %12s\n", width, "Name", "Hits", "Misses", "Hit Rate", "Tokens Saved")
+	for _, name := range names {
+		group := groups[name]
+		fmt.Fprintf(b, "  %-*s  %8d  %8d  %7.2f%%  %12d\n",
+			width, group.Name, group.Hits, group.Misses, group.HitRate*100, group.TokensSaved)
+	}
+}
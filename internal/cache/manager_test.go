@@ -419,3 +419,200 @@ func BenchmarkRecordCacheHit(b *testing.B) {
 		manager.RecordCacheHit("test-key")
 	}
 }
+
+func TestLRUEviction(t *testing.T) {
+	config := DefaultConfig()
+	config.AutoCleanup = false
+	config.MaxEntries = 2
+	manager := NewManager(config)
+
+	manager.RecordCached("key-1", 100)
+	manager.RecordCached("key-2", 100)
+	manager.RecordCached("key-3", 100)
+
+	// key-1 was least recently used and should have been evicted
+	assert.False(t, manager.IsCached("key-1"))
+	assert.True(t, manager.IsCached("key-2"))
+	assert.True(t, manager.IsCached("key-3"))
+
+	stats := manager.GetStats()
+	assert.Equal(t, int64(1), stats.Evictions)
+}
+
+func TestLRUEvictionRespectsRecentAccess(t *testing.T) {
+	config := DefaultConfig()
+	config.AutoCleanup = false
+	config.MaxEntries = 2
+	manager := NewManager(config)
+
+	manager.RecordCached("key-1", 100)
+	manager.RecordCached("key-2", 100)
+
+	// Touch key-1 so key-2 becomes the LRU entry
+	manager.RecordCacheHit("key-1")
+
+	manager.RecordCached("key-3", 100)
+
+	assert.True(t, manager.IsCached("key-1"))
+	assert.False(t, manager.IsCached("key-2"))
+	assert.True(t, manager.IsCached("key-3"))
+}
+
+func TestLRUUnlimitedWhenMaxEntriesZero(t *testing.T) {
+	config := DefaultConfig()
+	config.AutoCleanup = false
+	config.MaxEntries = 0
+	manager := NewManager(config)
+
+	for i := 0; i < 100; i++ {
+		manager.RecordCached(string(rune('a'+i%26))+string(rune('0'+i/26)), 10)
+	}
+
+	stats := manager.GetStats()
+	assert.Equal(t, int64(0), stats.Evictions)
+}
+
+func TestLRUEvictionConcurrent(t *testing.T) {
+	config := DefaultConfig()
+	config.AutoCleanup = false
+	config.MaxEntries = 10
+	manager := NewManager(config)
+
+	done := make(chan struct{})
+	for g := 0; g < 4; g++ {
+		go func(g int) {
+			defer func() { done <- struct{}{} }()
+			for i := 0; i < 100; i++ {
+				key := string(rune('a'+g)) + string(rune('a'+i%26))
+				manager.RecordCached(key, 10)
+				manager.RecordCacheHit(key)
+			}
+		}(g)
+	}
+	for g := 0; g < 4; g++ {
+		<-done
+	}
+
+	assert.LessOrEqual(t, len(manager.GetCacheKeys()), 10)
+}
+
+func TestTieredTTLs(t *testing.T) {
+	config := DefaultConfig()
+	config.AutoCleanup = false
+	config.MinPromptLength = 10
+	config.TTL = 5 * time.Minute
+	config.SystemPromptTTL = 1 * time.Hour
+	config.ContextTTL = 2 * time.Minute
+	manager := NewManager(config)
+
+	systemControl := manager.ShouldCache(&CacheableContent{
+		Content: "a long stable system prompt that qualifies for caching",
+		Type:    "system",
+		Length:  2000,
+	})
+	require.NotNil(t, systemControl)
+	assert.Equal(t, int((1 * time.Hour).Seconds()), systemControl.TTL)
+
+	contextControl := manager.ShouldCache(&CacheableContent{
+		Content: "a large retrieved context block that churns frequently",
+		Type:    "context",
+		Length:  2000,
+	})
+	require.NotNil(t, contextControl)
+	assert.Equal(t, int((2 * time.Minute).Seconds()), contextControl.TTL)
+
+	assert.NotEqual(t, systemControl.TTL, contextControl.TTL)
+}
+
+func TestTieredTTLFallback(t *testing.T) {
+	config := DefaultConfig()
+	config.AutoCleanup = false
+	config.MinPromptLength = 10
+	config.TTL = 5 * time.Minute
+	manager := NewManager(config)
+
+	control := manager.ShouldCache(&CacheableContent{
+		Content: "system prompt without a tier-specific TTL configured",
+		Type:    "system",
+		Length:  2000,
+	})
+	require.NotNil(t, control)
+	assert.Equal(t, int((5 * time.Minute).Seconds()), control.TTL)
+}
+
+func TestRecordCacheHitFor(t *testing.T) {
+	manager := NewManager(DefaultConfig())
+	cacheKey := "test-key"
+
+	// Cache the entry so a hit can estimate tokens saved from its size
+	manager.RecordCached(cacheKey, 4096)
+
+	manager.RecordCacheMissFor(cacheKey, CategorySystemPrompt, "claude-sonnet-4-5")
+	manager.RecordCacheHitFor(cacheKey, CategorySystemPrompt, "claude-sonnet-4-5")
+	manager.RecordCacheHitFor(cacheKey, CategorySystemPrompt, "claude-sonnet-4-5")
+	manager.RecordCacheHitFor(cacheKey, CategoryLargeContext, "claude-haiku-4-5")
+
+	stats := manager.GetStats()
+
+	// Category breakdown
+	systemGroup, exists := stats.CacheByCategory[CategorySystemPrompt]
+	require.True(t, exists)
+	assert.Equal(t, int64(2), systemGroup.Hits)
+	assert.Equal(t, int64(1), systemGroup.Misses)
+	assert.InDelta(t, 2.0/3.0, systemGroup.HitRate, 0.01)
+	assert.Equal(t, int64(2048), systemGroup.TokensSaved) // 2 hits * 4096/4
+
+	contextGroup, exists := stats.CacheByCategory[CategoryLargeContext]
+	require.True(t, exists)
+	assert.Equal(t, int64(1), contextGroup.Hits)
+	assert.Equal(t, int64(0), contextGroup.Misses)
+	assert.Equal(t, 1.0, contextGroup.HitRate)
+
+	// Model breakdown
+	sonnetGroup, exists := stats.CacheByModel["claude-sonnet-4-5"]
+	require.True(t, exists)
+	assert.Equal(t, int64(2), sonnetGroup.Hits)
+	assert.Equal(t, int64(1), sonnetGroup.Misses)
+
+	haikuGroup, exists := stats.CacheByModel["claude-haiku-4-5"]
+	require.True(t, exists)
+	assert.Equal(t, int64(1), haikuGroup.Hits)
+}
+
+func TestRecordCacheHitForEmptySkipsBreakdowns(t *testing.T) {
+	manager := NewManager(DefaultConfig())
+
+	// The plain recorders carry no category or model attribution
+	manager.RecordCacheHit("key1")
+	manager.RecordCacheMiss("key2")
+
+	stats := manager.GetStats()
+	assert.Equal(t, int64(2), stats.TotalRequests)
+	assert.Empty(t, stats.CacheByCategory)
+	assert.Empty(t, stats.CacheByModel)
+}
+
+func TestStatsReport(t *testing.T) {
+	manager := NewManager(DefaultConfig())
+
+	t.Run("no data", func(t *testing.T) {
+		report := manager.StatsReport()
+		assert.Contains(t, report, "Cache Effectiveness Report")
+		assert.Contains(t, report, "(no data)")
+	})
+
+	t.Run("with breakdowns", func(t *testing.T) {
+		manager.RecordCached("key1", 4096)
+		manager.RecordCacheHitFor("key1", CategorySystemPrompt, "claude-sonnet-4-5")
+		manager.RecordCacheMissFor("key2", CategoryLargeContext, "claude-haiku-4-5")
+
+		report := manager.StatsReport()
+		assert.Contains(t, report, "By category:")
+		assert.Contains(t, report, "By model:")
+		assert.Contains(t, report, CategorySystemPrompt)
+		assert.Contains(t, report, CategoryLargeContext)
+		assert.Contains(t, report, "claude-sonnet-4-5")
+		assert.Contains(t, report, "claude-haiku-4-5")
+		assert.Contains(t, report, "100.00%")
+	})
+}
@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// SaveMetrics serializes the current cache metrics to a JSON file so that
+// hit/miss counts and byte savings survive process restarts. The parent
+// directory is created if needed.
+func (m *Manager) SaveMetrics(path string) error {
+	stats := m.GetStats()
+
+	data, err := json.MarshalIndent(stats, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// Write to a temp file then rename so a crash mid-write can't corrupt
+	// previously saved metrics
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// LoadMetrics reads previously saved metrics from a JSON file and merges the
+// counters into the current metrics. A missing or corrupt file is not an
+// error: the manager simply starts fresh.
+func (m *Manager) LoadMetrics(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		// Missing file: start fresh
+		return nil
+	}
+
+	var saved CacheMetrics
+	if err := json.Unmarshal(data, &saved); err != nil {
+		// Corrupt file: start fresh
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.metrics.TotalRequests += saved.TotalRequests
+	m.metrics.CacheHits += saved.CacheHits
+	m.metrics.CacheMisses += saved.CacheMisses
+	m.metrics.Evictions += saved.Evictions
+	m.metrics.BytesCached += saved.BytesCached
+	m.metrics.BytesSaved += saved.BytesSaved
+
+	// Preserve the earliest reset time so "savings since" stays accurate
+	if !saved.LastReset.IsZero() && saved.LastReset.Before(m.metrics.LastReset) {
+		m.metrics.LastReset = saved.LastReset
+	}
+
+	// Merge per-key counters
+	for key, savedKey := range saved.CacheByKey {
+		if existing, ok := m.metrics.CacheByKey[key]; ok {
+			existing.Hits += savedKey.Hits
+			existing.Misses += savedKey.Misses
+			if savedKey.LastHit.After(existing.LastHit) {
+				existing.LastHit = savedKey.LastHit
+			}
+		} else {
+			keyCopy := *savedKey
+			m.metrics.CacheByKey[key] = &keyCopy
+		}
+	}
+
+	// Merge category and model breakdowns
+	mergeGroups(m.metrics.CacheByCategory, saved.CacheByCategory)
+	mergeGroups(m.metrics.CacheByModel, saved.CacheByModel)
+
+	m.updateHitRate()
+	return nil
+}
+
+// mergeGroups folds saved breakdown counters into the current ones
+func mergeGroups(current, saved map[string]*CacheGroupMetrics) {
+	for name, savedGroup := range saved {
+		if existing, ok := current[name]; ok {
+			existing.Hits += savedGroup.Hits
+			existing.Misses += savedGroup.Misses
+			existing.TokensSaved += savedGroup.TokensSaved
+			existing.HitRate = float64(existing.Hits) / float64(existing.Hits+existing.Misses)
+		} else {
+			groupCopy := *savedGroup
+			current[name] = &groupCopy
+		}
+	}
+}
+
+// EstimatedTokensSaved approximates how many tokens caching has saved, using
+// the common ~4 bytes per token heuristic. Useful for status-style reporting.
+func (m *Manager) EstimatedTokensSaved() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.metrics.BytesSaved / bytesPerToken
+}
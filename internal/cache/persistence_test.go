@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveAndLoadMetrics(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+
+	config := DefaultConfig()
+	config.AutoCleanup = false
+
+	manager := NewManager(config)
+	manager.RecordCacheHit("key-1")
+	manager.RecordCacheHit("key-1")
+	manager.RecordCacheMiss("key-2")
+	manager.RecordBytesSaved(4000)
+
+	require.NoError(t, manager.SaveMetrics(path))
+
+	// A new manager merges the saved counters on load
+	restored := NewManager(config)
+	restored.RecordCacheHit("key-1")
+	require.NoError(t, restored.LoadMetrics(path))
+
+	stats := restored.GetStats()
+	assert.Equal(t, int64(4), stats.TotalRequests)
+	assert.Equal(t, int64(3), stats.CacheHits)
+	assert.Equal(t, int64(1), stats.CacheMisses)
+	assert.Equal(t, int64(4000), stats.BytesSaved)
+	assert.Equal(t, int64(3), stats.CacheByKey["key-1"].Hits)
+	assert.Equal(t, int64(1000), restored.EstimatedTokensSaved())
+}
+
+func TestLoadMetricsMissingFile(t *testing.T) {
+	config := DefaultConfig()
+	config.AutoCleanup = false
+
+	manager := NewManager(config)
+	err := manager.LoadMetrics(filepath.Join(t.TempDir(), "does-not-exist.json"))
+	assert.NoError(t, err)
+
+	stats := manager.GetStats()
+	assert.Equal(t, int64(0), stats.TotalRequests)
+}
+
+func TestLoadMetricsCorruptFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	require.NoError(t, os.WriteFile(path, []byte("{not json"), 0o644))
+
+	config := DefaultConfig()
+	config.AutoCleanup = false
+
+	manager := NewManager(config)
+	err := manager.LoadMetrics(path)
+	assert.NoError(t, err)
+
+	stats := manager.GetStats()
+	assert.Equal(t, int64(0), stats.TotalRequests)
+}
+
+func TestSaveMetricsCreatesParentDir(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "dir", "metrics.json")
+
+	config := DefaultConfig()
+	config.AutoCleanup = false
+
+	manager := NewManager(config)
+	manager.RecordCacheHit("key-1")
+
+	require.NoError(t, manager.SaveMetrics(path))
+
+	_, err := os.Stat(path)
+	assert.NoError(t, err)
+}
@@ -11,16 +11,39 @@ type CacheControl struct {
 	Breakpoint bool  `json:"breakpoint"` // Whether this is a cache breakpoint
 }
 
+// Cache effectiveness categories used for per-category breakdowns
+const (
+	// CategorySystemPrompt covers cached system prompt blocks
+	CategorySystemPrompt = "system_prompt"
+
+	// CategoryLargeContext covers cached large-context blocks
+	CategoryLargeContext = "large_context"
+)
+
 // CacheMetrics tracks cache performance metrics
 type CacheMetrics struct {
 	TotalRequests      int64         `json:"total_requests"`
 	CacheHits          int64         `json:"cache_hits"`
 	CacheMisses        int64         `json:"cache_misses"`
+	Evictions          int64         `json:"evictions"`
 	BytesCached        int64         `json:"bytes_cached"`
 	BytesSaved         int64         `json:"bytes_saved"`
 	AverageHitRate     float64       `json:"average_hit_rate"`
 	LastReset          time.Time     `json:"last_reset"`
 	CacheByKey         map[string]*CacheKeyMetrics `json:"cache_by_key"`
+	CacheByCategory    map[string]*CacheGroupMetrics `json:"cache_by_category"`
+	CacheByModel       map[string]*CacheGroupMetrics `json:"cache_by_model"`
+}
+
+// CacheGroupMetrics tracks cache effectiveness for a group of requests,
+// either a prompt category or a model. TokensSaved is estimated from the
+// cached byte size of entries that were reused.
+type CacheGroupMetrics struct {
+	Name        string  `json:"name"`
+	Hits        int64   `json:"hits"`
+	Misses      int64   `json:"misses"`
+	HitRate     float64 `json:"hit_rate"`
+	TokensSaved int64   `json:"tokens_saved"`
 }
 
 // CacheKeyMetrics tracks metrics for a specific cache key
@@ -41,6 +64,9 @@ type Config struct {
 	SystemPromptCache bool          `json:"system_prompt_cache"` // Cache system prompts
 	ContextCache      bool          `json:"context_cache"`       // Cache conversation context
 	TTL               time.Duration `json:"ttl"`                 // Default TTL (0 = use provider default)
+	SystemPromptTTL   time.Duration `json:"system_prompt_ttl"`   // TTL for system prompt blocks (0 = fall back to TTL)
+	ContextTTL        time.Duration `json:"context_ttl"`         // TTL for large-context blocks (0 = fall back to TTL)
+	MaxEntries        int           `json:"max_entries"`         // Maximum cached entries before LRU eviction (0 = unlimited)
 	AutoCleanup       bool          `json:"auto_cleanup"`        // Automatically cleanup expired cache
 	CleanupInterval   time.Duration `json:"cleanup_interval"`    // How often to run cleanup
 }
@@ -0,0 +1,136 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AINative-studio/ainative-code/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIError_StructuredFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-ID", "req-abc-123")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"error":{"code":"validation_failed","message":"name is required"}}`))
+	}))
+	defer server.Close()
+
+	c := client.New(client.WithBaseURL(server.URL), client.WithMaxRetries(0))
+
+	_, err := c.Post(context.Background(), "/things", map[string]string{})
+	require.Error(t, err)
+
+	var apiErr *client.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode)
+	assert.Equal(t, "validation_failed", apiErr.Code)
+	assert.Equal(t, "name is required", apiErr.Message)
+	assert.Equal(t, "req-abc-123", apiErr.RequestID)
+	assert.Contains(t, err.Error(), "HTTP 422")
+	assert.Contains(t, err.Error(), "name is required")
+}
+
+func TestAPIError_FlatBodyShapes(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		wantCode    string
+		wantMessage string
+	}{
+		{
+			name:        "flat code and message",
+			body:        `{"code":"not_found","message":"no such project"}`,
+			wantCode:    "not_found",
+			wantMessage: "no such project",
+		},
+		{
+			name:        "detail only",
+			body:        `{"detail":"project missing"}`,
+			wantMessage: "project missing",
+		},
+		{
+			name:        "non-JSON body falls back to raw text",
+			body:        `gateway exploded`,
+			wantMessage: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			c := client.New(client.WithBaseURL(server.URL), client.WithMaxRetries(0))
+
+			_, err := c.Get(context.Background(), "/missing")
+			require.Error(t, err)
+
+			var apiErr *client.APIError
+			require.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, tt.wantCode, apiErr.Code)
+			assert.Equal(t, tt.wantMessage, apiErr.Message)
+			assert.Equal(t, tt.body, string(apiErr.Body))
+			if tt.wantMessage != "" {
+				assert.Contains(t, err.Error(), tt.wantMessage)
+			} else {
+				assert.Contains(t, err.Error(), tt.body)
+			}
+		})
+	}
+}
+
+func TestStatusCodeHelpers(t *testing.T) {
+	newServer := func(status int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			w.Write([]byte(`{"message":"nope"}`))
+		}))
+	}
+
+	t.Run("IsRateLimited", func(t *testing.T) {
+		server := newServer(http.StatusTooManyRequests)
+		defer server.Close()
+
+		c := client.New(client.WithBaseURL(server.URL), client.WithMaxRetries(0))
+		_, err := c.Get(context.Background(), "/test")
+		require.Error(t, err)
+		assert.True(t, client.IsRateLimited(err))
+		assert.False(t, client.IsUnauthorized(err))
+		assert.Equal(t, http.StatusTooManyRequests, client.StatusCode(err))
+	})
+
+	t.Run("IsUnauthorized", func(t *testing.T) {
+		server := newServer(http.StatusUnauthorized)
+		defer server.Close()
+
+		c := client.New(client.WithBaseURL(server.URL), client.WithMaxRetries(0))
+		_, err := c.Get(context.Background(), "/test")
+		require.Error(t, err)
+		assert.True(t, client.IsUnauthorized(err))
+		assert.False(t, client.IsRateLimited(err))
+	})
+
+	t.Run("status survives retry wrapping", func(t *testing.T) {
+		server := newServer(http.StatusServiceUnavailable)
+		defer server.Close()
+
+		c := client.New(client.WithBaseURL(server.URL), client.WithMaxRetries(1))
+		_, err := c.Get(context.Background(), "/test")
+		require.Error(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, client.StatusCode(err))
+	})
+
+	t.Run("zero for non-HTTP errors", func(t *testing.T) {
+		assert.Equal(t, 0, client.StatusCode(errors.New("plain error")))
+		assert.Equal(t, 0, client.StatusCode(nil))
+		assert.False(t, client.IsRateLimited(nil))
+	})
+}
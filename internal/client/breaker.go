@@ -0,0 +1,109 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// breakerState is the current mode of a circuit breaker.
+type breakerState int
+
+const (
+	// breakerClosed allows all requests through (normal operation)
+	breakerClosed breakerState = iota
+
+	// breakerOpen short-circuits requests until the cooldown elapses
+	breakerOpen
+
+	// breakerHalfOpen allows a single probe request to test recovery
+	breakerHalfOpen
+)
+
+// circuitBreaker tracks consecutive failures for one base URL and
+// short-circuits requests while the service is presumed down.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+
+	state    breakerState
+	failures int
+	openedAt time.Time
+}
+
+// allow reports whether a request may proceed. When the cooldown has elapsed
+// on an open breaker, it transitions to half-open and admits exactly one
+// probe request.
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if time.Since(b.openedAt) >= b.cooldown {
+			b.state = breakerHalfOpen
+			return true
+		}
+		return false
+	case breakerHalfOpen:
+		// A probe is already in flight
+		return false
+	default:
+		return true
+	}
+}
+
+// recordSuccess resets the breaker to closed.
+func (b *circuitBreaker) recordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = breakerClosed
+	b.failures = 0
+}
+
+// recordFailure counts a failure, opening the breaker at the threshold or
+// immediately when a half-open probe fails.
+func (b *circuitBreaker) recordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerHalfOpen {
+		b.state = breakerOpen
+		b.openedAt = time.Now()
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.threshold {
+		b.state = breakerOpen
+		b.openedAt = time.Now()
+	}
+}
+
+// breaker returns the circuit breaker for the client's base URL, creating it
+// on first use. Returns nil when no breaker is configured. Breakers are kept
+// per base URL so one failing service does not trip requests to another.
+func (c *Client) breaker() *circuitBreaker {
+	if c.breakerThreshold <= 0 {
+		return nil
+	}
+
+	c.breakerMu.Lock()
+	defer c.breakerMu.Unlock()
+
+	if c.breakers == nil {
+		c.breakers = make(map[string]*circuitBreaker)
+	}
+
+	br, ok := c.breakers[c.baseURL]
+	if !ok {
+		br = &circuitBreaker{
+			threshold: c.breakerThreshold,
+			cooldown:  c.breakerCooldown,
+		}
+		c.breakers[c.baseURL] = br
+	}
+
+	return br
+}
@@ -0,0 +1,145 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/AINative-studio/ainative-code/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// breakerServer counts requests and serves the status set in status.
+func breakerServer() (*httptest.Server, *atomic.Int64, *atomic.Int64) {
+	var requests atomic.Int64
+	var status atomic.Int64
+	status.Store(http.StatusInternalServerError)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(int(status.Load()))
+	}))
+
+	return server, &requests, &status
+}
+
+func TestCircuitBreaker_OpensAfterThreshold(t *testing.T) {
+	server, requests, _ := breakerServer()
+	defer server.Close()
+
+	c := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithMaxRetries(0),
+		client.WithCircuitBreaker(2, time.Minute),
+	)
+
+	// Two consecutive failures trip the breaker
+	for i := 0; i < 2; i++ {
+		_, err := c.Get(context.Background(), "/test")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, client.ErrCircuitOpen)
+	}
+	assert.Equal(t, int64(2), requests.Load())
+
+	// The third request is short-circuited without touching the server
+	_, err := c.Get(context.Background(), "/test")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, client.ErrCircuitOpen)
+	assert.Equal(t, int64(2), requests.Load())
+}
+
+func TestCircuitBreaker_HalfOpenRecovery(t *testing.T) {
+	server, requests, status := breakerServer()
+	defer server.Close()
+
+	c := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithMaxRetries(0),
+		client.WithCircuitBreaker(1, 50*time.Millisecond),
+	)
+
+	// Trip the breaker
+	_, err := c.Get(context.Background(), "/test")
+	require.Error(t, err)
+
+	_, err = c.Get(context.Background(), "/test")
+	assert.ErrorIs(t, err, client.ErrCircuitOpen)
+
+	// After the cooldown the breaker half-opens; a successful probe closes it
+	status.Store(http.StatusOK)
+	time.Sleep(80 * time.Millisecond)
+
+	_, err = c.Get(context.Background(), "/test")
+	assert.NoError(t, err)
+
+	// Fully closed again: requests flow normally
+	_, err = c.Get(context.Background(), "/test")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), requests.Load())
+}
+
+func TestCircuitBreaker_FailedProbeReopens(t *testing.T) {
+	server, requests, _ := breakerServer()
+	defer server.Close()
+
+	c := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithMaxRetries(0),
+		client.WithCircuitBreaker(1, 50*time.Millisecond),
+	)
+
+	// Trip the breaker
+	_, err := c.Get(context.Background(), "/test")
+	require.Error(t, err)
+
+	// The probe after the cooldown still fails and reopens the breaker
+	time.Sleep(80 * time.Millisecond)
+	_, err = c.Get(context.Background(), "/test")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, client.ErrCircuitOpen)
+
+	_, err = c.Get(context.Background(), "/test")
+	assert.ErrorIs(t, err, client.ErrCircuitOpen)
+	assert.Equal(t, int64(2), requests.Load())
+}
+
+func TestCircuitBreaker_ClientErrorsDoNotTrip(t *testing.T) {
+	server, requests, status := breakerServer()
+	defer server.Close()
+	status.Store(http.StatusNotFound)
+
+	c := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithMaxRetries(0),
+		client.WithCircuitBreaker(2, time.Minute),
+	)
+
+	// 404s mean the service is up; the breaker must stay closed
+	for i := 0; i < 5; i++ {
+		_, err := c.Get(context.Background(), "/missing")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, client.ErrCircuitOpen)
+	}
+	assert.Equal(t, int64(5), requests.Load())
+}
+
+func TestCircuitBreaker_DisabledByDefault(t *testing.T) {
+	server, requests, _ := breakerServer()
+	defer server.Close()
+
+	c := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithMaxRetries(0),
+	)
+
+	for i := 0; i < 5; i++ {
+		_, err := c.Get(context.Background(), "/test")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, client.ErrCircuitOpen)
+	}
+	assert.Equal(t, int64(5), requests.Load())
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sync"
+)
+
+// cachedResponse is a previously fetched GET response with its validators.
+type cachedResponse struct {
+	body         []byte
+	etag         string
+	lastModified string
+}
+
+// cacheRecord is an LRU entry pairing a key with its cached response.
+type cacheRecord struct {
+	key  string
+	resp cachedResponse
+}
+
+// responseCache is a fixed-size LRU of validated GET responses, keyed by URL
+// and the requesting account so responses never leak across accounts.
+type responseCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+// newResponseCache creates a cache holding at most size entries.
+func newResponseCache(size int) *responseCache {
+	return &responseCache{
+		size:    size,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// get returns the cached response for the key, marking it recently used.
+func (rc *responseCache) get(key string) (cachedResponse, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	elem, ok := rc.entries[key]
+	if !ok {
+		return cachedResponse{}, false
+	}
+
+	rc.order.MoveToFront(elem)
+	return elem.Value.(*cacheRecord).resp, true
+}
+
+// put stores a response under the key, evicting the least recently used
+// entry when full.
+func (rc *responseCache) put(key string, resp cachedResponse) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if elem, ok := rc.entries[key]; ok {
+		elem.Value.(*cacheRecord).resp = resp
+		rc.order.MoveToFront(elem)
+		return
+	}
+
+	rc.entries[key] = rc.order.PushFront(&cacheRecord{key: key, resp: resp})
+
+	if rc.order.Len() > rc.size {
+		oldest := rc.order.Back()
+		rc.order.Remove(oldest)
+		delete(rc.entries, oldest.Value.(*cacheRecord).key)
+	}
+}
+
+// cacheKey builds the cache key for a request: the URL plus a digest of the
+// Authorization header, so different accounts never share entries.
+func cacheKey(url string, req *http.Request) string {
+	auth := req.Header.Get("Authorization")
+	if auth == "" {
+		return url
+	}
+
+	sum := sha256.Sum256([]byte(auth))
+	return url + "|" + hex.EncodeToString(sum[:8])
+}
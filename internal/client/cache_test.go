@@ -0,0 +1,142 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/AINative-studio/ainative-code/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// etagServer serves a body under a fixed ETag and answers revalidations
+// with 304.
+func etagServer(body string, etag string, hits *atomic.Int64, validations *atomic.Int64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if r.Header.Get("If-None-Match") == etag {
+			validations.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Write([]byte(body))
+	}))
+}
+
+func TestResponseCache_ServesBodyOn304(t *testing.T) {
+	var hits, validations atomic.Int64
+	server := etagServer(`{"tokens":"v1"}`, `"abc123"`, &hits, &validations)
+	defer server.Close()
+
+	c := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithResponseCache(8),
+	)
+
+	first, err := c.Get(context.Background(), "/design/tokens")
+	require.NoError(t, err)
+	assert.Equal(t, `{"tokens":"v1"}`, string(first))
+
+	// Second fetch revalidates and gets the cached body back on the 304
+	second, err := c.Get(context.Background(), "/design/tokens")
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+	assert.Equal(t, int64(2), hits.Load())
+	assert.Equal(t, int64(1), validations.Load())
+}
+
+func TestResponseCache_ChangedETagRefetches(t *testing.T) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		// The resource changes after the first fetch, so the stale
+		// validator never matches
+		if n == 1 {
+			w.Header().Set("ETag", `"v1"`)
+			w.Write([]byte("one"))
+			return
+		}
+		assert.Equal(t, `"v1"`, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"v2"`)
+		w.Write([]byte("two"))
+	}))
+	defer server.Close()
+
+	c := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithResponseCache(8),
+	)
+
+	first, err := c.Get(context.Background(), "/resource")
+	require.NoError(t, err)
+	assert.Equal(t, "one", string(first))
+
+	second, err := c.Get(context.Background(), "/resource")
+	require.NoError(t, err)
+	assert.Equal(t, "two", string(second))
+}
+
+func TestResponseCache_OnlyCachesGET(t *testing.T) {
+	var sawValidator atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			sawValidator.Store(true)
+		}
+		w.Header().Set("ETag", `"abc"`)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithResponseCache(8),
+	)
+
+	for i := 0; i < 2; i++ {
+		_, err := c.Post(context.Background(), "/action", map[string]string{"k": "v"})
+		require.NoError(t, err)
+	}
+
+	assert.False(t, sawValidator.Load(), "POST requests must not be revalidated from cache")
+}
+
+func TestResponseCache_EvictsLRU(t *testing.T) {
+	var validations atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			validations.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"tag"`)
+		w.Write([]byte("payload"))
+	}))
+	defer server.Close()
+
+	// Cache holds a single entry
+	c := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithResponseCache(1),
+	)
+
+	_, err := c.Get(context.Background(), "/a")
+	require.NoError(t, err)
+
+	// Fetching /b evicts /a
+	_, err = c.Get(context.Background(), "/b")
+	require.NoError(t, err)
+
+	// /a is gone, so no validator is sent and no 304 happens
+	_, err = c.Get(context.Background(), "/a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), validations.Load())
+
+	// /b was just evicted by /a in turn; only a fresh /a revalidates
+	_, err = c.Get(context.Background(), "/a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), validations.Load())
+}
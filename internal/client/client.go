@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/AINative-studio/ainative-code/internal/auth"
@@ -20,6 +21,18 @@ type Client struct {
 	baseURL    string
 	timeout    time.Duration
 	maxRetries int
+
+	// Circuit breaker configuration, enabled via WithCircuitBreaker
+	breakerThreshold int
+	breakerCooldown  time.Duration
+	breakerMu        sync.Mutex
+	breakers         map[string]*circuitBreaker
+
+	// Validated response cache, enabled via WithResponseCache
+	respCache *responseCache
+
+	// Token-bucket request pacing, enabled via WithRateLimit
+	rateLimiter *rateLimiter
 }
 
 // New creates a new API client with the specified options.
@@ -76,6 +89,19 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		opt(reqOpts)
 	}
 
+	// Short-circuit while the breaker is open
+	br := c.breaker()
+	if br != nil && !br.allow() {
+		return nil, fmt.Errorf("%w: %s is cooling down after repeated failures", ErrCircuitOpen, c.baseURL)
+	}
+
+	// Pace the request through the shared token bucket
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.wait(ctx); err != nil {
+			return nil, fmt.Errorf("rate limit wait cancelled: %w", err)
+		}
+	}
+
 	var bodyReader io.Reader
 	if body != nil {
 		jsonData, err := json.Marshal(body)
@@ -129,6 +155,22 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 			}
 		}
 
+		// Attach cache validators for GETs with a cached copy
+		var cached cachedResponse
+		var cachedOK bool
+		var key string
+		if c.respCache != nil && method == http.MethodGet {
+			key = cacheKey(url, req)
+			if cached, cachedOK = c.respCache.get(key); cachedOK {
+				if cached.etag != "" {
+					req.Header.Set("If-None-Match", cached.etag)
+				}
+				if cached.lastModified != "" {
+					req.Header.Set("If-Modified-Since", cached.lastModified)
+				}
+			}
+		}
+
 		// Log request
 		logger.DebugEvent().
 			Str("method", method).
@@ -159,6 +201,15 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 			Int("body_size", len(respBody)).
 			Msg("Received HTTP response")
 
+		// Serve the cached body on a validation hit
+		if resp.StatusCode == http.StatusNotModified && cachedOK {
+			logger.DebugEvent().Str("url", url).Msg("Cache validated, serving stored response")
+			if br != nil {
+				br.recordSuccess()
+			}
+			return cached.body, nil
+		}
+
 		// Handle 401 Unauthorized - token might be expired
 		if resp.StatusCode == http.StatusUnauthorized && c.authClient != nil {
 			logger.InfoEvent().Msg("Received 401, attempting token refresh")
@@ -174,30 +225,64 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 				}
 			}
 
-			// Token refresh failed or no refresh token available
-			return nil, fmt.Errorf("authentication failed: %s", string(respBody))
+			// Token refresh failed or no refresh token available; the
+			// service responded, so this is not a breaker failure
+			if br != nil {
+				br.recordSuccess()
+			}
+			return nil, fmt.Errorf("authentication failed: %w", newAPIError(resp, respBody))
 		}
 
 		// Handle other error status codes
 		if resp.StatusCode >= 400 {
 			// Check if we should retry
 			if c.shouldRetry(resp.StatusCode) && attempt < c.maxRetries {
-				lastErr = fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(respBody))
+				lastErr = newAPIError(resp, respBody)
 				logger.WarnEvent().
 					Int("status", resp.StatusCode).
 					Msg("Request failed with retryable error")
 				continue
 			}
 
+			// Only service-level failures (retryable statuses) count against
+			// the breaker; plain client errors mean the service is up
+			if br != nil {
+				if c.shouldRetry(resp.StatusCode) {
+					br.recordFailure()
+				} else {
+					br.recordSuccess()
+				}
+			}
+
 			// Non-retryable error or max retries exceeded
-			return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(respBody))
+			return nil, newAPIError(resp, respBody)
 		}
 
 		// Success
+		if br != nil {
+			br.recordSuccess()
+		}
+
+		// Store validatable GET responses for future revalidation
+		if c.respCache != nil && method == http.MethodGet {
+			etag := resp.Header.Get("ETag")
+			lastModified := resp.Header.Get("Last-Modified")
+			if etag != "" || lastModified != "" {
+				c.respCache.put(key, cachedResponse{
+					body:         respBody,
+					etag:         etag,
+					lastModified: lastModified,
+				})
+			}
+		}
+
 		return respBody, nil
 	}
 
 	// All retries exhausted
+	if br != nil {
+		br.recordFailure()
+	}
 	return nil, fmt.Errorf("request failed after %d attempts: %w", c.maxRetries+1, lastErr)
 }
 
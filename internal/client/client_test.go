@@ -58,6 +58,18 @@ func (m *mockAuthClient) StoreTokens(ctx context.Context, tokens *auth.TokenPair
 	return nil
 }
 
+func (m *mockAuthClient) Logout(ctx context.Context) error {
+	m.tokens = nil
+	return nil
+}
+
+func (m *mockAuthClient) WhoAmI(ctx context.Context) (*auth.Identity, error) {
+	if m.tokens == nil || m.tokens.AccessToken == nil {
+		return nil, auth.ErrNotLoggedIn
+	}
+	return auth.NewIdentity(m.tokens.AccessToken), nil
+}
+
 func (m *mockAuthClient) ClearTokens(ctx context.Context) error {
 	m.tokens = nil
 	return nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/AINative-studio/ainative-code/internal/client"
 	"github.com/AINative-studio/ainative-code/internal/design"
@@ -210,6 +211,75 @@ func (c *Client) GetTokens(ctx context.Context, types []string, category string,
 	return resp.Tokens, resp.Total, nil
 }
 
+// ListOption is a functional option for filtering ListTokens results.
+type ListOption func(*listOptions)
+
+// listOptions contains filters for listing tokens.
+type listOptions struct {
+	category   string
+	types      []string
+	limit      int
+	offset     int
+	sortByName bool
+}
+
+// WithCategory filters tokens to a single category.
+func WithCategory(category string) ListOption {
+	return func(o *listOptions) {
+		o.category = category
+	}
+}
+
+// WithTypes filters tokens to the given token types.
+func WithTypes(types ...string) ListOption {
+	return func(o *listOptions) {
+		o.types = types
+	}
+}
+
+// WithLimit caps the number of tokens returned per page.
+func WithLimit(limit int) ListOption {
+	return func(o *listOptions) {
+		o.limit = limit
+	}
+}
+
+// WithOffset skips the first offset tokens for pagination.
+func WithOffset(offset int) ListOption {
+	return func(o *listOptions) {
+		o.offset = offset
+	}
+}
+
+// WithSortByName sorts the returned tokens by name.
+func WithSortByName() ListOption {
+	return func(o *listOptions) {
+		o.sortByName = true
+	}
+}
+
+// ListTokens lists design tokens with optional category and type filters,
+// returning full token fields so callers can render grouped output.
+func (c *Client) ListTokens(ctx context.Context, opts ...ListOption) ([]*design.Token, error) {
+	options := &listOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	tokens, _, err := c.GetTokens(ctx, options.types, options.category, options.limit, options.offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tokens: %w", err)
+	}
+
+	if options.sortByName {
+		sort.Slice(tokens, func(i, j int) bool {
+			return tokens[i].Name < tokens[j].Name
+		})
+	}
+
+	return tokens, nil
+}
+
 // DeleteToken deletes a design token from the AINative Design system.
 func (c *Client) DeleteToken(ctx context.Context, tokenName string) error {
 	logger.InfoEvent().
@@ -648,3 +648,91 @@ func TestLargeBatchUpload(t *testing.T) {
 		t.Errorf("expected 3 batches, got %d", batchCount)
 	}
 }
+
+// TestListTokens tests the ListTokens method.
+func TestListTokens(t *testing.T) {
+	t.Run("empty result", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(TokenQueryResponse{Tokens: []*design.Token{}, Total: 0})
+		}))
+		defer server.Close()
+
+		apiClient := client.New(
+			client.WithBaseURL(server.URL),
+			client.WithTimeout(5*time.Second),
+		)
+		designClient := New(
+			WithAPIClient(apiClient),
+			WithProjectID("test-project"),
+		)
+
+		tokens, err := designClient.ListTokens(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(tokens) != 0 {
+			t.Errorf("expected no tokens, got %d", len(tokens))
+		}
+	})
+
+	t.Run("multi-category result sorted by name", func(t *testing.T) {
+		var gotQuery TokenQueryRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := json.NewDecoder(r.Body).Decode(&gotQuery); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+
+			json.NewEncoder(w).Encode(TokenQueryResponse{
+				Tokens: []*design.Token{
+					{Name: "spacing.base", Value: "16px", Type: "spacing", Category: "spacing"},
+					{Name: "colors.primary", Value: "#007bff", Type: "color", Category: "colors"},
+					{Name: "colors.accent", Value: "#ff5722", Type: "color", Category: "colors"},
+				},
+				Total: 3,
+			})
+		}))
+		defer server.Close()
+
+		apiClient := client.New(
+			client.WithBaseURL(server.URL),
+			client.WithTimeout(5*time.Second),
+		)
+		designClient := New(
+			WithAPIClient(apiClient),
+			WithProjectID("test-project"),
+		)
+
+		tokens, err := designClient.ListTokens(context.Background(),
+			WithCategory("colors"),
+			WithTypes("color", "spacing"),
+			WithLimit(50),
+			WithOffset(10),
+			WithSortByName(),
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// Filters are forwarded to the query endpoint
+		if gotQuery.Category != "colors" {
+			t.Errorf("expected category filter 'colors', got %q", gotQuery.Category)
+		}
+		if len(gotQuery.Types) != 2 {
+			t.Errorf("expected 2 type filters, got %d", len(gotQuery.Types))
+		}
+		if gotQuery.Limit != 50 || gotQuery.Offset != 10 {
+			t.Errorf("expected limit 50 offset 10, got %d/%d", gotQuery.Limit, gotQuery.Offset)
+		}
+
+		// Tokens come back sorted by name
+		wantOrder := []string{"colors.accent", "colors.primary", "spacing.base"}
+		if len(tokens) != len(wantOrder) {
+			t.Fatalf("expected %d tokens, got %d", len(wantOrder), len(tokens))
+		}
+		for i, name := range wantOrder {
+			if tokens[i].Name != name {
+				t.Errorf("expected token %d to be %q, got %q", i, name, tokens[i].Name)
+			}
+		}
+	})
+}
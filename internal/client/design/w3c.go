@@ -0,0 +1,198 @@
+package design
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/AINative-studio/ainative-code/internal/design"
+	"github.com/AINative-studio/ainative-code/internal/logger"
+)
+
+// w3cExportLimit caps how many tokens a single W3C export fetches.
+const w3cExportLimit = 1000
+
+// ExportTokensW3C writes the project's design tokens to w in the W3C
+// Design Tokens Community Group JSON format, with tokens nested in groups
+// derived from their category so the output interoperates with tools like
+// Style Dictionary.
+func (c *Client) ExportTokensW3C(ctx context.Context, w io.Writer) error {
+	logger.InfoEvent().Msg("Exporting design tokens in W3C format")
+
+	tokens, _, err := c.GetTokens(ctx, nil, "", w3cExportLimit, 0)
+	if err != nil {
+		return fmt.Errorf("failed to fetch tokens for export: %w", err)
+	}
+
+	root := make(map[string]interface{})
+	for _, token := range tokens {
+		entry := map[string]interface{}{
+			"$value": token.Value,
+			"$type":  w3cType(token.Type),
+		}
+		if token.Description != "" {
+			entry["$description"] = token.Description
+		}
+
+		group := root
+		if token.Category != "" {
+			sub, ok := root[token.Category].(map[string]interface{})
+			if !ok {
+				sub = make(map[string]interface{})
+				root[token.Category] = sub
+			}
+			group = sub
+		}
+		group[w3cTokenName(token)] = entry
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(root); err != nil {
+		return fmt.Errorf("failed to encode W3C tokens: %w", err)
+	}
+
+	logger.InfoEvent().
+		Int("count", len(tokens)).
+		Msg("W3C token export completed")
+
+	return nil
+}
+
+// ImportTokensW3C reads W3C Design Tokens JSON from r, translates the
+// entries into the internal token shape, and uploads them using the given
+// conflict strategy. Unknown $type values import as generic tokens with a
+// logged warning.
+func (c *Client) ImportTokensW3C(ctx context.Context, r io.Reader, conflict design.ConflictResolutionStrategyUpload) (*UploadTokensResponse, error) {
+	logger.InfoEvent().Msg("Importing design tokens from W3C format")
+
+	var root map[string]interface{}
+	if err := json.NewDecoder(r).Decode(&root); err != nil {
+		return nil, fmt.Errorf("failed to parse W3C tokens: %w", err)
+	}
+
+	tokens := collectW3CTokens(root, nil, "")
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("no tokens found in W3C document")
+	}
+
+	// Walk order of JSON maps is random; keep uploads deterministic
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].Name < tokens[j].Name
+	})
+
+	return c.UploadTokens(ctx, tokens, conflict, nil)
+}
+
+// collectW3CTokens walks a W3C token group, producing internal tokens.
+// path carries the group names leading to the current node; groupType is
+// an inherited $type, which W3C allows on groups.
+func collectW3CTokens(group map[string]interface{}, path []string, groupType string) []*design.Token {
+	if t, ok := group["$type"].(string); ok {
+		groupType = t
+	}
+
+	var tokens []*design.Token
+	for name, raw := range group {
+		if strings.HasPrefix(name, "$") {
+			continue
+		}
+		node, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if value, isToken := node["$value"]; isToken {
+			tokens = append(tokens, w3cToken(append(path, name), node, value, groupType))
+			continue
+		}
+
+		tokens = append(tokens, collectW3CTokens(node, append(path, name), groupType)...)
+	}
+
+	return tokens
+}
+
+// w3cToken converts a single W3C token node into the internal shape.
+func w3cToken(path []string, node map[string]interface{}, value interface{}, inheritedType string) *design.Token {
+	typeName := inheritedType
+	if t, ok := node["$type"].(string); ok {
+		typeName = t
+	}
+
+	token := &design.Token{
+		Name:  strings.Join(path, "."),
+		Type:  internalType(path, typeName),
+		Value: w3cValueString(value),
+	}
+	if len(path) > 1 {
+		token.Category = path[0]
+	}
+	if desc, ok := node["$description"].(string); ok {
+		token.Description = desc
+	}
+
+	return token
+}
+
+// w3cType maps an internal token type onto the W3C vocabulary.
+func w3cType(t design.TokenType) string {
+	switch t {
+	case design.TokenTypeColor:
+		return "color"
+	case design.TokenTypeSpacing, design.TokenTypeBorderRadius:
+		return "dimension"
+	case design.TokenTypeTypography:
+		return "typography"
+	case design.TokenTypeShadow:
+		return "shadow"
+	default:
+		return string(t)
+	}
+}
+
+// internalType maps a W3C $type onto the internal vocabulary, warning on
+// types the internal model does not know.
+func internalType(path []string, typeName string) design.TokenType {
+	switch typeName {
+	case "color":
+		return design.TokenTypeColor
+	case "dimension":
+		return design.TokenTypeSpacing
+	case "typography", "fontFamily", "fontWeight":
+		return design.TokenTypeTypography
+	case "shadow":
+		return design.TokenTypeShadow
+	default:
+		logger.WarnEvent().
+			Str("token", strings.Join(path, ".")).
+			Str("type", typeName).
+			Msg("Unknown W3C token type, importing as generic token")
+		return design.TokenType(typeName)
+	}
+}
+
+// w3cValueString flattens a W3C $value into the internal string value.
+// Composite values (typography, shadow objects) are kept as compact JSON.
+func w3cValueString(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+// w3cTokenName strips a redundant category prefix from a token name so
+// exported groups don't repeat it.
+func w3cTokenName(token *design.Token) string {
+	if token.Category != "" {
+		return strings.TrimPrefix(token.Name, token.Category+".")
+	}
+	return token.Name
+}
@@ -0,0 +1,169 @@
+package design
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AINative-studio/ainative-code/internal/client"
+	"github.com/AINative-studio/ainative-code/internal/design"
+)
+
+// TestExportTokensW3C tests exporting tokens in W3C format.
+func TestExportTokensW3C(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(TokenQueryResponse{
+			Tokens: []*design.Token{
+				{Name: "colors.primary", Value: "#007bff", Type: design.TokenTypeColor, Category: "colors", Description: "Primary brand color"},
+				{Name: "spacing.base", Value: "16px", Type: design.TokenTypeSpacing, Category: "spacing"},
+			},
+			Total: 2,
+		})
+	}))
+	defer server.Close()
+
+	apiClient := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithTimeout(5*time.Second),
+	)
+	designClient := New(
+		WithAPIClient(apiClient),
+		WithProjectID("test-project"),
+	)
+
+	var buf bytes.Buffer
+	if err := designClient.ExportTokensW3C(context.Background(), &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var root map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &root); err != nil {
+		t.Fatalf("export is not valid JSON: %v", err)
+	}
+
+	colors, ok := root["colors"].(map[string]interface{})
+	if !ok {
+		t.Fatal("expected colors group in export")
+	}
+	primary, ok := colors["primary"].(map[string]interface{})
+	if !ok {
+		t.Fatal("expected colors.primary token in colors group")
+	}
+	if primary["$value"] != "#007bff" {
+		t.Errorf("expected $value #007bff, got %v", primary["$value"])
+	}
+	if primary["$type"] != "color" {
+		t.Errorf("expected $type color, got %v", primary["$type"])
+	}
+	if primary["$description"] != "Primary brand color" {
+		t.Errorf("expected $description, got %v", primary["$description"])
+	}
+
+	spacing := root["spacing"].(map[string]interface{})
+	base := spacing["base"].(map[string]interface{})
+	if base["$type"] != "dimension" {
+		t.Errorf("expected spacing to export as dimension, got %v", base["$type"])
+	}
+}
+
+// TestImportTokensW3C tests importing tokens from W3C format.
+func TestImportTokensW3C(t *testing.T) {
+	var uploaded UploadTokensRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&uploaded); err != nil {
+			t.Errorf("failed to decode upload: %v", err)
+		}
+		json.NewEncoder(w).Encode(UploadTokensResponse{
+			Success:       true,
+			UploadedCount: len(uploaded.Tokens),
+		})
+	}))
+	defer server.Close()
+
+	apiClient := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithTimeout(5*time.Second),
+	)
+	designClient := New(
+		WithAPIClient(apiClient),
+		WithProjectID("test-project"),
+	)
+
+	doc := `{
+		"colors": {
+			"$type": "color",
+			"primary": {"$value": "#007bff", "$description": "Primary brand color"},
+			"accent": {"$value": "#ff5722", "$type": "color"}
+		},
+		"spacing": {
+			"base": {"$value": "16px", "$type": "dimension"}
+		},
+		"typography": {
+			"body": {"$value": {"fontFamily": "Inter", "fontSize": "16px"}, "$type": "typography"}
+		},
+		"misc": {
+			"beep": {"$value": "800ms", "$type": "duration"}
+		}
+	}`
+
+	result, err := designClient.ImportTokensW3C(context.Background(), strings.NewReader(doc), design.ConflictOverwrite)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.UploadedCount != 5 {
+		t.Errorf("expected 5 uploaded tokens, got %d", result.UploadedCount)
+	}
+
+	byName := make(map[string]*design.Token)
+	for _, token := range uploaded.Tokens {
+		byName[token.Name] = token
+	}
+
+	primary := byName["colors.primary"]
+	if primary == nil {
+		t.Fatal("expected colors.primary token")
+	}
+	if primary.Type != design.TokenTypeColor {
+		t.Errorf("expected inherited color type, got %q", primary.Type)
+	}
+	if primary.Category != "colors" {
+		t.Errorf("expected category colors, got %q", primary.Category)
+	}
+	if primary.Description != "Primary brand color" {
+		t.Errorf("expected description, got %q", primary.Description)
+	}
+
+	if byName["spacing.base"].Type != design.TokenTypeSpacing {
+		t.Errorf("expected dimension to import as spacing, got %q", byName["spacing.base"].Type)
+	}
+
+	// Composite values are preserved as compact JSON
+	body := byName["typography.body"]
+	if body == nil || !strings.Contains(body.Value, `"fontFamily":"Inter"`) {
+		t.Errorf("expected composite typography value, got %+v", body)
+	}
+
+	// Unknown $type imports as a generic token carrying the raw type
+	beep := byName["misc.beep"]
+	if beep == nil || beep.Type != design.TokenType("duration") {
+		t.Errorf("expected unknown type to import generically, got %+v", beep)
+	}
+}
+
+// TestImportTokensW3C_Empty tests that an empty document is rejected.
+func TestImportTokensW3C_Empty(t *testing.T) {
+	designClient := New(
+		WithAPIClient(client.New()),
+		WithProjectID("test-project"),
+	)
+
+	_, err := designClient.ImportTokensW3C(context.Background(), strings.NewReader(`{}`), design.ConflictOverwrite)
+	if err == nil || !strings.Contains(err.Error(), "no tokens found") {
+		t.Errorf("expected no-tokens error, got %v", err)
+	}
+}
@@ -1,8 +1,10 @@
 package client
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 )
 
 var (
@@ -41,8 +43,105 @@ var (
 
 	// ErrInvalidRequest indicates an invalid request.
 	ErrInvalidRequest = errors.New("invalid request")
+
+	// ErrCircuitOpen indicates the circuit breaker is open and the request
+	// was short-circuited without being sent.
+	ErrCircuitOpen = errors.New("circuit breaker open")
 )
 
+// APIError is a structured error for non-2xx API responses, carrying the
+// HTTP status, the API's error code and message, and the request ID when the
+// server provided one. Callers can make retry/abort decisions with
+// IsRateLimited, IsUnauthorized, and StatusCode instead of matching strings.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	RequestID  string
+	Body       []byte
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = string(e.Body)
+	}
+	if e.Code != "" {
+		return fmt.Sprintf("HTTP %d (%s): %s", e.StatusCode, e.Code, msg)
+	}
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, msg)
+}
+
+// newAPIError builds an APIError from a response, extracting the code and
+// message from common JSON error body shapes.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Body:       body,
+	}
+
+	if requestID := resp.Header.Get("X-Request-ID"); requestID != "" {
+		apiErr.RequestID = requestID
+	}
+
+	// Try the nested {"error": {"code", "message"}} shape first, then the
+	// flat {"code", "message"} / {"detail"} shapes
+	var nested struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &nested); err == nil && nested.Error.Message != "" {
+		apiErr.Code = nested.Error.Code
+		apiErr.Message = nested.Error.Message
+		return apiErr
+	}
+
+	var flat struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+		Detail  string `json:"detail"`
+	}
+	if err := json.Unmarshal(body, &flat); err == nil {
+		apiErr.Code = flat.Code
+		if flat.Message != "" {
+			apiErr.Message = flat.Message
+		} else {
+			apiErr.Message = flat.Detail
+		}
+	}
+
+	return apiErr
+}
+
+// IsRateLimited reports whether the error is a 429 response.
+func IsRateLimited(err error) bool {
+	return StatusCode(err) == http.StatusTooManyRequests
+}
+
+// IsUnauthorized reports whether the error is a 401 response.
+func IsUnauthorized(err error) bool {
+	return StatusCode(err) == http.StatusUnauthorized
+}
+
+// StatusCode extracts the HTTP status from an APIError or HTTPError anywhere
+// in the error chain, or 0 when there is none.
+func StatusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode
+	}
+
+	return 0
+}
+
 // HTTPError represents an HTTP error with status code and message.
 type HTTPError struct {
 	StatusCode int
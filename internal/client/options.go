@@ -38,6 +38,42 @@ func WithMaxRetries(maxRetries int) Option {
 	}
 }
 
+// WithCircuitBreaker enables a circuit breaker that opens after threshold
+// consecutive failures, short-circuits requests with ErrCircuitOpen for the
+// cooldown, then half-opens to probe recovery. Breaker state is tracked per
+// base URL.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) Option {
+	return func(c *Client) {
+		c.breakerThreshold = threshold
+		c.breakerCooldown = cooldown
+	}
+}
+
+// WithRateLimit paces all outgoing requests through a token bucket refilling
+// at rps tokens per second with the given burst. Requests block (respecting
+// their context) until a token is available. The bucket is shared by every
+// goroutine using the client.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *Client) {
+		if rps > 0 {
+			c.rateLimiter = newRateLimiter(rps, burst)
+		}
+	}
+}
+
+// WithResponseCache enables an LRU cache of up to size validated GET
+// responses. Cached entries are revalidated with If-None-Match /
+// If-Modified-Since and served locally on a 304. Entries are keyed by URL
+// and account so responses never leak between accounts; only GETs are
+// cached.
+func WithResponseCache(size int) Option {
+	return func(c *Client) {
+		if size > 0 {
+			c.respCache = newResponseCache(size)
+		}
+	}
+}
+
 // WithHTTPClient sets a custom HTTP client.
 func WithHTTPClient(httpClient *http.Client) Option {
 	return func(c *Client) {
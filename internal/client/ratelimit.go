@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket that paces outgoing requests. It refills at
+// rate tokens per second up to burst, and callers block until a token is
+// available. Safe for concurrent use by all goroutines sharing a client.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	waits     int64
+	totalWait time.Duration
+}
+
+// RateLimitStats reports how often and how long requests have waited on the
+// rate limiter.
+type RateLimitStats struct {
+	// Waits is the number of requests that had to wait for a token
+	Waits int64
+
+	// TotalWait is the cumulative time spent waiting
+	TotalWait time.Duration
+}
+
+// newRateLimiter creates a token bucket starting full.
+func newRateLimiter(rps float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &rateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until a token is available or the context is cancelled.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	waited := time.Duration(0)
+
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+
+		if l.tokens >= 1 {
+			l.tokens--
+			if waited > 0 {
+				l.waits++
+				l.totalWait += waited
+			}
+			l.mu.Unlock()
+			return nil
+		}
+
+		// Time until one full token accrues
+		delay := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+			waited += delay
+		}
+	}
+}
+
+// stats returns a snapshot of the limiter's wait metrics.
+func (l *rateLimiter) stats() RateLimitStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return RateLimitStats{
+		Waits:     l.waits,
+		TotalWait: l.totalWait,
+	}
+}
+
+// RateLimitStats returns the client's rate limiter wait metrics. The zero
+// value is returned when no rate limit is configured.
+func (c *Client) RateLimitStats() RateLimitStats {
+	if c.rateLimiter == nil {
+		return RateLimitStats{}
+	}
+	return c.rateLimiter.stats()
+}
@@ -0,0 +1,116 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AINative-studio/ainative-code/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func okServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+}
+
+func TestRateLimit_PacesSequentialRequests(t *testing.T) {
+	server := okServer()
+	defer server.Close()
+
+	// 50 rps with burst 1: five requests need at least ~80ms
+	c := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithRateLimit(50, 1),
+	)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		_, err := c.Get(context.Background(), "/test")
+		require.NoError(t, err)
+	}
+	elapsed := time.Since(start)
+
+	assert.GreaterOrEqual(t, elapsed, 70*time.Millisecond)
+
+	stats := c.RateLimitStats()
+	assert.Greater(t, stats.Waits, int64(0))
+	assert.Greater(t, stats.TotalWait, time.Duration(0))
+}
+
+func TestRateLimit_SharedAcrossGoroutines(t *testing.T) {
+	server := okServer()
+	defer server.Close()
+
+	c := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithRateLimit(50, 1),
+	)
+
+	// Ten concurrent requests through one bucket still take ~180ms
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.Get(context.Background(), "/test")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+}
+
+func TestRateLimit_BurstAllowsImmediateRequests(t *testing.T) {
+	server := okServer()
+	defer server.Close()
+
+	c := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithRateLimit(1, 5),
+	)
+
+	// The initial burst covers all five requests without waiting
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		_, err := c.Get(context.Background(), "/test")
+		require.NoError(t, err)
+	}
+
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.Equal(t, int64(0), c.RateLimitStats().Waits)
+}
+
+func TestRateLimit_RespectsContext(t *testing.T) {
+	server := okServer()
+	defer server.Close()
+
+	c := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithRateLimit(0.1, 1),
+	)
+
+	// Consume the only token
+	_, err := c.Get(context.Background(), "/test")
+	require.NoError(t, err)
+
+	// The next request would wait ~10s; the context expires first
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = c.Get(ctx, "/test")
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRateLimitStats_ZeroWithoutLimiter(t *testing.T) {
+	c := client.New()
+	assert.Equal(t, client.RateLimitStats{}, c.RateLimitStats())
+}
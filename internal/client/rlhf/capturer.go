@@ -0,0 +1,212 @@
+package rlhf
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/AINative-studio/ainative-code/internal/logger"
+	"github.com/AINative-studio/ainative-code/internal/provider"
+)
+
+const (
+	// DefaultCaptureQueueSize is how many interactions a Capturer holds
+	// before dropping new ones.
+	DefaultCaptureQueueSize = 64
+
+	// DefaultCaptureScore is the neutral score recorded for captured
+	// interactions until the user rates them.
+	DefaultCaptureScore = 0.5
+)
+
+// Capturer wraps a provider.Provider and records each prompt/response pair
+// as an InteractionFeedback with a neutral score, so chat sessions feed the
+// RLHF pipeline without explicit submission at every turn. Captured
+// interactions accumulate in a bounded queue and are batch-submitted by
+// Flush; the chat path itself never blocks on the RLHF API, and new
+// interactions are dropped with a logged warning when the queue is full.
+type Capturer struct {
+	inner     provider.Provider
+	client    *Client
+	sessionID string
+	queueSize int
+
+	mu    sync.Mutex
+	queue []*InteractionFeedback
+}
+
+// CapturerOption is a functional option for configuring the Capturer.
+type CapturerOption func(*Capturer)
+
+// WithCaptureSessionID tags captured interactions with a chat session ID.
+func WithCaptureSessionID(sessionID string) CapturerOption {
+	return func(c *Capturer) {
+		c.sessionID = sessionID
+	}
+}
+
+// WithCaptureQueueSize sets how many interactions may be queued before new
+// ones are dropped.
+func WithCaptureQueueSize(size int) CapturerOption {
+	return func(c *Capturer) {
+		if size > 0 {
+			c.queueSize = size
+		}
+	}
+}
+
+// NewCapturer wraps a provider so its chat interactions are captured for
+// RLHF submission through the given client.
+func NewCapturer(inner provider.Provider, client *Client, opts ...CapturerOption) *Capturer {
+	c := &Capturer{
+		inner:     inner,
+		client:    client,
+		queueSize: DefaultCaptureQueueSize,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Name returns the wrapped provider's name.
+func (c *Capturer) Name() string {
+	return c.inner.Name()
+}
+
+// Models returns the wrapped provider's supported models.
+func (c *Capturer) Models() []string {
+	return c.inner.Models()
+}
+
+// Close flushes queued interactions on a best-effort basis and closes the
+// wrapped provider.
+func (c *Capturer) Close() error {
+	if err := c.Flush(context.Background()); err != nil {
+		logger.WarnEvent().Err(err).Msg("Failed to flush captured interactions on close")
+	}
+	return c.inner.Close()
+}
+
+// Chat delegates to the wrapped provider and captures the interaction on
+// success.
+func (c *Capturer) Chat(ctx context.Context, messages []provider.Message, opts ...provider.ChatOption) (provider.Response, error) {
+	resp, err := c.inner.Chat(ctx, messages, opts...)
+	if err == nil {
+		c.capture(lastUserPrompt(messages), resp.Content, resp.Model)
+	}
+	return resp, err
+}
+
+// Stream delegates to the wrapped provider and captures the assembled
+// content once the stream completes without error.
+func (c *Capturer) Stream(ctx context.Context, messages []provider.Message, opts ...provider.StreamOption) (<-chan provider.Event, error) {
+	innerChan, err := c.inner.Stream(ctx, messages, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	eventChan := make(chan provider.Event)
+	go func() {
+		defer close(eventChan)
+
+		var content string
+		var streamErr error
+		for event := range innerChan {
+			switch event.Type {
+			case provider.EventTypeContentDelta:
+				content += event.Content
+			case provider.EventTypeError:
+				streamErr = event.Error
+			}
+			eventChan <- event
+		}
+
+		if streamErr == nil {
+			c.capture(lastUserPrompt(messages), content, "")
+		}
+	}()
+
+	return eventChan, nil
+}
+
+// Flush batch-submits all queued interactions. Interactions that fail to
+// submit are re-queued (up to the queue size) so a later flush can retry.
+func (c *Capturer) Flush(ctx context.Context) error {
+	c.mu.Lock()
+	queued := c.queue
+	c.queue = nil
+	c.mu.Unlock()
+
+	if len(queued) == 0 {
+		return nil
+	}
+
+	_, err := c.client.SubmitBatchInteractionFeedback(ctx, &BatchInteractionFeedback{
+		Interactions: queued,
+	})
+	if err != nil {
+		c.mu.Lock()
+		if room := c.queueSize - len(c.queue); room > 0 {
+			if room > len(queued) {
+				room = len(queued)
+			}
+			c.queue = append(queued[:room], c.queue...)
+		}
+		c.mu.Unlock()
+		return err
+	}
+
+	logger.DebugEvent().
+		Int("count", len(queued)).
+		Msg("Flushed captured interactions")
+
+	return nil
+}
+
+// Pending returns the number of captured interactions awaiting submission.
+func (c *Capturer) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.queue)
+}
+
+// capture queues one prompt/response pair, dropping it with a warning when
+// the queue is full.
+func (c *Capturer) capture(prompt, response, modelID string) {
+	if prompt == "" || response == "" {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.queue) >= c.queueSize {
+		logger.WarnEvent().
+			Int("queue_size", c.queueSize).
+			Msg("Capture queue full, dropping interaction")
+		return
+	}
+
+	c.queue = append(c.queue, &InteractionFeedback{
+		Prompt:    prompt,
+		Response:  response,
+		Score:     DefaultCaptureScore,
+		SessionID: c.sessionID,
+		ModelID:   modelID,
+		Timestamp: time.Now(),
+		Metadata: map[string]interface{}{
+			"auto_captured": true,
+		},
+	})
+}
+
+// lastUserPrompt returns the content of the most recent user message.
+func lastUserPrompt(messages []provider.Message) string {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role == "user" {
+			return messages[i].Content
+		}
+	}
+	return ""
+}
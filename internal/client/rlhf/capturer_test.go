@@ -0,0 +1,161 @@
+package rlhf_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AINative-studio/ainative-code/internal/client"
+	"github.com/AINative-studio/ainative-code/internal/client/rlhf"
+	"github.com/AINative-studio/ainative-code/internal/provider"
+	"github.com/AINative-studio/ainative-code/internal/provider/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCaptureServer returns a server that records batch submissions.
+func newCaptureServer(t *testing.T, batches *[]rlhf.BatchInteractionFeedback) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/interactions/feedback/batch", r.URL.Path)
+
+		var batch rlhf.BatchInteractionFeedback
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+		*batches = append(*batches, batch)
+
+		resp := rlhf.BatchInteractionFeedbackResponse{
+			TotalProcessed: len(batch.Interactions),
+			SuccessCount:   len(batch.Interactions),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestCapturerCapturesChat(t *testing.T) {
+	var batches []rlhf.BatchInteractionFeedback
+	server := newCaptureServer(t, &batches)
+	defer server.Close()
+
+	apiClient := client.New(client.WithBaseURL(server.URL))
+	rlhfClient := rlhf.New(rlhf.WithAPIClient(apiClient))
+
+	inner := mock.NewMockProvider()
+	inner.EnqueueResponse(provider.Response{Content: "Use jwt-go.", Model: "mock-model"})
+
+	capturer := rlhf.NewCapturer(inner, rlhfClient, rlhf.WithCaptureSessionID("session-001"))
+
+	resp, err := capturer.Chat(context.Background(), []provider.Message{
+		{Role: "system", Content: "be helpful"},
+		{Role: "user", Content: "How do I do auth in Go?"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Use jwt-go.", resp.Content)
+	assert.Equal(t, 1, capturer.Pending())
+
+	require.NoError(t, capturer.Flush(context.Background()))
+	assert.Equal(t, 0, capturer.Pending())
+
+	require.Len(t, batches, 1)
+	require.Len(t, batches[0].Interactions, 1)
+	captured := batches[0].Interactions[0]
+	assert.Equal(t, "How do I do auth in Go?", captured.Prompt)
+	assert.Equal(t, "Use jwt-go.", captured.Response)
+	assert.Equal(t, rlhf.DefaultCaptureScore, captured.Score)
+	assert.Equal(t, "session-001", captured.SessionID)
+	assert.Equal(t, "mock-model", captured.ModelID)
+}
+
+func TestCapturerCapturesStream(t *testing.T) {
+	var batches []rlhf.BatchInteractionFeedback
+	server := newCaptureServer(t, &batches)
+	defer server.Close()
+
+	apiClient := client.New(client.WithBaseURL(server.URL))
+	rlhfClient := rlhf.New(rlhf.WithAPIClient(apiClient))
+
+	inner := mock.NewMockProvider()
+	inner.EnqueueStream([]string{"Hello, ", "world!"})
+
+	capturer := rlhf.NewCapturer(inner, rlhfClient)
+
+	events, err := capturer.Stream(context.Background(), []provider.Message{
+		{Role: "user", Content: "Say hello"},
+	})
+	require.NoError(t, err)
+
+	var content string
+	for event := range events {
+		if event.Type == provider.EventTypeContentDelta {
+			content += event.Content
+		}
+	}
+	assert.Equal(t, "Hello, world!", content)
+
+	require.NoError(t, capturer.Flush(context.Background()))
+	require.Len(t, batches, 1)
+	require.Len(t, batches[0].Interactions, 1)
+	assert.Equal(t, "Hello, world!", batches[0].Interactions[0].Response)
+}
+
+func TestCapturerFlushEmptyQueue(t *testing.T) {
+	var batches []rlhf.BatchInteractionFeedback
+	server := newCaptureServer(t, &batches)
+	defer server.Close()
+
+	apiClient := client.New(client.WithBaseURL(server.URL))
+	rlhfClient := rlhf.New(rlhf.WithAPIClient(apiClient))
+
+	capturer := rlhf.NewCapturer(mock.NewMockProvider(), rlhfClient)
+
+	require.NoError(t, capturer.Flush(context.Background()))
+	assert.Empty(t, batches, "flush with nothing queued should not hit the API")
+}
+
+func TestCapturerDropsOnOverflow(t *testing.T) {
+	apiClient := client.New(client.WithBaseURL("http://localhost:0"))
+	rlhfClient := rlhf.New(rlhf.WithAPIClient(apiClient))
+
+	inner := mock.NewMockProvider()
+	for i := 0; i < 3; i++ {
+		inner.EnqueueResponse(provider.Response{Content: "ok", Model: "mock-model"})
+	}
+
+	capturer := rlhf.NewCapturer(inner, rlhfClient, rlhf.WithCaptureQueueSize(2))
+
+	for i := 0; i < 3; i++ {
+		_, err := capturer.Chat(context.Background(), []provider.Message{
+			{Role: "user", Content: "hi"},
+		})
+		require.NoError(t, err)
+	}
+
+	// The third capture is dropped rather than blocking the chat path
+	assert.Equal(t, 2, capturer.Pending())
+}
+
+func TestCapturerRequeuesOnFlushFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"detail":"rejected"}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.New(client.WithBaseURL(server.URL), client.WithMaxRetries(0))
+	rlhfClient := rlhf.New(rlhf.WithAPIClient(apiClient))
+
+	inner := mock.NewMockProvider()
+	inner.EnqueueResponse(provider.Response{Content: "ok", Model: "mock-model"})
+
+	capturer := rlhf.NewCapturer(inner, rlhfClient)
+
+	_, err := capturer.Chat(context.Background(), []provider.Message{
+		{Role: "user", Content: "hi"},
+	})
+	require.NoError(t, err)
+
+	require.Error(t, capturer.Flush(context.Background()))
+	assert.Equal(t, 1, capturer.Pending(), "failed submissions stay queued for retry")
+}
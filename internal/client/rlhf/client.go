@@ -165,6 +165,48 @@ func (c *Client) SubmitCorrection(ctx context.Context, correction *Correction) (
 	return &resp, nil
 }
 
+// SubmitCorrectionDiff computes a unified diff between the assistant's
+// original output and the user's corrected version and submits both along
+// with the diff. Use RenderDiff to show the same diff in the terminal.
+func (c *Client) SubmitCorrectionDiff(ctx context.Context, original, corrected string, meta map[string]interface{}) (*CorrectionResult, error) {
+	logger.InfoEvent().
+		Int("original_len", len(original)).
+		Int("corrected_len", len(corrected)).
+		Msg("Submitting correction diff")
+
+	if corrected == "" {
+		return nil, fmt.Errorf("corrected response is required")
+	}
+	if original == corrected {
+		return nil, fmt.Errorf("corrected response is identical to the original")
+	}
+
+	req := &CorrectionDiffRequest{
+		Original:  original,
+		Corrected: corrected,
+		Diff:      UnifiedDiff(original, corrected),
+		Metadata:  meta,
+	}
+
+	path := "/api/corrections/diff"
+	respData, err := c.apiClient.Post(ctx, path, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit correction diff: %w", err)
+	}
+
+	var result CorrectionResult
+	if err := json.Unmarshal(respData, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	logger.InfoEvent().
+		Str("correction_id", result.CorrectionID).
+		Str("status", result.Status).
+		Msg("Correction diff submitted successfully")
+
+	return &result, nil
+}
+
 // GetAnalytics retrieves RLHF analytics for the specified parameters.
 func (c *Client) GetAnalytics(ctx context.Context, req *AnalyticsRequest) (*Analytics, error) {
 	logger.DebugEvent().
@@ -306,6 +348,123 @@ func (c *Client) ListInteractions(ctx context.Context, modelID, sessionID string
 	return interactions, nil
 }
 
+// ListOption is a functional option for filtering ListFeedback results.
+type ListOption func(*listOptions)
+
+// listOptions contains filters for listing feedback.
+type listOptions struct {
+	sessionID string
+	modelID   string
+	startDate time.Time
+	endDate   time.Time
+	limit     int
+}
+
+// WithSessionID filters feedback to a single chat session.
+func WithSessionID(sessionID string) ListOption {
+	return func(o *listOptions) {
+		o.sessionID = sessionID
+	}
+}
+
+// WithModelID filters feedback to a single model.
+func WithModelID(modelID string) ListOption {
+	return func(o *listOptions) {
+		o.modelID = modelID
+	}
+}
+
+// WithDateRange filters feedback to entries recorded between start and end.
+func WithDateRange(start, end time.Time) ListOption {
+	return func(o *listOptions) {
+		o.startDate = start
+		o.endDate = end
+	}
+}
+
+// WithLimit caps the number of entries returned.
+func WithLimit(limit int) ListOption {
+	return func(o *listOptions) {
+		o.limit = limit
+	}
+}
+
+// ListFeedback lists recorded feedback entries, newest first, optionally
+// filtered by session, model, and date range.
+func (c *Client) ListFeedback(ctx context.Context, opts ...ListOption) ([]*InteractionFeedback, error) {
+	options := &listOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	logger.DebugEvent().
+		Str("session_id", options.sessionID).
+		Str("model_id", options.modelID).
+		Int("limit", options.limit).
+		Msg("Listing feedback entries")
+
+	params := url.Values{}
+	if options.sessionID != "" {
+		params.Add("session_id", options.sessionID)
+	}
+	if options.modelID != "" {
+		params.Add("model_id", options.modelID)
+	}
+	if !options.startDate.IsZero() {
+		params.Add("start_date", options.startDate.Format(time.RFC3339))
+	}
+	if !options.endDate.IsZero() {
+		params.Add("end_date", options.endDate.Format(time.RFC3339))
+	}
+	if options.limit > 0 {
+		params.Add("limit", fmt.Sprintf("%d", options.limit))
+	}
+
+	path := "/api/interactions/feedback"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	respData, err := c.apiClient.Get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feedback: %w", err)
+	}
+
+	var feedback []*InteractionFeedback
+	if err := json.Unmarshal(respData, &feedback); err != nil {
+		return nil, fmt.Errorf("failed to parse feedback response: %w", err)
+	}
+
+	logger.InfoEvent().
+		Int("count", len(feedback)).
+		Msg("Feedback entries listed successfully")
+
+	return feedback, nil
+}
+
+// GetStats retrieves summary statistics over all collected feedback.
+func (c *Client) GetStats(ctx context.Context) (*FeedbackStats, error) {
+	logger.DebugEvent().Msg("Fetching feedback statistics")
+
+	path := "/api/interactions/feedback/stats"
+	respData, err := c.apiClient.Get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback stats: %w", err)
+	}
+
+	var stats FeedbackStats
+	if err := json.Unmarshal(respData, &stats); err != nil {
+		return nil, fmt.Errorf("failed to parse stats response: %w", err)
+	}
+
+	logger.InfoEvent().
+		Int("total_entries", stats.TotalEntries).
+		Float64("average_score", stats.AverageScore).
+		Msg("Feedback statistics retrieved successfully")
+
+	return &stats, nil
+}
+
 // GetCorrection retrieves a specific correction by ID.
 func (c *Client) GetCorrection(ctx context.Context, correctionID string) (*CorrectionResponse, error) {
 	logger.DebugEvent().
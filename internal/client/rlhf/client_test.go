@@ -414,3 +414,70 @@ func TestGetCorrection(t *testing.T) {
 		assert.Contains(t, err.Error(), "correction_id is required")
 	})
 }
+
+func TestListFeedback(t *testing.T) {
+	var gotQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/api/interactions/feedback", r.URL.Path)
+		gotQuery = r.URL.RawQuery
+
+		feedback := []*rlhf.InteractionFeedback{
+			{Prompt: "p1", Response: "r1", Score: 0.9, SessionID: "session-001"},
+			{Prompt: "p2", Response: "r2", Score: 0.7, SessionID: "session-001"},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(feedback)
+	}))
+	defer server.Close()
+
+	apiClient := client.New(client.WithBaseURL(server.URL))
+	rlhfClient := rlhf.New(rlhf.WithAPIClient(apiClient))
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC)
+
+	feedback, err := rlhfClient.ListFeedback(context.Background(),
+		rlhf.WithSessionID("session-001"),
+		rlhf.WithModelID("claude-3-5-sonnet-20241022"),
+		rlhf.WithDateRange(start, end),
+		rlhf.WithLimit(20),
+	)
+	require.NoError(t, err)
+	require.Len(t, feedback, 2)
+	assert.Equal(t, "p1", feedback[0].Prompt)
+
+	assert.Contains(t, gotQuery, "session_id=session-001")
+	assert.Contains(t, gotQuery, "model_id=claude-3-5-sonnet-20241022")
+	assert.Contains(t, gotQuery, "limit=20")
+	assert.Contains(t, gotQuery, "start_date=")
+	assert.Contains(t, gotQuery, "end_date=")
+}
+
+func TestGetStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/api/interactions/feedback/stats", r.URL.Path)
+
+		stats := rlhf.FeedbackStats{
+			TotalEntries: 120,
+			AverageScore: 0.87,
+			ScoreDistribution: map[string]int{
+				"0.6-0.8": 40,
+				"0.8-1.0": 80,
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(stats)
+	}))
+	defer server.Close()
+
+	apiClient := client.New(client.WithBaseURL(server.URL))
+	rlhfClient := rlhf.New(rlhf.WithAPIClient(apiClient))
+
+	stats, err := rlhfClient.GetStats(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 120, stats.TotalEntries)
+	assert.InDelta(t, 0.87, stats.AverageScore, 0.001)
+	assert.Equal(t, 80, stats.ScoreDistribution["0.8-1.0"])
+}
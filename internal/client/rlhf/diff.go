@@ -0,0 +1,146 @@
+package rlhf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// UnifiedDiff computes a unified diff between an assistant's original
+// output and a corrected version, using a line-based longest-common-
+// subsequence. It returns an empty string when the two inputs are equal.
+func UnifiedDiff(original, corrected string) string {
+	origLines := splitLines(original)
+	corrLines := splitLines(corrected)
+
+	ops := diffLines(origLines, corrLines)
+
+	changed := false
+	for _, op := range ops {
+		if op.kind != diffEqual {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("--- original\n")
+	b.WriteString("+++ corrected\n")
+	fmt.Fprintf(&b, "@@ -1,%d +1,%d @@\n", len(origLines), len(corrLines))
+	for _, op := range ops {
+		switch op.kind {
+		case diffEqual:
+			b.WriteString(" " + op.line + "\n")
+		case diffDelete:
+			b.WriteString("-" + op.line + "\n")
+		case diffInsert:
+			b.WriteString("+" + op.line + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+// RenderDiff produces a colored terminal rendering of the diff between
+// original and corrected, with removals in red and additions in green.
+func RenderDiff(original, corrected string) string {
+	diff := UnifiedDiff(original, corrected)
+	if diff == "" {
+		return ""
+	}
+
+	red := color.New(color.FgRed).SprintFunc()
+	green := color.New(color.FgGreen).SprintFunc()
+	cyan := color.New(color.FgCyan).SprintFunc()
+
+	var b strings.Builder
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "@@"):
+			b.WriteString(cyan(line))
+		case strings.HasPrefix(line, "-"):
+			b.WriteString(red(line))
+		case strings.HasPrefix(line, "+"):
+			b.WriteString(green(line))
+		default:
+			b.WriteString(line)
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// diffKind classifies a line in a diff.
+type diffKind int
+
+const (
+	diffEqual diffKind = iota
+	diffDelete
+	diffInsert
+)
+
+// diffOp is one line of a computed diff.
+type diffOp struct {
+	kind diffKind
+	line string
+}
+
+// splitLines splits text into lines without treating an empty string as a
+// single empty line.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// diffLines computes line-level diff operations via a longest-common-
+// subsequence table.
+func diffLines(a, b []string) []diffOp {
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{diffEqual, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{diffDelete, a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{diffInsert, b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{diffDelete, a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{diffInsert, b[j]})
+	}
+
+	return ops
+}
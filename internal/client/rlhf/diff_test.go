@@ -0,0 +1,104 @@
+package rlhf_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/AINative-studio/ainative-code/internal/client"
+	"github.com/AINative-studio/ainative-code/internal/client/rlhf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	t.Run("single line change", func(t *testing.T) {
+		diff := rlhf.UnifiedDiff("use jwt-go", "use golang-jwt")
+		assert.Contains(t, diff, "--- original")
+		assert.Contains(t, diff, "+++ corrected")
+		assert.Contains(t, diff, "-use jwt-go")
+		assert.Contains(t, diff, "+use golang-jwt")
+	})
+
+	t.Run("multi-line keeps unchanged context", func(t *testing.T) {
+		original := "line one\nline two\nline three"
+		corrected := "line one\nline 2\nline three"
+
+		diff := rlhf.UnifiedDiff(original, corrected)
+		assert.Contains(t, diff, " line one\n")
+		assert.Contains(t, diff, "-line two\n")
+		assert.Contains(t, diff, "+line 2\n")
+		assert.Contains(t, diff, " line three\n")
+	})
+
+	t.Run("identical inputs produce empty diff", func(t *testing.T) {
+		assert.Empty(t, rlhf.UnifiedDiff("same\ntext", "same\ntext"))
+		assert.Empty(t, rlhf.UnifiedDiff("", ""))
+	})
+
+	t.Run("empty original is all additions", func(t *testing.T) {
+		diff := rlhf.UnifiedDiff("", "new\ncontent")
+		assert.Contains(t, diff, "+new\n")
+		assert.Contains(t, diff, "+content\n")
+		assert.NotContains(t, diff, "\n-")
+	})
+
+	t.Run("empty corrected is all removals", func(t *testing.T) {
+		diff := rlhf.UnifiedDiff("old\ncontent", "")
+		assert.Contains(t, diff, "-old\n")
+		assert.Contains(t, diff, "-content\n")
+	})
+}
+
+func TestRenderDiff(t *testing.T) {
+	rendered := rlhf.RenderDiff("old line", "new line")
+	// Stripped of color codes the rendering matches the unified diff
+	assert.Contains(t, rendered, "old line")
+	assert.Contains(t, rendered, "new line")
+	assert.Equal(t, "", rlhf.RenderDiff("same", "same"))
+}
+
+func TestSubmitCorrectionDiff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/corrections/diff", r.URL.Path)
+
+		var req rlhf.CorrectionDiffRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "use jwt-go", req.Original)
+		assert.Equal(t, "use golang-jwt", req.Corrected)
+		assert.True(t, strings.Contains(req.Diff, "-use jwt-go"))
+		assert.Equal(t, "chat", req.Metadata["source"])
+
+		result := rlhf.CorrectionResult{
+			CorrectionID: "correction-123",
+			Status:       "success",
+			Diff:         req.Diff,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	apiClient := client.New(client.WithBaseURL(server.URL))
+	rlhfClient := rlhf.New(rlhf.WithAPIClient(apiClient))
+
+	result, err := rlhfClient.SubmitCorrectionDiff(context.Background(),
+		"use jwt-go", "use golang-jwt",
+		map[string]interface{}{"source": "chat"})
+	require.NoError(t, err)
+	assert.Equal(t, "correction-123", result.CorrectionID)
+	assert.Equal(t, "success", result.Status)
+}
+
+func TestSubmitCorrectionDiffValidation(t *testing.T) {
+	rlhfClient := rlhf.New(rlhf.WithAPIClient(client.New()))
+
+	_, err := rlhfClient.SubmitCorrectionDiff(context.Background(), "original", "", nil)
+	assert.ErrorContains(t, err, "corrected response is required")
+
+	_, err = rlhfClient.SubmitCorrectionDiff(context.Background(), "same", "same", nil)
+	assert.ErrorContains(t, err, "identical")
+}
@@ -103,6 +103,40 @@ type CorrectionResponse struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// CorrectionDiffRequest represents a correction submitted with its
+// precomputed unified diff.
+type CorrectionDiffRequest struct {
+	// Original is the assistant's original output
+	Original string `json:"original"`
+
+	// Corrected is the user's corrected version
+	Corrected string `json:"corrected"`
+
+	// Diff is the unified diff between the two
+	Diff string `json:"diff"`
+
+	// Metadata contains additional context
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// CorrectionResult represents the API response for a diff correction.
+type CorrectionResult struct {
+	// CorrectionID is the unique identifier
+	CorrectionID string `json:"correction_id"`
+
+	// Status indicates success/failure
+	Status string `json:"status"`
+
+	// Diff echoes the submitted unified diff
+	Diff string `json:"diff,omitempty"`
+
+	// SimilarityScore (0.0 to 1.0)
+	SimilarityScore float64 `json:"similarity_score,omitempty"`
+
+	// CreatedAt is when the correction was recorded
+	CreatedAt time.Time `json:"created_at"`
+}
+
 // DiffResult represents the diff between original and corrected responses.
 type DiffResult struct {
 	// Original response
@@ -168,6 +202,18 @@ type TrendPoint struct {
 	Count int       `json:"count"`
 }
 
+// FeedbackStats represents summary statistics over collected feedback.
+type FeedbackStats struct {
+	// TotalEntries is the number of feedback entries recorded
+	TotalEntries int `json:"total_entries"`
+
+	// AverageScore across all entries (0.0 to 1.0)
+	AverageScore float64 `json:"average_score"`
+
+	// ScoreDistribution counts entries per bucket: "0.0-0.2", "0.2-0.4", etc.
+	ScoreDistribution map[string]int `json:"score_distribution"`
+}
+
 // AnalyticsRequest represents a request for analytics data.
 type AnalyticsRequest struct {
 	// ModelID to filter by (optional)
@@ -356,15 +356,17 @@ func TestUpdateBlogPost(t *testing.T) {
 // TestPublishBlogPost tests publishing a blog post.
 func TestPublishBlogPost(t *testing.T) {
 	tests := []struct {
-		name           string
-		postID         int
-		mockResponse   *UpdateBlogPostResponse
-		mockStatusCode int
-		wantErr        bool
+		name          string
+		postID        int
+		currentStatus string
+		mockResponse  *UpdateBlogPostResponse
+		wantErr       bool
+		errContains   string
 	}{
 		{
-			name:   "successful publish",
-			postID: 1,
+			name:          "successful publish",
+			postID:        1,
+			currentStatus: "draft",
 			mockResponse: &UpdateBlogPostResponse{
 				Data: &BlogPost{
 					ID: 1,
@@ -378,8 +380,14 @@ func TestPublishBlogPost(t *testing.T) {
 					},
 				},
 			},
-			mockStatusCode: http.StatusOK,
-			wantErr:        false,
+			wantErr: false,
+		},
+		{
+			name:          "already published",
+			postID:        2,
+			currentStatus: "published",
+			wantErr:       true,
+			errContains:   "already published",
 		},
 	}
 
@@ -387,13 +395,26 @@ func TestPublishBlogPost(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Create mock server
 			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				if r.Method != http.MethodPut {
-					t.Errorf("unexpected method: %s", r.Method)
-				}
-
-				w.WriteHeader(tt.mockStatusCode)
-				if tt.mockResponse != nil {
+				switch r.Method {
+				case http.MethodGet:
+					// Publish fetches the current post to validate the transition
+					json.NewEncoder(w).Encode(&GetBlogPostResponse{
+						Data: &BlogPost{
+							ID: tt.postID,
+							Attributes: &BlogPostAttributes{
+								Title:   "Test Post",
+								Content: "Test Content",
+								Status:  tt.currentStatus,
+							},
+						},
+					})
+				case http.MethodPut:
+					if tt.wantErr {
+						t.Error("no update should be sent for an invalid transition")
+					}
 					json.NewEncoder(w).Encode(tt.mockResponse)
+				default:
+					t.Errorf("unexpected method: %s", r.Method)
 				}
 			}))
 			defer server.Close()
@@ -415,6 +436,8 @@ func TestPublishBlogPost(t *testing.T) {
 			if tt.wantErr {
 				if err == nil {
 					t.Errorf("expected error, got nil")
+				} else if tt.errContains != "" && !contains(err.Error(), tt.errContains) {
+					t.Errorf("expected error to contain %q, got %q", tt.errContains, err.Error())
 				}
 				return
 			}
@@ -428,6 +451,122 @@ func TestPublishBlogPost(t *testing.T) {
 			if post.Attributes.Status != "published" {
 				t.Errorf("expected status %q, got %q", "published", post.Attributes.Status)
 			}
+			if post.Attributes.PublishedAt == nil {
+				t.Error("expected publishedAt to be set")
+			}
+		})
+	}
+}
+
+// TestUnpublishBlogPost tests reverting a published post to draft.
+func TestUnpublishBlogPost(t *testing.T) {
+	tests := []struct {
+		name          string
+		postID        int
+		currentStatus string
+		wantErr       bool
+		errContains   string
+	}{
+		{
+			name:          "successful unpublish",
+			postID:        1,
+			currentStatus: "published",
+			wantErr:       false,
+		},
+		{
+			name:          "not published",
+			postID:        2,
+			currentStatus: "draft",
+			wantErr:       true,
+			errContains:   "not published",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create mock server
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					json.NewEncoder(w).Encode(&GetBlogPostResponse{
+						Data: &BlogPost{
+							ID: tt.postID,
+							Attributes: &BlogPostAttributes{
+								Title:       "Test Post",
+								Content:     "Test Content",
+								Status:      tt.currentStatus,
+								PublishedAt: timePtr(time.Now()),
+							},
+						},
+					})
+				case http.MethodPut:
+					if tt.wantErr {
+						t.Error("no update should be sent for an invalid transition")
+					}
+
+					// Strapi expects an explicit null publishedAt to unpublish
+					var body map[string]map[string]interface{}
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Errorf("failed to decode request: %v", err)
+					}
+					if published, ok := body["data"]["publishedAt"]; !ok || published != nil {
+						t.Errorf("expected explicit null publishedAt, got %v", published)
+					}
+					if body["data"]["status"] != "draft" {
+						t.Errorf("expected status draft, got %v", body["data"]["status"])
+					}
+
+					json.NewEncoder(w).Encode(&UpdateBlogPostResponse{
+						Data: &BlogPost{
+							ID: tt.postID,
+							Attributes: &BlogPostAttributes{
+								Title:   "Test Post",
+								Content: "Test Content",
+								Status:  "draft",
+							},
+						},
+					})
+				default:
+					t.Errorf("unexpected method: %s", r.Method)
+				}
+			}))
+			defer server.Close()
+
+			// Create client
+			apiClient := client.New(
+				client.WithBaseURL(server.URL),
+			)
+			strapiClient := New(
+				WithAPIClient(apiClient),
+				WithBaseURL(server.URL),
+			)
+
+			// Execute test
+			ctx := context.Background()
+			post, err := strapiClient.UnpublishBlogPost(ctx, tt.postID)
+
+			// Check error
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got nil")
+				} else if tt.errContains != "" && !contains(err.Error(), tt.errContains) {
+					t.Errorf("expected error to contain %q, got %q", tt.errContains, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			// Validate response
+			if post.Attributes.Status != "draft" {
+				t.Errorf("expected status %q, got %q", "draft", post.Attributes.Status)
+			}
+			if post.Attributes.PublishedAt != nil {
+				t.Error("expected publishedAt to be cleared")
+			}
 		})
 	}
 }
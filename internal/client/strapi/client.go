@@ -148,7 +148,9 @@ func (c *Client) GetBlogPost(ctx context.Context, id int) (*BlogPost, error) {
 	return resp.Data, nil
 }
 
-// UpdateBlogPost updates an existing blog post.
+// UpdateBlogPost updates an existing blog post. Updates are partial:
+// only fields set on the request data are sent, other fields keep their
+// current values.
 func (c *Client) UpdateBlogPost(ctx context.Context, id int, req *UpdateBlogPostRequest) (*BlogPost, error) {
 	logger.InfoEvent().
 		Int("id", id).
@@ -174,16 +176,26 @@ func (c *Client) UpdateBlogPost(ctx context.Context, id int, req *UpdateBlogPost
 	return resp.Data, nil
 }
 
-// PublishBlogPost publishes a blog post by updating its status and publishedAt timestamp.
+// PublishBlogPost publishes a blog post by updating its status and
+// publishedAt timestamp. Publishing an already-published post is an error.
 func (c *Client) PublishBlogPost(ctx context.Context, id int) (*BlogPost, error) {
 	logger.InfoEvent().
 		Int("id", id).
 		Msg("Publishing blog post in Strapi")
 
+	current, err := c.GetBlogPost(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch blog post for publishing: %w", err)
+	}
+	if current.Attributes.Status == "published" {
+		return nil, fmt.Errorf("blog post %d is already published", id)
+	}
+
 	now := time.Now()
 	req := &UpdateBlogPostRequest{
 		Data: &BlogPostData{
-			Status: "published",
+			Status:      "published",
+			PublishedAt: &now,
 		},
 	}
 
@@ -211,6 +223,49 @@ func (c *Client) PublishBlogPost(ctx context.Context, id int) (*BlogPost, error)
 	return resp.Data, nil
 }
 
+// UnpublishBlogPost reverts a published blog post back to draft, clearing
+// its publishedAt timestamp. Unpublishing a draft is an error.
+func (c *Client) UnpublishBlogPost(ctx context.Context, id int) (*BlogPost, error) {
+	logger.InfoEvent().
+		Int("id", id).
+		Msg("Unpublishing blog post in Strapi")
+
+	current, err := c.GetBlogPost(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch blog post for unpublishing: %w", err)
+	}
+	if current.Attributes.Status != "published" {
+		return nil, fmt.Errorf("blog post %d is not published", id)
+	}
+
+	req := &UnpublishBlogPostRequest{
+		Data: &UnpublishBlogPostData{
+			Status:      "draft",
+			PublishedAt: nil,
+		},
+	}
+
+	path := fmt.Sprintf("/api/blog-posts/%d", id)
+	respData, err := c.apiClient.Put(ctx, path, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpublish blog post: %w", err)
+	}
+
+	var resp UpdateBlogPostResponse
+	if err := json.Unmarshal(respData, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	resp.Data.Attributes.PublishedAt = nil
+
+	logger.InfoEvent().
+		Int("id", id).
+		Str("title", resp.Data.Attributes.Title).
+		Msg("Blog post unpublished successfully")
+
+	return resp.Data, nil
+}
+
 // DeleteBlogPost deletes a blog post by ID.
 func (c *Client) DeleteBlogPost(ctx context.Context, id int) error {
 	logger.InfoEvent().
@@ -250,6 +305,31 @@ func (c *Client) ListContentTypes(ctx context.Context) ([]*ContentType, error) {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
+	// Strapi v4 nests display name, kind, and attributes under a schema
+	// object; lift them onto the content type so callers see one shape.
+	for _, ct := range resp.Data {
+		if ct.Schema == nil {
+			continue
+		}
+		if ct.DisplayName == "" {
+			ct.DisplayName = ct.Schema.DisplayName
+		}
+		if ct.Kind == "" {
+			ct.Kind = ct.Schema.Kind
+		}
+		if ct.Attributes == nil {
+			ct.Attributes = ct.Schema.Attributes
+		}
+		if ct.Info == nil {
+			ct.Info = &ContentTypeInfo{
+				DisplayName: ct.Schema.DisplayName,
+				Description: ct.Schema.Description,
+				Singular:    ct.Schema.Singular,
+				Plural:      ct.Schema.Plural,
+			}
+		}
+	}
+
 	logger.DebugEvent().
 		Int("count", len(resp.Data)).
 		Msg("Content types listed successfully")
@@ -257,6 +337,109 @@ func (c *Client) ListContentTypes(ctx context.Context) ([]*ContentType, error) {
 	return resp.Data, nil
 }
 
+// ListOption is a functional option for configuring ListEntries requests.
+type ListOption func(*listOptions)
+
+// listOptions contains pagination and ordering settings for ListEntries.
+type listOptions struct {
+	page      int
+	pageSize  int
+	start     int
+	limit     int
+	offsetSet bool
+	sort      []string
+}
+
+// WithPage selects a page when using page-based pagination.
+func WithPage(page int) ListOption {
+	return func(o *listOptions) {
+		o.page = page
+	}
+}
+
+// WithPageSize sets the page size when using page-based pagination.
+func WithPageSize(pageSize int) ListOption {
+	return func(o *listOptions) {
+		o.pageSize = pageSize
+	}
+}
+
+// WithStartLimit switches to offset-based pagination, skipping start
+// entries and returning at most limit.
+func WithStartLimit(start, limit int) ListOption {
+	return func(o *listOptions) {
+		o.start = start
+		o.limit = limit
+		o.offsetSet = true
+	}
+}
+
+// WithSort sets the sort order, e.g. "createdAt:desc".
+func WithSort(fields ...string) ListOption {
+	return func(o *listOptions) {
+		o.sort = fields
+	}
+}
+
+// ListEntries lists entries of the given content type with pagination.
+// Page-based pagination is the default; WithStartLimit switches to the
+// offset-based style. Both of Strapi v4's pagination metadata shapes are
+// reflected in the returned ListMeta.
+func (c *Client) ListEntries(ctx context.Context, contentType string, opts ...ListOption) ([]*ContentEntry, *ListMeta, error) {
+	if contentType == "" {
+		return nil, nil, fmt.Errorf("content type is required")
+	}
+
+	options := &listOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	logger.DebugEvent().
+		Str("content_type", contentType).
+		Msg("Listing entries from Strapi")
+
+	params := url.Values{}
+	if options.offsetSet {
+		params.Set("pagination[start]", strconv.Itoa(options.start))
+		if options.limit > 0 {
+			params.Set("pagination[limit]", strconv.Itoa(options.limit))
+		}
+	} else {
+		page := options.page
+		if page == 0 {
+			page = 1
+		}
+		pageSize := options.pageSize
+		if pageSize == 0 {
+			pageSize = 25
+		}
+		params.Set("pagination[page]", strconv.Itoa(page))
+		params.Set("pagination[pageSize]", strconv.Itoa(pageSize))
+	}
+	for i, sortField := range options.sort {
+		params.Set(fmt.Sprintf("sort[%d]", i), sortField)
+	}
+
+	path := fmt.Sprintf("/api/%s?%s", contentType, params.Encode())
+	respData, err := c.apiClient.Get(ctx, path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list entries: %w", err)
+	}
+
+	var resp ListContentResponse
+	if err := json.Unmarshal(respData, &resp); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	logger.DebugEvent().
+		Str("content_type", contentType).
+		Int("count", len(resp.Data)).
+		Msg("Entries listed successfully")
+
+	return resp.Data, resp.Meta, nil
+}
+
 // CreateContent creates a new entry in a specified content type.
 func (c *Client) CreateContent(ctx context.Context, contentType string, data map[string]interface{}) (*ContentEntry, error) {
 	logger.InfoEvent().
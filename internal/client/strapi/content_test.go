@@ -0,0 +1,229 @@
+package strapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AINative-studio/ainative-code/internal/client"
+)
+
+// TestListContentTypes tests listing content types with the v4 schema envelope.
+func TestListContentTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/content-type-builder/content-types" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		// Strapi v4 nests the interesting fields under "schema"
+		w.Write([]byte(`{
+			"data": [
+				{
+					"uid": "api::article.article",
+					"apiID": "article",
+					"schema": {
+						"displayName": "Article",
+						"description": "Blog articles",
+						"kind": "collectionType",
+						"singularName": "article",
+						"pluralName": "articles",
+						"attributes": {
+							"title": {"type": "string", "required": true},
+							"body": {"type": "richtext"}
+						}
+					}
+				},
+				{
+					"uid": "api::homepage.homepage",
+					"displayName": "Homepage",
+					"kind": "singleType",
+					"attributes": {
+						"hero": {"type": "component"}
+					}
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.New(
+		client.WithBaseURL(server.URL),
+	)
+	strapiClient := New(
+		WithAPIClient(apiClient),
+		WithBaseURL(server.URL),
+	)
+
+	ctx := context.Background()
+	contentTypes, err := strapiClient.ListContentTypes(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(contentTypes) != 2 {
+		t.Fatalf("expected 2 content types, got %d", len(contentTypes))
+	}
+
+	article := contentTypes[0]
+	if article.UID != "api::article.article" {
+		t.Errorf("unexpected UID: %s", article.UID)
+	}
+	if article.DisplayName != "Article" {
+		t.Errorf("expected display name lifted from schema, got %q", article.DisplayName)
+	}
+	if article.Kind != "collectionType" {
+		t.Errorf("expected kind lifted from schema, got %q", article.Kind)
+	}
+	if _, ok := article.Attributes["title"]; !ok {
+		t.Error("expected title attribute lifted from schema")
+	}
+	if article.Info == nil || article.Info.Plural != "articles" {
+		t.Errorf("expected info populated from schema, got %+v", article.Info)
+	}
+
+	// Flat shape passes through unchanged
+	homepage := contentTypes[1]
+	if homepage.DisplayName != "Homepage" {
+		t.Errorf("unexpected display name: %s", homepage.DisplayName)
+	}
+	if homepage.Kind != "singleType" {
+		t.Errorf("unexpected kind: %s", homepage.Kind)
+	}
+}
+
+// TestListEntries tests listing entries with page-based pagination.
+func TestListEntries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/articles" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("pagination[page]") != "2" {
+			t.Errorf("unexpected page: %s", query.Get("pagination[page]"))
+		}
+		if query.Get("pagination[pageSize]") != "10" {
+			t.Errorf("unexpected page size: %s", query.Get("pagination[pageSize]"))
+		}
+		if query.Get("sort[0]") != "createdAt:desc" {
+			t.Errorf("unexpected sort: %s", query.Get("sort[0]"))
+		}
+
+		json.NewEncoder(w).Encode(&ListContentResponse{
+			Data: []*ContentEntry{
+				{ID: 11, Attributes: map[string]interface{}{"title": "Eleventh"}},
+				{ID: 12, Attributes: map[string]interface{}{"title": "Twelfth"}},
+			},
+			Meta: &ListMeta{
+				Pagination: &Pagination{
+					Page:      2,
+					PageSize:  10,
+					PageCount: 3,
+					Total:     22,
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	apiClient := client.New(
+		client.WithBaseURL(server.URL),
+	)
+	strapiClient := New(
+		WithAPIClient(apiClient),
+		WithBaseURL(server.URL),
+	)
+
+	ctx := context.Background()
+	entries, meta, err := strapiClient.ListEntries(ctx, "articles",
+		WithPage(2),
+		WithPageSize(10),
+		WithSort("createdAt:desc"),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].ID != 11 {
+		t.Errorf("unexpected entry ID: %d", entries[0].ID)
+	}
+	if entries[0].Attributes["title"] != "Eleventh" {
+		t.Errorf("unexpected title: %v", entries[0].Attributes["title"])
+	}
+	if meta == nil || meta.Pagination == nil {
+		t.Fatal("expected pagination metadata")
+	}
+	if meta.Pagination.PageCount != 3 || meta.Pagination.Total != 22 {
+		t.Errorf("unexpected pagination: %+v", meta.Pagination)
+	}
+}
+
+// TestListEntriesOffsetPagination tests the offset-based pagination style.
+func TestListEntriesOffsetPagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("pagination[start]") != "20" {
+			t.Errorf("unexpected start: %s", query.Get("pagination[start]"))
+		}
+		if query.Get("pagination[limit]") != "5" {
+			t.Errorf("unexpected limit: %s", query.Get("pagination[limit]"))
+		}
+		if query.Get("pagination[page]") != "" {
+			t.Error("page parameter should not be sent in offset mode")
+		}
+
+		json.NewEncoder(w).Encode(&ListContentResponse{
+			Data: []*ContentEntry{
+				{ID: 21, Attributes: map[string]interface{}{"title": "Twenty-first"}},
+			},
+			Meta: &ListMeta{
+				Pagination: &Pagination{
+					Start: 20,
+					Limit: 5,
+					Total: 21,
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	apiClient := client.New(
+		client.WithBaseURL(server.URL),
+	)
+	strapiClient := New(
+		WithAPIClient(apiClient),
+		WithBaseURL(server.URL),
+	)
+
+	ctx := context.Background()
+	entries, meta, err := strapiClient.ListEntries(ctx, "articles", WithStartLimit(20, 5))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(entries))
+	}
+	if meta.Pagination.Start != 20 || meta.Pagination.Limit != 5 {
+		t.Errorf("unexpected pagination: %+v", meta.Pagination)
+	}
+}
+
+// TestListEntriesMissingContentType tests that an empty content type is rejected.
+func TestListEntriesMissingContentType(t *testing.T) {
+	strapiClient := New(
+		WithAPIClient(client.New()),
+	)
+
+	_, _, err := strapiClient.ListEntries(context.Background(), "")
+	if err == nil || !contains(err.Error(), "content type is required") {
+		t.Errorf("expected content type error, got %v", err)
+	}
+}
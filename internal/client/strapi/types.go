@@ -29,14 +29,17 @@ type CreateBlogPostRequest struct {
 }
 
 // BlogPostData contains the data for creating or updating a blog post.
+// All fields are optional on update so partial updates only send the
+// fields that changed.
 type BlogPostData struct {
-	Title    string                 `json:"title"`
-	Content  string                 `json:"content"`
-	Slug     string                 `json:"slug,omitempty"`
-	Author   string                 `json:"author,omitempty"`
-	Status   string                 `json:"status,omitempty"` // draft (default), published
-	Tags     []string               `json:"tags,omitempty"`
-	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	Title       string                 `json:"title,omitempty"`
+	Content     string                 `json:"content,omitempty"`
+	Slug        string                 `json:"slug,omitempty"`
+	Author      string                 `json:"author,omitempty"`
+	Status      string                 `json:"status,omitempty"` // draft (default), published
+	PublishedAt *time.Time             `json:"publishedAt,omitempty"`
+	Tags        []string               `json:"tags,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
 }
 
 // CreateBlogPostResponse represents the response from creating a blog post.
@@ -67,11 +70,15 @@ type ListMeta struct {
 	Pagination *Pagination `json:"pagination"`
 }
 
-// Pagination contains pagination information.
+// Pagination contains pagination information. Strapi v4 returns either
+// page-based fields (page, pageSize, pageCount) or offset-based fields
+// (start, limit) depending on which style the request used.
 type Pagination struct {
-	Page      int `json:"page"`
-	PageSize  int `json:"pageSize"`
-	PageCount int `json:"pageCount"`
+	Page      int `json:"page,omitempty"`
+	PageSize  int `json:"pageSize,omitempty"`
+	PageCount int `json:"pageCount,omitempty"`
+	Start     int `json:"start,omitempty"`
+	Limit     int `json:"limit,omitempty"`
 	Total     int `json:"total"`
 }
 
@@ -103,13 +110,38 @@ type PublishBlogPostResponse struct {
 	Meta map[string]interface{} `json:"meta,omitempty"`
 }
 
+// UnpublishBlogPostRequest represents a request to unpublish a blog post.
+type UnpublishBlogPostRequest struct {
+	Data *UnpublishBlogPostData `json:"data"`
+}
+
+// UnpublishBlogPostData contains the data for unpublishing a blog post.
+// PublishedAt is always serialized so Strapi receives an explicit null.
+type UnpublishBlogPostData struct {
+	Status      string      `json:"status"`
+	PublishedAt interface{} `json:"publishedAt"`
+}
+
 // ContentType represents a Strapi content type.
 type ContentType struct {
 	UID         string                 `json:"uid"`
+	APIID       string                 `json:"apiID,omitempty"`
 	DisplayName string                 `json:"displayName"`
 	Kind        string                 `json:"kind"` // singleType, collectionType
 	Info        *ContentTypeInfo       `json:"info,omitempty"`
 	Attributes  map[string]interface{} `json:"attributes,omitempty"`
+	Schema      *ContentTypeSchema     `json:"schema,omitempty"`
+}
+
+// ContentTypeSchema contains the nested schema returned by the Strapi v4
+// content-type-builder endpoint.
+type ContentTypeSchema struct {
+	DisplayName string                 `json:"displayName"`
+	Description string                 `json:"description,omitempty"`
+	Kind        string                 `json:"kind,omitempty"`
+	Singular    string                 `json:"singularName,omitempty"`
+	Plural      string                 `json:"pluralName,omitempty"`
+	Attributes  map[string]interface{} `json:"attributes,omitempty"`
 }
 
 // ContentTypeInfo contains information about a content type.
@@ -0,0 +1,167 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/AINative-studio/ainative-code/internal/logger"
+)
+
+// Stream performs an HTTP request against a Server-Sent Events endpoint and
+// yields the payload of each SSE "data:" line on the returned channel. The
+// channel is closed when the stream ends, the context is cancelled, or a read
+// error occurs. Authentication works the same way as for regular requests:
+// the bearer token is injected and a 401 triggers one token refresh before
+// the stream starts. Streams are not retried once open.
+func (c *Client) Stream(ctx context.Context, method, path string, body interface{}, opts ...RequestOption) (<-chan []byte, error) {
+	// Build request options
+	reqOpts := &requestOptions{}
+	for _, opt := range opts {
+		opt(reqOpts)
+	}
+
+	// Short-circuit while the breaker is open
+	br := c.breaker()
+	if br != nil && !br.allow() {
+		return nil, fmt.Errorf("%w: %s is cooling down after repeated failures", ErrCircuitOpen, c.baseURL)
+	}
+
+	// Pace the request through the shared token bucket
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.wait(ctx); err != nil {
+			return nil, fmt.Errorf("rate limit wait cancelled: %w", err)
+		}
+	}
+
+	var jsonData []byte
+	if body != nil {
+		var err error
+		jsonData, err = json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+	}
+
+	url := c.buildURL(path, reqOpts.queryParams)
+
+	// One extra attempt covers the 401-then-refresh case; streams are not
+	// otherwise retried
+	var resp *http.Response
+	for attempt := 0; attempt < 2; attempt++ {
+		var bodyReader io.Reader
+		if jsonData != nil {
+			bodyReader = bytes.NewReader(jsonData)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		if jsonData != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		req.Header.Set("Accept", "text/event-stream")
+
+		// Add custom headers
+		for key, value := range reqOpts.headers {
+			req.Header.Set(key, value)
+		}
+
+		// Inject JWT bearer token if auth client is configured and not skipped
+		if c.authClient != nil && !reqOpts.skipAuth {
+			if err := c.injectAuthToken(ctx, req); err != nil {
+				logger.WarnEvent().Err(err).Msg("Failed to inject auth token")
+				// Continue without token - API might be public
+			}
+		}
+
+		logger.DebugEvent().
+			Str("method", method).
+			Str("url", url).
+			Msg("Opening SSE stream")
+
+		resp, err = c.httpClient.Do(req)
+		if err != nil {
+			if br != nil {
+				br.recordFailure()
+			}
+			return nil, fmt.Errorf("HTTP request failed: %w", err)
+		}
+
+		// Handle 401 Unauthorized - token might be expired
+		if resp.StatusCode == http.StatusUnauthorized && c.authClient != nil && attempt == 0 {
+			respBody, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			logger.InfoEvent().Msg("Received 401, attempting token refresh")
+			tokens, err := c.authClient.GetStoredTokens(ctx)
+			if err == nil && tokens.RefreshToken != nil {
+				if _, err := c.authClient.RefreshToken(ctx, tokens.RefreshToken); err == nil {
+					logger.InfoEvent().Msg("Token refreshed successfully, reopening stream")
+					continue
+				}
+			}
+
+			// The service responded, so this is not a breaker failure
+			if br != nil {
+				br.recordSuccess()
+			}
+			return nil, fmt.Errorf("authentication failed: %w", newAPIError(resp, respBody))
+		}
+
+		break
+	}
+
+	if resp.StatusCode >= 400 {
+		respBody, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if br != nil {
+			if c.shouldRetry(resp.StatusCode) {
+				br.recordFailure()
+			} else {
+				br.recordSuccess()
+			}
+		}
+		return nil, newAPIError(resp, respBody)
+	}
+
+	if br != nil {
+		br.recordSuccess()
+	}
+
+	events := make(chan []byte)
+	go func() {
+		defer close(events)
+		defer resp.Body.Close()
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if !strings.HasPrefix(line, "data:") {
+				// Comments, event names, ids and blank separators are not payload
+				continue
+			}
+			payload := strings.TrimPrefix(line, "data:")
+			payload = strings.TrimPrefix(payload, " ")
+
+			select {
+			case events <- []byte(payload):
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			logger.WarnEvent().Err(err).Msg("SSE stream ended with error")
+		}
+	}()
+
+	return events, nil
+}
@@ -0,0 +1,174 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AINative-studio/ainative-code/internal/client"
+)
+
+// TestClientStreamDataLines tests that SSE data lines are delivered in order
+func TestClientStreamDataLines(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		flusher := w.(http.Flusher)
+		for i := 0; i < 3; i++ {
+			fmt.Fprintf(w, "data: chunk-%d\n\n", i)
+			flusher.Flush()
+		}
+	}))
+	defer server.Close()
+
+	c := client.New(client.WithBaseURL(server.URL))
+
+	events, err := c.Stream(context.Background(), http.MethodGet, "/api/stream", nil)
+	require.NoError(t, err)
+
+	var received []string
+	for event := range events {
+		received = append(received, string(event))
+	}
+	assert.Equal(t, []string{"chunk-0", "chunk-1", "chunk-2"}, received)
+}
+
+// TestClientStreamIgnoresNonDataLines tests that comments and event metadata are filtered
+func TestClientStreamIgnoresNonDataLines(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, ": keepalive comment\n")
+		fmt.Fprint(w, "event: update\n")
+		fmt.Fprint(w, "id: 42\n")
+		fmt.Fprint(w, "data: payload\n\n")
+	}))
+	defer server.Close()
+
+	c := client.New(client.WithBaseURL(server.URL))
+
+	events, err := c.Stream(context.Background(), http.MethodGet, "/api/stream", nil)
+	require.NoError(t, err)
+
+	var received []string
+	for event := range events {
+		received = append(received, string(event))
+	}
+	assert.Equal(t, []string{"payload"}, received)
+}
+
+// TestClientStreamPostBody tests that a JSON body is sent when opening the stream
+func TestClientStreamPostBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "data: ok\n\n")
+	}))
+	defer server.Close()
+
+	c := client.New(client.WithBaseURL(server.URL))
+
+	events, err := c.Stream(context.Background(), http.MethodPost, "/api/stream",
+		map[string]string{"query": "analytics"})
+	require.NoError(t, err)
+
+	var received []string
+	for event := range events {
+		received = append(received, string(event))
+	}
+	assert.Equal(t, []string{"ok"}, received)
+}
+
+// TestClientStreamTokenRefreshOn401 tests token refresh before the stream starts
+func TestClientStreamTokenRefreshOn401(t *testing.T) {
+	mockAuth := newMockAuthClient("expired-token", "valid-refresh-token")
+	requestCount := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		if requestCount == 1 {
+			assert.Equal(t, "Bearer expired-token", r.Header.Get("Authorization"))
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":"token expired"}`))
+			return
+		}
+
+		assert.Equal(t, "Bearer new-access-token", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "data: refreshed\n\n")
+	}))
+	defer server.Close()
+
+	c := client.New(
+		client.WithBaseURL(server.URL),
+		client.WithAuthClient(mockAuth),
+	)
+
+	events, err := c.Stream(context.Background(), http.MethodGet, "/api/stream", nil)
+	require.NoError(t, err)
+
+	var received []string
+	for event := range events {
+		received = append(received, string(event))
+	}
+
+	assert.True(t, mockAuth.refreshCalled, "Token refresh should have been called")
+	assert.Equal(t, []string{"refreshed"}, received)
+	assert.Equal(t, 2, requestCount, "Should have reopened the stream after refresh")
+}
+
+// TestClientStreamErrorStatus tests that an error response fails the call up front
+func TestClientStreamErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"no such stream"}`))
+	}))
+	defer server.Close()
+
+	c := client.New(client.WithBaseURL(server.URL))
+
+	events, err := c.Stream(context.Background(), http.MethodGet, "/api/missing", nil)
+	require.Error(t, err)
+	assert.Nil(t, events)
+
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
+
+// TestClientStreamContextCancellation tests that cancelling the context closes the channel
+func TestClientStreamContextCancellation(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		flusher := w.(http.Flusher)
+		fmt.Fprint(w, "data: first\n\n")
+		flusher.Flush()
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := client.New(client.WithBaseURL(server.URL))
+
+	events, err := c.Stream(ctx, http.MethodGet, "/api/stream", nil)
+	require.NoError(t, err)
+
+	first, ok := <-events
+	require.True(t, ok)
+	assert.Equal(t, "first", string(first))
+
+	cancel()
+	for range events {
+		// Drain until the reader goroutine notices the cancellation
+	}
+}
@@ -11,10 +11,15 @@ import (
 	"github.com/google/uuid"
 )
 
+// DefaultBatchSize is the number of documents sent per request by InsertMany
+// when no batch size is configured.
+const DefaultBatchSize = 100
+
 // Client represents a client for ZeroDB NoSQL operations.
 type Client struct {
 	apiClient *client.Client
 	projectID string
+	batchSize int
 }
 
 // Option is a functional option for configuring the Client.
@@ -34,9 +39,20 @@ func WithProjectID(projectID string) Option {
 	}
 }
 
+// WithBatchSize sets the number of documents InsertMany sends per request.
+func WithBatchSize(size int) Option {
+	return func(c *Client) {
+		if size > 0 {
+			c.batchSize = size
+		}
+	}
+}
+
 // New creates a new ZeroDB client with the specified options.
 func New(opts ...Option) *Client {
-	c := &Client{}
+	c := &Client{
+		batchSize: DefaultBatchSize,
+	}
 	for _, opt := range opts {
 		opt(c)
 	}
@@ -103,8 +119,102 @@ func (c *Client) Insert(ctx context.Context, tableName string, data map[string]i
 	return resp.ID, resp.Document, nil
 }
 
+// BatchInsertError reports the documents a batch insert could not store.
+// Indices refer to the docs slice passed to InsertMany.
+type BatchInsertError struct {
+	Failed []InsertManyFailure
+}
+
+// Error implements the error interface.
+func (e *BatchInsertError) Error() string {
+	if len(e.Failed) == 1 {
+		return fmt.Sprintf("batch insert failed for document %d: %s", e.Failed[0].Index, e.Failed[0].Error)
+	}
+	return fmt.Sprintf("batch insert failed for %d documents (first: index %d: %s)",
+		len(e.Failed), e.Failed[0].Index, e.Failed[0].Error)
+}
+
+// InsertMany inserts documents into the specified table in batches of the
+// configured size (see WithBatchSize), returning the inserted IDs in the
+// same order as docs. When the server rejects individual documents the
+// remaining ones are still inserted and the rejects are reported via a
+// *BatchInsertError alongside the IDs that did succeed.
+func (c *Client) InsertMany(ctx context.Context, tableName string, docs []map[string]interface{}) ([]string, error) {
+	logger.DebugEvent().
+		Str("table", tableName).
+		Int("count", len(docs)).
+		Int("batch_size", c.batchSize).
+		Msg("Inserting documents in batches")
+
+	if len(docs) == 0 {
+		return nil, nil
+	}
+
+	path := fmt.Sprintf("/api/v1/projects/%s/nosql/documents/batch", c.projectID)
+
+	ids := make([]string, 0, len(docs))
+	var failed []InsertManyFailure
+	for offset := 0; offset < len(docs); offset += c.batchSize {
+		end := offset + c.batchSize
+		if end > len(docs) {
+			end = len(docs)
+		}
+
+		req := &InsertManyRequest{
+			TableName: tableName,
+			Documents: docs[offset:end],
+		}
+
+		respData, err := c.apiClient.Post(ctx, path, req)
+		if err != nil {
+			return ids, fmt.Errorf("failed to insert batch starting at index %d: %w", offset, err)
+		}
+
+		var resp InsertManyResponse
+		if err := json.Unmarshal(respData, &resp); err != nil {
+			return ids, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		ids = append(ids, resp.IDs...)
+
+		// Re-base per-batch indices onto the full docs slice
+		for _, f := range resp.Failed {
+			failed = append(failed, InsertManyFailure{
+				Index: offset + f.Index,
+				Error: f.Error,
+			})
+		}
+	}
+
+	if len(failed) > 0 {
+		logger.WarnEvent().
+			Str("table", tableName).
+			Int("failed", len(failed)).
+			Msg("Batch insert completed with failures")
+		return ids, &BatchInsertError{Failed: failed}
+	}
+
+	logger.DebugEvent().
+		Str("table", tableName).
+		Int("inserted", len(ids)).
+		Msg("Documents inserted successfully")
+
+	return ids, nil
+}
+
 // Query queries documents from the specified table with optional filter.
 func (c *Client) Query(ctx context.Context, tableName string, filter QueryFilter, options QueryOptions) ([]*Document, error) {
+	resp, err := c.QueryPage(ctx, tableName, filter, options)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Documents, nil
+}
+
+// QueryPage queries a single page of documents and returns the full
+// response, including NextCursor for fetching the following page. Pass the
+// cursor back via QueryOptions.Cursor to continue where the page ended.
+func (c *Client) QueryPage(ctx context.Context, tableName string, filter QueryFilter, options QueryOptions) (*QueryResponse, error) {
 	logger.DebugEvent().
 		Str("table", tableName).
 		Msg("Querying documents")
@@ -132,7 +242,86 @@ func (c *Client) Query(ctx context.Context, tableName string, filter QueryFilter
 		Int("total", resp.Total).
 		Msg("Query completed")
 
-	return resp.Documents, nil
+	return &resp, nil
+}
+
+// QueryAll iterates every document matching filter, fetching pages of
+// DefaultBatchSize via the cursor and invoking fn for each document. Pages
+// are requested with a stable _id sort so cursors neither skip nor repeat
+// documents. Iteration stops early when fn returns an error, which is
+// returned to the caller.
+func (c *Client) QueryAll(ctx context.Context, tableName string, filter QueryFilter, fn func(*Document) error) error {
+	options := QueryOptions{
+		Limit: c.batchSize,
+		Sort:  map[string]int{"_id": 1},
+	}
+
+	for {
+		resp, err := c.QueryPage(ctx, tableName, filter, options)
+		if err != nil {
+			return err
+		}
+
+		for _, doc := range resp.Documents {
+			if err := fn(doc); err != nil {
+				return err
+			}
+		}
+
+		if resp.NextCursor == "" {
+			return nil
+		}
+		options.Cursor = resp.NextCursor
+	}
+}
+
+// Count returns the number of documents in the table matching filter. It
+// uses the server-side count endpoint when the deployment provides one;
+// older deployments answer 404, in which case it falls back to a limit-1
+// query and reads the total from the query response so no documents are
+// transferred either way.
+func (c *Client) Count(ctx context.Context, tableName string, filter QueryFilter) (int64, error) {
+	logger.DebugEvent().
+		Str("table", tableName).
+		Msg("Counting documents")
+
+	req := &CountRequest{
+		TableName: tableName,
+		Filter:    filter,
+	}
+
+	path := fmt.Sprintf("/api/v1/projects/%s/nosql/count", c.projectID)
+	respData, err := c.apiClient.Post(ctx, path, req)
+	if err != nil {
+		if client.StatusCode(err) != 404 {
+			return 0, fmt.Errorf("failed to count documents: %w", err)
+		}
+
+		// Count endpoint not available; a limit-1 query still reports
+		// the total match count
+		resp, err := c.QueryPage(ctx, tableName, filter, QueryOptions{Limit: 1})
+		if err != nil {
+			return 0, fmt.Errorf("failed to count documents: %w", err)
+		}
+		return int64(resp.Total), nil
+	}
+
+	var resp CountResponse
+	if err := json.Unmarshal(respData, &resp); err != nil {
+		return 0, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return resp.Count, nil
+}
+
+// Exists reports whether any document in the table matches filter. It
+// short-circuits with a limit-1 query rather than counting everything.
+func (c *Client) Exists(ctx context.Context, tableName string, filter QueryFilter) (bool, error) {
+	resp, err := c.QueryPage(ctx, tableName, filter, QueryOptions{Limit: 1})
+	if err != nil {
+		return false, fmt.Errorf("failed to check existence: %w", err)
+	}
+	return len(resp.Documents) > 0, nil
 }
 
 // Update updates a document in the specified table.
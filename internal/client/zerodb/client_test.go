@@ -3,6 +3,7 @@ package zerodb_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -392,3 +393,299 @@ func TestMongoDBStyleFilters(t *testing.T) {
 		})
 	}
 }
+
+// TestInsertMany tests batch document insertion with chunking.
+func TestInsertMany(t *testing.T) {
+	var batchSizes []int
+	nextID := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v1/projects/test-project/nosql/documents/batch", r.URL.Path)
+
+		var req zerodb.InsertManyRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+
+		assert.Equal(t, "users", req.TableName)
+		batchSizes = append(batchSizes, len(req.Documents))
+
+		resp := zerodb.InsertManyResponse{}
+		for range req.Documents {
+			resp.IDs = append(resp.IDs, fmt.Sprintf("doc-%d", nextID))
+			nextID++
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	httpClient := client.New(
+		client.WithBaseURL(server.URL),
+	)
+
+	zdbClient := zerodb.New(
+		zerodb.WithAPIClient(httpClient),
+		zerodb.WithProjectID("test-project"),
+		zerodb.WithBatchSize(2),
+	)
+
+	docs := make([]map[string]interface{}, 5)
+	for i := range docs {
+		docs[i] = map[string]interface{}{"n": i}
+	}
+
+	ids, err := zdbClient.InsertMany(context.Background(), "users", docs)
+	require.NoError(t, err)
+
+	// Five documents with batch size 2 split across the chunk boundary: 2+2+1
+	assert.Equal(t, []int{2, 2, 1}, batchSizes)
+	assert.Equal(t, []string{"doc-0", "doc-1", "doc-2", "doc-3", "doc-4"}, ids)
+}
+
+// TestInsertManyPartialFailure tests that rejected documents are reported
+// with indices into the original slice.
+func TestInsertManyPartialFailure(t *testing.T) {
+	batch := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req zerodb.InsertManyRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+
+		resp := zerodb.InsertManyResponse{}
+		for i := range req.Documents {
+			// Reject the second document of the second batch (global index 3)
+			if batch == 1 && i == 1 {
+				resp.Failed = append(resp.Failed, zerodb.InsertManyFailure{
+					Index: i,
+					Error: "schema validation failed",
+				})
+				continue
+			}
+			resp.IDs = append(resp.IDs, fmt.Sprintf("doc-%d-%d", batch, i))
+		}
+		batch++
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	httpClient := client.New(
+		client.WithBaseURL(server.URL),
+	)
+
+	zdbClient := zerodb.New(
+		zerodb.WithAPIClient(httpClient),
+		zerodb.WithProjectID("test-project"),
+		zerodb.WithBatchSize(2),
+	)
+
+	docs := make([]map[string]interface{}, 4)
+	for i := range docs {
+		docs[i] = map[string]interface{}{"n": i}
+	}
+
+	ids, err := zdbClient.InsertMany(context.Background(), "users", docs)
+	require.Error(t, err)
+
+	var batchErr *zerodb.BatchInsertError
+	require.ErrorAs(t, err, &batchErr)
+	require.Len(t, batchErr.Failed, 1)
+	assert.Equal(t, 3, batchErr.Failed[0].Index)
+	assert.Equal(t, "schema validation failed", batchErr.Failed[0].Error)
+	assert.Contains(t, err.Error(), "document 3")
+
+	// Successfully inserted IDs are still returned
+	assert.Equal(t, []string{"doc-0-0", "doc-0-1", "doc-1-0"}, ids)
+}
+
+// TestQueryAll tests cursor-based iteration over multiple pages.
+func TestQueryAll(t *testing.T) {
+	pages := map[string][]string{
+		"":        {"doc-0", "doc-1"},
+		"cursor1": {"doc-2", "doc-3"},
+		"cursor2": {"doc-4"},
+	}
+	next := map[string]string{
+		"":        "cursor1",
+		"cursor1": "cursor2",
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req zerodb.QueryRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+
+		// A stable sort must be requested so pages stay consistent
+		assert.Equal(t, map[string]int{"_id": 1}, req.Options.Sort)
+
+		ids, ok := pages[req.Options.Cursor]
+		require.True(t, ok, "unexpected cursor %q", req.Options.Cursor)
+
+		resp := zerodb.QueryResponse{NextCursor: next[req.Options.Cursor]}
+		for _, id := range ids {
+			resp.Documents = append(resp.Documents, &zerodb.Document{ID: id})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	httpClient := client.New(
+		client.WithBaseURL(server.URL),
+	)
+
+	zdbClient := zerodb.New(
+		zerodb.WithAPIClient(httpClient),
+		zerodb.WithProjectID("test-project"),
+		zerodb.WithBatchSize(2),
+	)
+
+	var seen []string
+	err := zdbClient.QueryAll(context.Background(), "users", nil, func(doc *zerodb.Document) error {
+		seen = append(seen, doc.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"doc-0", "doc-1", "doc-2", "doc-3", "doc-4"}, seen)
+}
+
+// TestQueryAllCallbackError tests that a callback error stops iteration.
+func TestQueryAllCallbackError(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		resp := zerodb.QueryResponse{
+			Documents:  []*zerodb.Document{{ID: "doc-0"}, {ID: "doc-1"}},
+			NextCursor: "more",
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	httpClient := client.New(
+		client.WithBaseURL(server.URL),
+	)
+
+	zdbClient := zerodb.New(
+		zerodb.WithAPIClient(httpClient),
+		zerodb.WithProjectID("test-project"),
+	)
+
+	sentinel := fmt.Errorf("stop here")
+	err := zdbClient.QueryAll(context.Background(), "users", nil, func(doc *zerodb.Document) error {
+		return sentinel
+	})
+	require.ErrorIs(t, err, sentinel)
+	assert.Equal(t, 1, requests)
+}
+
+// TestCount tests counting via the server-side count endpoint.
+func TestCount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v1/projects/test-project/nosql/count", r.URL.Path)
+
+		var req zerodb.CountRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.Equal(t, "users", req.TableName)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(zerodb.CountResponse{Count: 42})
+	}))
+	defer server.Close()
+
+	httpClient := client.New(
+		client.WithBaseURL(server.URL),
+	)
+
+	zdbClient := zerodb.New(
+		zerodb.WithAPIClient(httpClient),
+		zerodb.WithProjectID("test-project"),
+	)
+
+	count, err := zdbClient.Count(context.Background(), "users", nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), count)
+}
+
+// TestCountFallsBackToQuery tests the limit-1 query fallback when the
+// count endpoint is missing.
+func TestCountFallsBackToQuery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/projects/test-project/nosql/count" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail":"not found"}`))
+			return
+		}
+
+		assert.Equal(t, "/api/v1/projects/test-project/nosql/query", r.URL.Path)
+		var req zerodb.QueryRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.Equal(t, 1, req.Options.Limit)
+
+		resp := zerodb.QueryResponse{
+			Documents: []*zerodb.Document{{ID: "doc-0"}},
+			Total:     17,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	httpClient := client.New(
+		client.WithBaseURL(server.URL),
+	)
+
+	zdbClient := zerodb.New(
+		zerodb.WithAPIClient(httpClient),
+		zerodb.WithProjectID("test-project"),
+	)
+
+	count, err := zdbClient.Count(context.Background(), "users", nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(17), count)
+}
+
+// TestExists tests existence checks with a limit-1 query.
+func TestExists(t *testing.T) {
+	empty := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req zerodb.QueryRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.Equal(t, 1, req.Options.Limit)
+
+		resp := zerodb.QueryResponse{}
+		if !empty {
+			resp.Documents = []*zerodb.Document{{ID: "doc-0"}}
+			resp.Total = 9
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	httpClient := client.New(
+		client.WithBaseURL(server.URL),
+	)
+
+	zdbClient := zerodb.New(
+		zerodb.WithAPIClient(httpClient),
+		zerodb.WithProjectID("test-project"),
+	)
+
+	exists, err := zdbClient.Exists(context.Background(), "users", zerodb.Where("status").Eq("active").Build())
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	empty = true
+	exists, err = zdbClient.Exists(context.Background(), "users", nil)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,150 @@
+package zerodb
+
+// FilterBuilder builds MongoDB-style QueryFilter maps through a fluent
+// chain, keeping call sites readable and operator names out of string
+// literals:
+//
+//	filter := zerodb.Where("age").Gt(18).And("status").Eq("active").Build()
+//	// {"$and": [{"age": {"$gt": 18}}, {"status": "active"}]}
+//
+// Clauses added with And are combined under $and; calling Or switches the
+// whole chain to $or. For nesting the two, combine built filters with the
+// package-level And and Or helpers.
+type FilterBuilder struct {
+	clauses  []QueryFilter
+	operator string // "$and" or "$or"
+	field    string
+	eq       interface{}
+	eqSet    bool
+	conds    map[string]interface{}
+}
+
+// Where starts a filter chain for the given field.
+func Where(field string) *FilterBuilder {
+	return &FilterBuilder{
+		operator: "$and",
+		field:    field,
+	}
+}
+
+// And closes the current field's conditions and starts a new clause that
+// must also match.
+func (b *FilterBuilder) And(field string) *FilterBuilder {
+	b.closeClause()
+	b.field = field
+	return b
+}
+
+// Or closes the current field's conditions, starts a new clause, and
+// combines all clauses in the chain with $or.
+func (b *FilterBuilder) Or(field string) *FilterBuilder {
+	b.closeClause()
+	b.operator = "$or"
+	b.field = field
+	return b
+}
+
+// Eq matches documents where the field equals value.
+func (b *FilterBuilder) Eq(value interface{}) *FilterBuilder {
+	b.eq = value
+	b.eqSet = true
+	return b
+}
+
+// Gt matches documents where the field is greater than value.
+func (b *FilterBuilder) Gt(value interface{}) *FilterBuilder {
+	return b.cond("$gt", value)
+}
+
+// Gte matches documents where the field is greater than or equal to value.
+func (b *FilterBuilder) Gte(value interface{}) *FilterBuilder {
+	return b.cond("$gte", value)
+}
+
+// Lt matches documents where the field is less than value.
+func (b *FilterBuilder) Lt(value interface{}) *FilterBuilder {
+	return b.cond("$lt", value)
+}
+
+// Lte matches documents where the field is less than or equal to value.
+func (b *FilterBuilder) Lte(value interface{}) *FilterBuilder {
+	return b.cond("$lte", value)
+}
+
+// In matches documents where the field equals any of the given values.
+func (b *FilterBuilder) In(values ...interface{}) *FilterBuilder {
+	return b.cond("$in", values)
+}
+
+// Regex matches documents where the field matches the given pattern.
+func (b *FilterBuilder) Regex(pattern string) *FilterBuilder {
+	return b.cond("$regex", pattern)
+}
+
+// Exists matches documents where the field's presence equals exists.
+func (b *FilterBuilder) Exists(exists bool) *FilterBuilder {
+	return b.cond("$exists", exists)
+}
+
+// Build produces the QueryFilter the chain describes. A single clause is
+// returned directly; multiple clauses are wrapped in $and or $or.
+func (b *FilterBuilder) Build() QueryFilter {
+	b.closeClause()
+
+	switch len(b.clauses) {
+	case 0:
+		return QueryFilter{}
+	case 1:
+		return b.clauses[0]
+	default:
+		clauses := make([]interface{}, len(b.clauses))
+		for i, c := range b.clauses {
+			clauses[i] = map[string]interface{}(c)
+		}
+		return QueryFilter{b.operator: clauses}
+	}
+}
+
+// cond records an operator condition for the current field.
+func (b *FilterBuilder) cond(op string, value interface{}) *FilterBuilder {
+	if b.conds == nil {
+		b.conds = make(map[string]interface{})
+	}
+	b.conds[op] = value
+	return b
+}
+
+// closeClause converts the current field's conditions into a clause.
+func (b *FilterBuilder) closeClause() {
+	if b.field == "" {
+		return
+	}
+	switch {
+	case len(b.conds) > 0:
+		b.clauses = append(b.clauses, QueryFilter{b.field: b.conds})
+	case b.eqSet:
+		b.clauses = append(b.clauses, QueryFilter{b.field: b.eq})
+	}
+	b.field = ""
+	b.eq = nil
+	b.eqSet = false
+	b.conds = nil
+}
+
+// And combines already-built filters so that all must match.
+func And(filters ...QueryFilter) QueryFilter {
+	return combine("$and", filters)
+}
+
+// Or combines already-built filters so that any may match.
+func Or(filters ...QueryFilter) QueryFilter {
+	return combine("$or", filters)
+}
+
+func combine(op string, filters []QueryFilter) QueryFilter {
+	clauses := make([]interface{}, len(filters))
+	for i, f := range filters {
+		clauses[i] = map[string]interface{}(f)
+	}
+	return QueryFilter{op: clauses}
+}
@@ -0,0 +1,115 @@
+package zerodb_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AINative-studio/ainative-code/internal/client/zerodb"
+)
+
+// assertFilterJSON compares a built filter against a hand-written filter
+// through their JSON encodings, which is what the API receives.
+func assertFilterJSON(t *testing.T, want, got zerodb.QueryFilter) {
+	t.Helper()
+	wantJSON, err := json.Marshal(want)
+	require.NoError(t, err)
+	gotJSON, err := json.Marshal(got)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(wantJSON), string(gotJSON))
+}
+
+// TestFilterBuilder tests that the builder produces the same filters as
+// hand-written maps.
+func TestFilterBuilder(t *testing.T) {
+	t.Run("simple equality", func(t *testing.T) {
+		want := zerodb.QueryFilter{"name": "John"}
+		got := zerodb.Where("name").Eq("John").Build()
+		assertFilterJSON(t, want, got)
+	})
+
+	t.Run("comparison operators on one field", func(t *testing.T) {
+		want := zerodb.QueryFilter{
+			"age": map[string]interface{}{"$gt": 18, "$lt": 65},
+		}
+		got := zerodb.Where("age").Gt(18).Lt(65).Build()
+		assertFilterJSON(t, want, got)
+	})
+
+	t.Run("and across fields", func(t *testing.T) {
+		want := zerodb.QueryFilter{
+			"$and": []interface{}{
+				map[string]interface{}{"age": map[string]interface{}{"$gte": 18}},
+				map[string]interface{}{"status": "active"},
+			},
+		}
+		got := zerodb.Where("age").Gte(18).And("status").Eq("active").Build()
+		assertFilterJSON(t, want, got)
+	})
+
+	t.Run("or across fields", func(t *testing.T) {
+		want := zerodb.QueryFilter{
+			"$or": []interface{}{
+				map[string]interface{}{"status": "active"},
+				map[string]interface{}{"status": "pending"},
+			},
+		}
+		got := zerodb.Where("status").Eq("active").Or("status").Eq("pending").Build()
+		assertFilterJSON(t, want, got)
+	})
+
+	t.Run("in operator", func(t *testing.T) {
+		want := zerodb.QueryFilter{
+			"tags": map[string]interface{}{"$in": []interface{}{"go", "rust"}},
+		}
+		got := zerodb.Where("tags").In("go", "rust").Build()
+		assertFilterJSON(t, want, got)
+	})
+
+	t.Run("regex operator", func(t *testing.T) {
+		want := zerodb.QueryFilter{
+			"email": map[string]interface{}{"$regex": ".*@example\\.com$"},
+		}
+		got := zerodb.Where("email").Regex(".*@example\\.com$").Build()
+		assertFilterJSON(t, want, got)
+	})
+
+	t.Run("exists operator", func(t *testing.T) {
+		want := zerodb.QueryFilter{
+			"email": map[string]interface{}{"$exists": true},
+		}
+		got := zerodb.Where("email").Exists(true).Build()
+		assertFilterJSON(t, want, got)
+	})
+
+	t.Run("empty chain", func(t *testing.T) {
+		assertFilterJSON(t, zerodb.QueryFilter{}, zerodb.Where("unused").Build())
+	})
+}
+
+// TestFilterCombinators tests nesting built filters with And/Or.
+func TestFilterCombinators(t *testing.T) {
+	want := zerodb.QueryFilter{
+		"$or": []interface{}{
+			map[string]interface{}{
+				"$and": []interface{}{
+					map[string]interface{}{"age": map[string]interface{}{"$gte": 18}},
+					map[string]interface{}{"status": "active"},
+				},
+			},
+			map[string]interface{}{"role": "admin"},
+		},
+	}
+
+	got := zerodb.Or(
+		zerodb.And(
+			zerodb.Where("age").Gte(18).Build(),
+			zerodb.Where("status").Eq("active").Build(),
+		),
+		zerodb.Where("role").Eq("admin").Build(),
+	)
+
+	assertFilterJSON(t, want, got)
+}
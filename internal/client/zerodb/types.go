@@ -46,6 +46,7 @@ type QueryOptions struct {
 	Offset int                    `json:"offset,omitempty"`
 	Sort   map[string]int         `json:"sort,omitempty"` // 1 for asc, -1 for desc
 	Fields map[string]interface{} `json:"fields,omitempty"`
+	Cursor string                 `json:"cursor,omitempty"` // opaque cursor from a previous page
 }
 
 // CreateTableRequest represents a request to create a new table.
@@ -71,6 +72,25 @@ type InsertResponse struct {
 	Document *Document `json:"document"`
 }
 
+// InsertManyRequest represents a request to insert a batch of documents.
+type InsertManyRequest struct {
+	TableName string                   `json:"table_name"`
+	Documents []map[string]interface{} `json:"documents"`
+}
+
+// InsertManyFailure describes a single document that the server rejected
+// during a batch insert. Index is relative to the submitted batch.
+type InsertManyFailure struct {
+	Index int    `json:"index"`
+	Error string `json:"error"`
+}
+
+// InsertManyResponse represents the response from a batch insert.
+type InsertManyResponse struct {
+	IDs    []string            `json:"ids"`
+	Failed []InsertManyFailure `json:"failed,omitempty"`
+}
+
 // QueryRequest represents a request to query documents.
 type QueryRequest struct {
 	TableName string       `json:"table_name"`
@@ -84,6 +104,20 @@ type QueryResponse struct {
 	Total     int         `json:"total"`
 	Offset    int         `json:"offset"`
 	Limit     int         `json:"limit"`
+	// NextCursor is the opaque cursor for the next page, empty on the
+	// last page.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// CountRequest represents a request to count documents.
+type CountRequest struct {
+	TableName string      `json:"table_name"`
+	Filter    QueryFilter `json:"filter,omitempty"`
+}
+
+// CountResponse represents the response from counting documents.
+type CountResponse struct {
+	Count int64 `json:"count"`
 }
 
 // UpdateRequest represents a request to update a document.
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/AINative-studio/ainative-code/internal/auth/jwt"
 	"github.com/AINative-studio/ainative-code/internal/auth/keychain"
 	"github.com/AINative-studio/ainative-code/internal/auth/oauth"
 	"github.com/AINative-studio/ainative-code/internal/backend"
@@ -19,6 +21,7 @@ var (
 	defaultOAuthConfig = oauth.Config{
 		AuthURL:     getAuthURL(),
 		TokenURL:    getTokenURL(),
+		RevokeURL:   getRevokeURL(),
 		ClientID:    "ainative-code-cli",
 		RedirectURL: "http://localhost:8080/callback",
 		Scopes:      []string{"read", "write", "offline_access"},
@@ -115,6 +118,16 @@ func getTokenURL() string {
 	return "https://api.ainative.studio/v1/auth/token"
 }
 
+func getRevokeURL() string {
+	// Check environment variable override first
+	if url := os.Getenv("AINATIVE_REVOKE_URL"); url != "" {
+		return url
+	}
+
+	// Production endpoint - api.ainative.studio (Issue #115)
+	return "https://api.ainative.studio/v1/auth/revoke"
+}
+
 func init() {
 	// Register auth command to root
 	rootCmd.AddCommand(authCmd)
@@ -198,13 +211,29 @@ func runLogin(cmd *cobra.Command, args []string) error {
 }
 
 func runLogout(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
 	kc := keychain.Get()
 
-	// Delete all credentials
+	// Revoke tokens server-side first so the refresh token cannot be
+	// replayed after logout (it stays valid until expiry otherwise)
+	var revokeErr error
+	if tokens, err := kc.GetTokenPair(); err == nil {
+		oauthClient := oauth.NewClient(defaultOAuthConfig)
+		revokeErr = oauthClient.RevokeTokens(ctx, tokens)
+	}
+
+	// Delete all credentials; local cleanup happens even when revocation
+	// failed so the machine is left without tokens either way
 	if err := kc.DeleteAll(); err != nil {
 		return fmt.Errorf("failed to clear credentials: %w", err)
 	}
 
+	if revokeErr != nil {
+		cmd.Println("⚠ Local credentials cleared, but server-side revocation failed")
+		cmd.Println("The refresh token may remain valid on the server until it expires")
+		return fmt.Errorf("token revocation failed: %w", revokeErr)
+	}
+
 	cmd.Println("✓ Successfully logged out")
 	cmd.Println("All credentials have been removed from OS keychain")
 
@@ -212,29 +241,67 @@ func runLogout(cmd *cobra.Command, args []string) error {
 }
 
 func runWhoami(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
 	kc := keychain.Get()
 
 	// Get tokens
 	tokens, err := kc.GetTokenPair()
 	if err != nil {
-		cmd.Println("Not authenticated")
+		cmd.Println("Not logged in")
 		cmd.Println("\nRun 'ainative-code login' to authenticate")
 		return nil
 	}
 
-	// Get user email if available
-	email, err := kc.GetUserEmail()
+	// Refresh first when the access token has already expired so the
+	// displayed identity reflects a usable session
+	if expired, err := jwt.IsTokenExpired(tokens.AccessToken); err == nil && expired {
+		oauthClient := oauth.NewClient(defaultOAuthConfig)
+		if newTokens, err := oauthClient.RefreshToken(ctx, tokens.RefreshToken); err == nil {
+			if err := kc.SetTokenPair(newTokens); err == nil {
+				tokens = newTokens
+			}
+		}
+	}
+
+	// Decode claims for display; signature verification is not needed to
+	// show the user who they are logged in as
+	claims, err := jwt.DecodeAccessTokenClaims(tokens.AccessToken)
 	if err != nil {
-		email = "Unknown"
+		return fmt.Errorf("stored token is corrupted: %w", err)
+	}
+
+	// Fall back to the keychain email when the claim is missing
+	email := claims.Email
+	if email == "" {
+		if stored, err := kc.GetUserEmail(); err == nil {
+			email = stored
+		} else {
+			email = "Unknown"
+		}
 	}
 
-	// Calculate expiration
+	userID := claims.UserID
+	if userID == "" {
+		userID = claims.Subject
+	}
+
+	// Calculate expiration from the token claim when present
 	expiresAt := time.Now().Add(time.Duration(tokens.ExpiresIn) * time.Second)
+	if claims.ExpiresAt != nil {
+		expiresAt = claims.ExpiresAt.Time
+	}
 	timeUntilExpiry := time.Until(expiresAt)
 
 	// Display user info
 	cmd.Println("Authenticated User:")
+	cmd.Printf("  User ID: %s\n", userID)
 	cmd.Printf("  Email: %s\n", email)
+	if len(claims.Roles) > 0 {
+		cmd.Printf("  Roles: %s\n", strings.Join(claims.Roles, ", "))
+	}
+	if claims.Issuer != "" {
+		cmd.Printf("  Issuer: %s\n", claims.Issuer)
+	}
 	cmd.Printf("  Token Type: %s\n", tokens.TokenType)
 	cmd.Printf("  Expires In: %s\n", formatDuration(timeUntilExpiry))
 
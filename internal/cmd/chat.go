@@ -10,6 +10,7 @@ import (
 	"github.com/AINative-studio/ainative-code/internal/backend"
 	"github.com/AINative-studio/ainative-code/internal/logger"
 	llmprovider "github.com/AINative-studio/ainative-code/internal/provider"
+	"github.com/AINative-studio/ainative-code/internal/session"
 	"github.com/AINative-studio/ainative-code/internal/tui"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -20,6 +21,7 @@ var (
 	chatSessionID string
 	chatSystemMsg string
 	chatStream    bool
+	chatResume    string
 )
 
 // chatCmd represents the chat command
@@ -39,8 +41,11 @@ Examples:
   # Send a single message
   ainative-code chat "Explain how to use goroutines"
 
-  # Continue a previous session
-  ainative-code chat --session-id abc123
+  # Continue a previous session with its full history
+  ainative-code chat --resume abc123
+
+  # Continue the most recently updated session
+  ainative-code chat --resume last
 
   # Use a specific model
   ainative-code chat --provider openai --model gpt-4`,
@@ -55,6 +60,7 @@ func init() {
 	chatCmd.Flags().StringVarP(&chatSessionID, "session-id", "s", "", "resume a previous chat session")
 	chatCmd.Flags().StringVar(&chatSystemMsg, "system", "", "custom system message")
 	chatCmd.Flags().BoolVar(&chatStream, "stream", true, "stream responses in real-time")
+	chatCmd.Flags().StringVar(&chatResume, "resume", "", "resume an existing session by ID, or 'last' for the most recent")
 }
 
 func runChat(cmd *cobra.Command, args []string) error {
@@ -101,30 +107,39 @@ func runChat(cmd *cobra.Command, args []string) error {
 	}
 	defer aiProvider.Close()
 
+	// Load prior conversation context when resuming a session
+	var resumed *chatSession
+	var history []llmprovider.Message
+	if chatResume != "" {
+		resumed, history, err = resumeChatSession(ctx, chatResume)
+		if err != nil {
+			return err
+		}
+		defer resumed.Close()
+	}
+
 	if len(args) > 0 {
 		// Single message mode
 		message := args[0]
-		return runSingleMessage(ctx, aiProvider, modelName, message)
+		return runSingleMessage(ctx, aiProvider, modelName, message, resumed, history)
 	}
 
 	// Interactive mode
-	return runInteractiveChat(ctx, aiProvider, modelName)
+	return runInteractiveChat(ctx, aiProvider, modelName, resumed, history)
 }
 
 // runSingleMessage processes a single message and prints the response
-func runSingleMessage(ctx context.Context, aiProvider llmprovider.Provider, modelName, message string) error {
+func runSingleMessage(ctx context.Context, aiProvider llmprovider.Provider, modelName, message string, resumed *chatSession, history []llmprovider.Message) error {
 	logger.InfoEvent().
 		Str("model", modelName).
 		Str("message", message).
 		Msg("Processing single message")
 
-	// Prepare messages
-	messages := []llmprovider.Message{
-		{
-			Role:    "user",
-			Content: message,
-		},
-	}
+	// Prepare messages, replaying prior session context first
+	messages := append(history, llmprovider.Message{
+		Role:    "user",
+		Content: message,
+	})
 
 	// Add system message if provided
 	var opts []llmprovider.ChatOption
@@ -134,9 +149,16 @@ func runSingleMessage(ctx context.Context, aiProvider llmprovider.Provider, mode
 		opts = append(opts, llmprovider.WithSystemPrompt(chatSystemMsg))
 	}
 
+	resumed.recordMessage(ctx, session.RoleUser, message, "")
+
 	// Check if streaming is enabled
 	if chatStream {
-		return streamSingleMessage(ctx, aiProvider, messages, opts)
+		content, err := streamSingleMessage(ctx, aiProvider, messages, opts)
+		if err != nil {
+			return err
+		}
+		resumed.recordMessage(ctx, session.RoleAssistant, content, modelName)
+		return nil
 	}
 
 	// Non-streaming response
@@ -148,6 +170,8 @@ func runSingleMessage(ctx context.Context, aiProvider llmprovider.Provider, mode
 	// Print response
 	fmt.Println(resp.Content)
 
+	resumed.recordMessage(ctx, session.RoleAssistant, resp.Content, modelName)
+
 	// Print usage stats if verbose
 	if GetVerbose() {
 		fmt.Fprintf(os.Stderr, "\n---\n")
@@ -161,8 +185,9 @@ func runSingleMessage(ctx context.Context, aiProvider llmprovider.Provider, mode
 	return nil
 }
 
-// streamSingleMessage streams a single message response
-func streamSingleMessage(ctx context.Context, aiProvider llmprovider.Provider, messages []llmprovider.Message, opts []llmprovider.ChatOption) error {
+// streamSingleMessage streams a single message response and returns the
+// accumulated content for persistence
+func streamSingleMessage(ctx context.Context, aiProvider llmprovider.Provider, messages []llmprovider.Message, opts []llmprovider.ChatOption) (string, error) {
 	// Convert ChatOptions to StreamOptions
 	streamOpts := make([]llmprovider.StreamOption, len(opts))
 	for i, opt := range opts {
@@ -171,28 +196,30 @@ func streamSingleMessage(ctx context.Context, aiProvider llmprovider.Provider, m
 
 	eventChan, err := aiProvider.Stream(ctx, messages, streamOpts...)
 	if err != nil {
-		return fmt.Errorf("failed to start stream: %w", err)
+		return "", fmt.Errorf("failed to start stream: %w", err)
 	}
 
 	// Process streaming events
+	var content strings.Builder
 	for event := range eventChan {
 		switch event.Type {
 		case llmprovider.EventTypeContentDelta:
 			// Print delta content without newline
 			fmt.Print(event.Content)
+			content.WriteString(event.Content)
 		case llmprovider.EventTypeError:
-			return fmt.Errorf("streaming error: %w", event.Error)
+			return content.String(), fmt.Errorf("streaming error: %w", event.Error)
 		case llmprovider.EventTypeContentEnd:
 			// Print final newline
 			fmt.Println()
 		}
 	}
 
-	return nil
+	return content.String(), nil
 }
 
 // runInteractiveChat starts an interactive chat session with TUI
-func runInteractiveChat(ctx context.Context, aiProvider llmprovider.Provider, modelName string) error {
+func runInteractiveChat(ctx context.Context, aiProvider llmprovider.Provider, modelName string, resumed *chatSession, history []llmprovider.Message) error {
 	logger.Info("Starting interactive chat mode with TUI")
 
 	// Initialize TUI model
@@ -203,6 +230,11 @@ func runInteractiveChat(ctx context.Context, aiProvider llmprovider.Provider, mo
 		model.AddMessage("system", chatSystemMsg)
 	}
 
+	// Replay resumed conversation history so prior context is visible
+	for _, msg := range history {
+		model.AddMessage(msg.Role, msg.Content)
+	}
+
 	// Create bubbletea program with alt screen
 	p := tea.NewProgram(
 		&interactiveChatModel{
@@ -210,8 +242,9 @@ func runInteractiveChat(ctx context.Context, aiProvider llmprovider.Provider, mo
 			provider:  aiProvider,
 			modelName: modelName,
 			ctx:       ctx,
-			messages:  []llmprovider.Message{},
+			messages:  history,
 			systemMsg: chatSystemMsg,
+			session:   resumed,
 		},
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(),
@@ -241,6 +274,7 @@ type interactiveChatModel struct {
 	ctx              context.Context
 	messages         []llmprovider.Message
 	systemMsg        string
+	session          *chatSession
 	err              error
 	waitingForAI     bool
 	lastUserInput    string
@@ -297,6 +331,7 @@ func (m *interactiveChatModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				Role:    "assistant",
 				Content: content,
 			})
+			m.session.recordMessage(m.ctx, session.RoleAssistant, content, m.modelName)
 		}
 
 		// Clear streaming content
@@ -348,6 +383,7 @@ func (m *interactiveChatModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			Role:    "user",
 			Content: userInput,
 		})
+		m.session.recordMessage(m.ctx, session.RoleUser, userInput, "")
 
 		// Start streaming AI response
 		m.waitingForAI = true
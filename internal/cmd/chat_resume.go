@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/AINative-studio/ainative-code/internal/database"
+	"github.com/AINative-studio/ainative-code/internal/logger"
+	llmprovider "github.com/AINative-studio/ainative-code/internal/provider"
+	"github.com/AINative-studio/ainative-code/internal/session"
+)
+
+// chatSession ties a chat run to a persisted session so new turns are
+// appended to the conversation history
+type chatSession struct {
+	db  *database.DB
+	mgr *session.SQLiteManager
+	id  string
+}
+
+// resumeChatSession resolves the --resume reference, loads the session's
+// prior messages, and returns them as provider conversation context.
+// The reference "last" picks the most recently updated session.
+func resumeChatSession(ctx context.Context, resumeRef string) (*chatSession, []llmprovider.Message, error) {
+	db, err := getDatabase()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	mgr := session.NewSQLiteManager(db)
+
+	sessionID := resumeRef
+	if resumeRef == "last" {
+		// Sessions are listed most recently updated first
+		sessions, err := mgr.ListSessions(ctx, session.WithLimit(1))
+		if err != nil {
+			db.Close()
+			return nil, nil, fmt.Errorf("failed to list sessions: %w", err)
+		}
+		if len(sessions) == 0 {
+			db.Close()
+			return nil, nil, fmt.Errorf("no sessions to resume; start a chat first")
+		}
+		sessionID = sessions[0].ID
+	} else {
+		if _, err := mgr.GetSession(ctx, sessionID); err != nil {
+			db.Close()
+			return nil, nil, fmt.Errorf("failed to resume session %s: %w", sessionID, err)
+		}
+	}
+
+	messages, err := mgr.GetMessages(ctx, sessionID)
+	if err != nil {
+		db.Close()
+		return nil, nil, fmt.Errorf("failed to load session messages: %w", err)
+	}
+
+	logger.InfoEvent().
+		Str("session_id", sessionID).
+		Int("messages", len(messages)).
+		Msg("Resuming chat session")
+
+	return &chatSession{db: db, mgr: mgr, id: sessionID}, historyToProviderMessages(messages), nil
+}
+
+// historyToProviderMessages converts persisted messages into provider
+// conversation context, skipping tool messages that cannot be replayed
+func historyToProviderMessages(messages []*session.Message) []llmprovider.Message {
+	history := make([]llmprovider.Message, 0, len(messages))
+	for _, msg := range messages {
+		if msg.Role == session.RoleTool {
+			continue
+		}
+		history = append(history, llmprovider.Message{
+			Role:    string(msg.Role),
+			Content: msg.Content,
+		})
+	}
+	return history
+}
+
+// recordMessage persists a new turn to the session and touches it so it
+// sorts as the most recently updated
+func (cs *chatSession) recordMessage(ctx context.Context, role session.MessageRole, content, model string) {
+	if cs == nil || content == "" {
+		return
+	}
+
+	message := &session.Message{
+		ID:        uuid.New().String(),
+		SessionID: cs.id,
+		Role:      role,
+		Content:   content,
+		Timestamp: time.Now(),
+	}
+	if model != "" {
+		message.Model = &model
+	}
+
+	if err := cs.mgr.AddMessage(ctx, message); err != nil {
+		logger.WarnEvent().
+			Err(err).
+			Str("session_id", cs.id).
+			Msg("Failed to persist chat message")
+		return
+	}
+
+	if err := cs.mgr.TouchSession(ctx, cs.id); err != nil {
+		logger.WarnEvent().
+			Err(err).
+			Str("session_id", cs.id).
+			Msg("Failed to touch session")
+	}
+}
+
+// Close releases the underlying database connection
+func (cs *chatSession) Close() {
+	if cs != nil && cs.db != nil {
+		cs.db.Close()
+	}
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AINative-studio/ainative-code/internal/session"
+)
+
+func TestHistoryToProviderMessages(t *testing.T) {
+	messages := []*session.Message{
+		{Role: session.RoleUser, Content: "hello"},
+		{Role: session.RoleAssistant, Content: "hi there"},
+		{Role: session.RoleTool, Content: `{"result": "ignored"}`},
+		{Role: session.RoleUser, Content: "continue"},
+	}
+
+	history := historyToProviderMessages(messages)
+
+	if len(history) != 3 {
+		t.Fatalf("expected 3 messages (tool skipped), got %d", len(history))
+	}
+
+	if history[0].Role != "user" || history[0].Content != "hello" {
+		t.Errorf("unexpected first message: %+v", history[0])
+	}
+	if history[1].Role != "assistant" || history[1].Content != "hi there" {
+		t.Errorf("unexpected second message: %+v", history[1])
+	}
+	if history[2].Content != "continue" {
+		t.Errorf("unexpected third message: %+v", history[2])
+	}
+}
+
+func TestHistoryToProviderMessages_Empty(t *testing.T) {
+	history := historyToProviderMessages(nil)
+	if len(history) != 0 {
+		t.Errorf("expected empty history, got %d messages", len(history))
+	}
+}
+
+func TestChatSessionRecordMessage_NilReceiver(t *testing.T) {
+	// Chat without --resume has no session; recording must be a no-op
+	var cs *chatSession
+	cs.recordMessage(context.Background(), session.RoleUser, "hello", "")
+	cs.Close()
+}
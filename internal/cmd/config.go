@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/AINative-studio/ainative-code/internal/config"
 	"github.com/AINative-studio/ainative-code/internal/logger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -64,18 +66,41 @@ Examples:
 var configSetCmd = &cobra.Command{
 	Use:   "set [key] [value]",
 	Short: "Set a configuration value",
-	Long:  `Set a configuration value and save it to the config file.`,
-	Args:  cobra.ExactArgs(2),
-	RunE:  runConfigSet,
+	Long: `Set a configuration value and save it to the config file.
+
+Keys use dotted paths into the configuration schema (e.g. llm.default_provider,
+llm.anthropic.max_tokens). Values are converted to the field's type - booleans,
+integers, numbers, durations (30s, 2m), and comma-separated lists - and the
+resulting configuration is re-validated before it is written back. Unknown keys
+and type mismatches are rejected with the list of valid keys.
+
+Examples:
+  # Change the default provider
+  ainative-code config set llm.default_provider anthropic
+
+  # Toggle provider fallback
+  ainative-code config set llm.fallback.enabled true
+
+  # Set a numeric field
+  ainative-code config set llm.anthropic.max_tokens 8192`,
+	Args: cobra.ExactArgs(2),
+	RunE: runConfigSet,
 }
 
 // configGetCmd represents the config get command
 var configGetCmd = &cobra.Command{
 	Use:   "get [key]",
 	Short: "Get a configuration value",
-	Long:  `Retrieve a specific configuration value.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runConfigGet,
+	Long: `Retrieve a specific configuration value by its dotted path.
+
+Examples:
+  # Read the default provider
+  ainative-code config get llm.default_provider
+
+  # Read a nested field
+  ainative-code config get llm.anthropic.model`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfigGet,
 }
 
 // configInitCmd represents the config init command
@@ -167,12 +192,34 @@ func runConfigSet(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid configuration key: %w", err)
 	}
 
+	// Keys in the configuration schema get typed handling; legacy flat keys
+	// are still accepted as plain strings for backward compatibility
+	var typedValue interface{} = value
+	if _, ok := config.KeyType(key); ok {
+		parsed, err := config.ParseValue(key, value)
+		if err != nil {
+			return fmt.Errorf("invalid configuration value: %w", err)
+		}
+		// Durations round-trip more readably as strings ("30s") in YAML
+		if _, isDuration := parsed.(time.Duration); !isDuration {
+			typedValue = parsed
+		}
+	} else if !isLegacyConfigKey(key) {
+		return unknownConfigKeyError(key)
+	}
+
 	// Validate the configuration value before setting
 	if err := validateConfigValue(key, value); err != nil {
 		return fmt.Errorf("invalid configuration value: %w", err)
 	}
 
-	viper.Set(key, value)
+	viper.Set(key, typedValue)
+
+	// Re-validate the mutated field against the full configuration before
+	// persisting anything
+	if err := revalidateConfigKey(key); err != nil {
+		return err
+	}
 
 	// Determine config file path
 	configFile := viper.ConfigFileUsed()
@@ -214,6 +261,11 @@ func runConfigGet(cmd *cobra.Command, args []string) error {
 
 	// If viper.Get returns nil, the key truly doesn't exist
 	if !viper.IsSet(key) && value == nil {
+		// Schema keys that simply have no value yet are not an error
+		if _, ok := config.KeyType(key); ok {
+			fmt.Printf("%s: (not set)\n", key)
+			return nil
+		}
 		return fmt.Errorf("configuration key '%s' not found", key)
 	}
 
@@ -272,6 +324,62 @@ func runConfigInit(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// legacyConfigKeys are flat keys from before the structured schema that
+// remain settable as plain strings for backward compatibility
+var legacyConfigKeys = map[string]bool{
+	"provider":          true,
+	"model":             true,
+	"verbose":           true,
+	"temperature":       true,
+	"max_tokens":        true,
+	"api_key":           true,
+	"endpoint":          true,
+	"base_url":          true,
+	"database.path":     true,
+	"session.auto_save": true,
+}
+
+// isLegacyConfigKey reports whether a key is a supported legacy flat key
+func isLegacyConfigKey(key string) bool {
+	return legacyConfigKeys[strings.ToLower(key)]
+}
+
+// unknownConfigKeyError builds an error for an unrecognized key that lists
+// the valid keys, narrowed to the key's section when possible
+func unknownConfigKeyError(key string) error {
+	section := strings.SplitN(key, ".", 2)[0]
+	if matches := config.KeysWithPrefix(section + "."); len(matches) > 0 {
+		return fmt.Errorf("unknown configuration key '%s'\n\nValid keys under '%s':\n  %s",
+			key, section, strings.Join(matches, "\n  "))
+	}
+	return fmt.Errorf("unknown configuration key '%s'\n\nValid keys:\n  %s",
+		key, strings.Join(config.ValidKeys(), "\n  "))
+}
+
+// revalidateConfigKey unmarshals the current settings and reports
+// validation errors that concern the mutated key, so one bad edit cannot
+// be written back to the config file
+func revalidateConfigKey(key string) error {
+	var cfg config.Config
+	if err := viper.Unmarshal(&cfg); err != nil {
+		return fmt.Errorf("failed to re-validate configuration: %w", err)
+	}
+
+	validator := config.NewValidator(&cfg)
+	_ = validator.Validate()
+
+	for _, issue := range validator.Issues() {
+		if issue.Severity != config.SeverityError {
+			continue
+		}
+		if strings.EqualFold(issue.Field, key) {
+			return fmt.Errorf("invalid configuration value: %s: %s", issue.Field, issue.Message)
+		}
+	}
+
+	return nil
+}
+
 // validateConfigKey validates a configuration key name
 func validateConfigKey(key string) error {
 	// Check for empty key
@@ -101,11 +101,11 @@ func TestConfigSubcommands(t *testing.T) {
 // TestRunConfigShow tests the config show command
 func TestRunConfigShow(t *testing.T) {
 	tests := []struct {
-		name         string
-		setupViper   func()
-		showSecrets  bool
-		wantErr      bool
-		checkOutput  func(t *testing.T, output string)
+		name        string
+		setupViper  func()
+		showSecrets bool
+		wantErr     bool
+		checkOutput func(t *testing.T, output string)
 	}{
 		{
 			name: "shows empty configuration",
@@ -261,9 +261,9 @@ func TestRunConfigSet(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name:    "sets custom key",
+			name:    "rejects unknown key",
 			args:    []string{"custom.key", "custom-value"},
-			wantErr: false,
+			wantErr: true,
 		},
 	}
 
@@ -389,28 +389,28 @@ func TestRunConfigGet(t *testing.T) {
 // TestRunConfigInit tests the config init command
 func TestRunConfigInit(t *testing.T) {
 	tests := []struct {
-		name        string
-		force       bool
+		name         string
+		force        bool
 		existingFile bool
-		wantErr     bool
+		wantErr      bool
 	}{
 		{
-			name:        "creates new config file",
-			force:       false,
+			name:         "creates new config file",
+			force:        false,
 			existingFile: false,
-			wantErr:     false,
+			wantErr:      false,
 		},
 		{
-			name:        "fails with existing file",
-			force:       false,
+			name:         "fails with existing file",
+			force:        false,
 			existingFile: true,
-			wantErr:     true,
+			wantErr:      true,
 		},
 		{
-			name:        "overwrites with force flag",
-			force:       true,
+			name:         "overwrites with force flag",
+			force:        true,
 			existingFile: true,
-			wantErr:     false,
+			wantErr:      false,
 		},
 	}
 
@@ -882,45 +882,52 @@ func TestValidateConfigKey(t *testing.T) {
 // TestRunConfigSetWithInvalidKeys tests config set with invalid keys
 func TestRunConfigSetWithInvalidKeys(t *testing.T) {
 	tests := []struct {
-		name       string
-		key        string
-		value      string
-		wantErr    bool
+		name        string
+		key         string
+		value       string
+		wantErr     bool
 		errContains string
 	}{
 		{
-			name:       "empty key rejected",
-			key:        "",
-			value:      "some-value",
-			wantErr:    true,
+			name:        "empty key rejected",
+			key:         "",
+			value:       "some-value",
+			wantErr:     true,
 			errContains: "key name cannot be empty",
 		},
 		{
-			name:       "whitespace key rejected",
-			key:        "   ",
-			value:      "some-value",
-			wantErr:    true,
+			name:        "whitespace key rejected",
+			key:         "   ",
+			value:       "some-value",
+			wantErr:     true,
 			errContains: "key name cannot be whitespace only",
 		},
 		{
-			name:       "key with spaces rejected",
-			key:        "api key",
-			value:      "some-value",
-			wantErr:    true,
+			name:        "key with spaces rejected",
+			key:         "api key",
+			value:       "some-value",
+			wantErr:     true,
 			errContains: "invalid character",
 		},
 		{
-			name:       "key with special chars rejected",
-			key:        "api@key",
-			value:      "some-value",
-			wantErr:    true,
+			name:        "key with special chars rejected",
+			key:         "api@key",
+			value:       "some-value",
+			wantErr:     true,
 			errContains: "invalid character",
 		},
 		{
-			name:       "valid key accepted",
-			key:        "valid_key",
-			value:      "some-value",
-			wantErr:    false,
+			name:        "unknown key rejected with valid key listing",
+			key:         "valid_key",
+			value:       "some-value",
+			wantErr:     true,
+			errContains: "unknown configuration key",
+		},
+		{
+			name:    "schema key accepted",
+			key:     "llm.default_provider",
+			value:   "anthropic",
+			wantErr: false,
 		},
 	}
 
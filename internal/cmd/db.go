@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/AINative-studio/ainative-code/internal/logger"
+)
+
+// dbCmd represents the local database maintenance command group
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Manage the local session database",
+	Long: `Manage the local SQLite database that stores session history.
+
+Examples:
+  # Compact the database and rebuild search indexes
+  ainative-code db maintenance
+
+  # Show database storage statistics
+  ainative-code db stats`,
+}
+
+// dbMaintenanceCmd compacts the database and rebuilds search indexes
+var dbMaintenanceCmd = &cobra.Command{
+	Use:   "maintenance",
+	Short: "Compact the database and rebuild search indexes",
+	Long: `Run maintenance on the local session database.
+
+This runs PRAGMA optimize, rebuilds the full-text search index, and
+compacts the database file with VACUUM. VACUUM needs exclusive access to
+the database, so close any running chat sessions first.
+
+Examples:
+  ainative-code db maintenance`,
+	Aliases: []string{"optimize", "vacuum"},
+	RunE:    runDBMaintenance,
+}
+
+// dbStatsCmd shows database storage statistics
+var dbStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show database storage statistics",
+	Long: `Display storage statistics for the local session database, including
+page counts, reclaimable free pages, and file size.
+
+Examples:
+  ainative-code db stats`,
+	RunE: runDBStats,
+}
+
+func init() {
+	rootCmd.AddCommand(dbCmd)
+	dbCmd.AddCommand(dbMaintenanceCmd)
+	dbCmd.AddCommand(dbStatsCmd)
+}
+
+func runDBMaintenance(cmd *cobra.Command, args []string) error {
+	db, err := getDatabase()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	before, err := db.StorageStats(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("failed to read database statistics: %w", err)
+	}
+
+	fmt.Println("Running database maintenance...")
+
+	if err := db.Optimize(cmd.Context()); err != nil {
+		logger.ErrorEvent().Err(err).Msg("Database maintenance failed")
+		return fmt.Errorf("maintenance failed: %w", err)
+	}
+
+	after, err := db.StorageStats(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("failed to read database statistics: %w", err)
+	}
+
+	logger.InfoEvent().
+		Int64("size_before", before.FileSize).
+		Int64("size_after", after.FileSize).
+		Msg("Database maintenance completed")
+
+	fmt.Printf("Maintenance complete. Database size: %s -> %s\n",
+		formatByteSize(before.FileSize), formatByteSize(after.FileSize))
+
+	return nil
+}
+
+func runDBStats(cmd *cobra.Command, args []string) error {
+	db, err := getDatabase()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	stats, err := db.StorageStats(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("failed to read database statistics: %w", err)
+	}
+
+	fmt.Printf("Database: %s\n", getDatabasePath())
+	fmt.Printf("  File size:  %s\n", formatByteSize(stats.FileSize))
+	fmt.Printf("  Page size:  %d bytes\n", stats.PageSize)
+	fmt.Printf("  Pages:      %d\n", stats.PageCount)
+	fmt.Printf("  Free pages: %d", stats.FreePages)
+	if stats.FreePages > 0 {
+		fmt.Printf(" (run 'ainative-code db maintenance' to reclaim %s)",
+			formatByteSize(stats.FreePages*stats.PageSize))
+	}
+	fmt.Println()
+
+	return nil
+}
+
+// formatByteSize renders a byte count in a human-readable unit
+func formatByteSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "KMGTPE"[exp])
+}
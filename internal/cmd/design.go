@@ -1,13 +1,17 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/AINative-studio/ainative-code/internal/client"
+	designclient "github.com/AINative-studio/ainative-code/internal/client/design"
 	"github.com/AINative-studio/ainative-code/internal/design"
 	"github.com/AINative-studio/ainative-code/internal/logger"
 	"gopkg.in/yaml.v3"
@@ -109,6 +113,13 @@ func init() {
 	designCmd.AddCommand(designSyncCmd)
 	designCmd.AddCommand(designValidateCmd)
 
+	// List flags
+	designListCmd.Flags().StringP("project", "p", "", "project ID (required)")
+	designListCmd.Flags().StringP("category", "c", "", "filter by category")
+	designListCmd.Flags().StringSliceP("type", "t", []string{}, "filter by token type")
+	designListCmd.Flags().IntP("limit", "n", 0, "limit number of tokens")
+	designListCmd.Flags().Int("offset", 0, "offset for pagination")
+
 	// Import flags
 	designImportCmd.Flags().StringP("file", "f", "", "input file path (required)")
 	designImportCmd.MarkFlagRequired("file")
@@ -127,54 +138,91 @@ func init() {
 }
 
 func runDesignList(cmd *cobra.Command, args []string) error {
-	logger.Debug("Listing design tokens")
+	ctx := context.Background()
+
+	project, _ := cmd.Flags().GetString("project")
+	category, _ := cmd.Flags().GetString("category")
+	types, _ := cmd.Flags().GetStringSlice("type")
+	limit, _ := cmd.Flags().GetInt("limit")
+	offset, _ := cmd.Flags().GetInt("offset")
+
+	logger.DebugEvent().
+		Str("project", project).
+		Str("category", category).
+		Strs("types", types).
+		Msg("Listing design tokens")
+
+	if project == "" {
+		return fmt.Errorf("project ID is required (use --project flag)")
+	}
+
+	apiClient := client.New()
+	designClient := designclient.New(
+		designclient.WithAPIClient(apiClient),
+		designclient.WithProjectID(project),
+	)
+
+	opts := []designclient.ListOption{designclient.WithSortByName()}
+	if category != "" {
+		opts = append(opts, designclient.WithCategory(category))
+	}
+	if len(types) > 0 {
+		opts = append(opts, designclient.WithTypes(types...))
+	}
+	if limit > 0 {
+		opts = append(opts, designclient.WithLimit(limit))
+	}
+	if offset > 0 {
+		opts = append(opts, designclient.WithOffset(offset))
+	}
+
+	tokens, err := designClient.ListTokens(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to list tokens: %w", err)
+	}
 
 	fmt.Println("Design Tokens")
 	fmt.Println("==============")
 	fmt.Println()
 
-	fmt.Println("This command requires design tokens database schema to be implemented.")
-	fmt.Println()
-	fmt.Println("Current Status:")
-	fmt.Println("  • Design token storage requires database schema (planned)")
-	fmt.Println("  • Design token import/export functionality is stubbed out")
-	fmt.Println("  • Strapi sync for design tokens is planned")
-	fmt.Println()
-	fmt.Println("Design tokens are typically managed in:")
-	fmt.Println("  1. Design files (Figma, Sketch, etc.)")
-	fmt.Println("  2. Strapi CMS for centralized management")
-	fmt.Println("  3. JSON/YAML files in your repository")
-	fmt.Println()
-	fmt.Println("Common design token categories:")
-	fmt.Println()
-	fmt.Println("  Colors:")
-	fmt.Println("    • colors.primary")
-	fmt.Println("    • colors.secondary")
-	fmt.Println("    • colors.accent")
-	fmt.Println("    • colors.text.primary")
-	fmt.Println("    • colors.text.secondary")
-	fmt.Println()
-	fmt.Println("  Typography:")
-	fmt.Println("    • typography.font.family")
-	fmt.Println("    • typography.font.size.base")
-	fmt.Println("    • typography.line.height")
-	fmt.Println("    • typography.font.weight.normal")
-	fmt.Println()
-	fmt.Println("  Spacing:")
-	fmt.Println("    • spacing.xs (4px)")
-	fmt.Println("    • spacing.sm (8px)")
-	fmt.Println("    • spacing.md (16px)")
-	fmt.Println("    • spacing.lg (24px)")
-	fmt.Println("    • spacing.xl (32px)")
-	fmt.Println()
-	fmt.Println("To use design tokens now:")
-	fmt.Println("  • Use 'design import --file tokens.json' to import from a file")
-	fmt.Println("  • Use 'design export --file tokens.json' to export to a file")
-	fmt.Println("  • Use 'strapi fetch design-tokens' to sync from Strapi CMS")
+	if len(tokens) == 0 {
+		fmt.Println("No design tokens found.")
+		return nil
+	}
+
+	displayTokensByCategory(tokens)
+	fmt.Printf("\n%d tokens\n", len(tokens))
 
 	return nil
 }
 
+// displayTokensByCategory renders tokens grouped under category headers.
+func displayTokensByCategory(tokens []*design.Token) {
+	byCategory := make(map[string][]*design.Token)
+	var categories []string
+	for _, token := range tokens {
+		category := token.Category
+		if category == "" {
+			category = "uncategorized"
+		}
+		if _, seen := byCategory[category]; !seen {
+			categories = append(categories, category)
+		}
+		byCategory[category] = append(byCategory[category], token)
+	}
+	sort.Strings(categories)
+
+	for _, category := range categories {
+		fmt.Printf("%s:\n", category)
+		fmt.Printf("  %-40s %-15s %s\n", "Name", "Type", "Value")
+		fmt.Printf("  %s\n", strings.Repeat("-", 75))
+		for _, token := range byCategory[category] {
+			fmt.Printf("  %-40s %-15s %s\n", token.Name, token.Type, token.Value)
+		}
+		fmt.Println()
+	}
+}
+
 func runDesignShow(cmd *cobra.Command, args []string) error {
 	tokenName := args[0]
 
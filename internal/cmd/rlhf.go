@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
@@ -116,6 +117,10 @@ func init() {
 	// List flags
 	rlhfListCmd.Flags().IntP("limit", "n", 20, "limit number of entries")
 	rlhfListCmd.Flags().String("filter", "", "filter by rating or tag")
+	rlhfListCmd.Flags().String("session", "", "filter by session ID")
+	rlhfListCmd.Flags().StringP("model", "m", "", "filter by model ID")
+	rlhfListCmd.Flags().String("from", "", "start date (YYYY-MM-DD)")
+	rlhfListCmd.Flags().String("to", "", "end date (YYYY-MM-DD)")
 
 	// Export flags
 	// Using -f/--file for consistency with other commands (standardized in issue #121)
@@ -196,35 +201,85 @@ func runRlhfSubmit(cmd *cobra.Command, args []string) error {
 }
 
 func runRlhfList(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
 	limit, _ := cmd.Flags().GetInt("limit")
-	filter, _ := cmd.Flags().GetString("filter")
+	session, _ := cmd.Flags().GetString("session")
+	model, _ := cmd.Flags().GetString("model")
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
 
 	logger.DebugEvent().
 		Int("limit", limit).
-		Str("filter", filter).
+		Str("session", session).
+		Str("model", model).
 		Msg("Listing RLHF feedback")
 
+	opts := []rlhf.ListOption{rlhf.WithLimit(limit)}
+	if session != "" {
+		opts = append(opts, rlhf.WithSessionID(session))
+	}
+	if model != "" {
+		opts = append(opts, rlhf.WithModelID(model))
+	}
+	if from != "" || to != "" {
+		startDate, endDate, err := parseDateRange(from, to)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, rlhf.WithDateRange(startDate, endDate))
+	}
+
+	rlhfClient, err := initRlhfClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize RLHF client: %w", err)
+	}
+
+	feedback, err := rlhfClient.ListFeedback(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to list feedback: %w", err)
+	}
+
 	fmt.Println("RLHF Feedback Entries")
 	fmt.Println("======================")
 	fmt.Println()
 
-	fmt.Println("This command requires RLHF database schema to be implemented.")
-	fmt.Println()
-	fmt.Println("Current Status:")
-	fmt.Println("  • RLHF interaction submission is implemented (use 'rlhf interaction')")
-	fmt.Println("  • RLHF correction submission is implemented (use 'rlhf correction')")
-	fmt.Println("  • RLHF analytics is implemented (use 'rlhf analytics')")
-	fmt.Println("  • Local feedback storage requires database schema (planned)")
-	fmt.Println()
-	fmt.Println("These commands currently interact with the AINative API.")
-	fmt.Println("To list feedback entries stored in the API, use:")
-	fmt.Println("  ainative-code rlhf analytics --start-date YYYY-MM-DD --end-date YYYY-MM-DD")
-	fmt.Println()
-	fmt.Println("For local feedback storage, this feature is planned for a future release.")
+	if len(feedback) == 0 {
+		fmt.Println("No feedback entries found.")
+		return nil
+	}
+
+	displayInteractionsTable(feedback)
+	fmt.Printf("\n%d entries\n", len(feedback))
 
 	return nil
 }
 
+// parseDateRange parses optional YYYY-MM-DD bounds, defaulting an empty
+// "to" bound to now.
+func parseDateRange(from, to string) (time.Time, time.Time, error) {
+	var startDate, endDate time.Time
+	var err error
+
+	if from != "" {
+		startDate, err = time.Parse("2006-01-02", from)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid from date format (use YYYY-MM-DD): %w", err)
+		}
+	}
+
+	if to != "" {
+		endDate, err = time.Parse("2006-01-02", to)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid to date format (use YYYY-MM-DD): %w", err)
+		}
+	} else {
+		endDate = time.Now()
+	}
+
+	return startDate, endDate, nil
+}
+
 func runRlhfExport(cmd *cobra.Command, args []string) error {
 	// Get file path from --file flag (preferred) or fall back to --output (deprecated)
 	file, _ := cmd.Flags().GetString("file")
@@ -293,44 +348,31 @@ func runRlhfExport(cmd *cobra.Command, args []string) error {
 }
 
 func runRlhfStats(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
 	logger.Debug("Generating RLHF statistics")
 
+	rlhfClient, err := initRlhfClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize RLHF client: %w", err)
+	}
+
+	stats, err := rlhfClient.GetStats(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get feedback stats: %w", err)
+	}
+
 	fmt.Println("RLHF Feedback Statistics")
 	fmt.Println("=========================")
 	fmt.Println()
-
-	fmt.Println("This command requires RLHF database schema to be implemented.")
-	fmt.Println()
-	fmt.Println("Current Status:")
-	fmt.Println("  • Local statistics require database schema (planned)")
-	fmt.Println("  • API-based analytics are available via 'rlhf analytics'")
+	fmt.Printf("  Total Entries: %d\n", stats.TotalEntries)
+	fmt.Printf("  Average Score: %.2f / 1.00\n", stats.AverageScore)
 	fmt.Println()
-	fmt.Println("To view detailed analytics from the AINative API:")
-	fmt.Println()
-	fmt.Println("  # View analytics for the last 7 days")
-	fmt.Println("  ainative-code rlhf analytics \\")
-	fmt.Println("    --start-date 2026-01-01 \\")
-	fmt.Println("    --end-date 2026-01-08")
-	fmt.Println()
-	fmt.Println("  # Filter by model")
-	fmt.Println("  ainative-code rlhf analytics \\")
-	fmt.Println("    --model claude-3-5-sonnet-20241022 \\")
-	fmt.Println("    --start-date 2026-01-01 \\")
-	fmt.Println("    --end-date 2026-01-08")
-	fmt.Println()
-	fmt.Println("  # Export to file")
-	fmt.Println("  ainative-code rlhf analytics \\")
-	fmt.Println("    --start-date 2026-01-01 \\")
-	fmt.Println("    --end-date 2026-01-08 \\")
-	fmt.Println("    --export analytics.json")
-	fmt.Println()
-	fmt.Println("The analytics command provides:")
-	fmt.Println("  • Average feedback scores")
-	fmt.Println("  • Total interactions and corrections")
-	fmt.Println("  • Correction rate")
-	fmt.Println("  • Score distribution")
-	fmt.Println("  • Top correction reasons")
-	fmt.Println("  • Trending data over time")
+
+	if len(stats.ScoreDistribution) > 0 {
+		fmt.Println("Score Distribution:")
+		displayScoreDistribution(stats.ScoreDistribution, stats.TotalEntries)
+	}
 
 	return nil
 }
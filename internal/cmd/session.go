@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -19,6 +20,8 @@ var (
 	sessionListAll   bool
 	sessionLimit     int
 	sessionListJSON  bool
+	showJSON         bool
+	showFull         bool
 	exportFormat     string
 	exportOutput     string
 	exportTemplate   string
@@ -94,9 +97,21 @@ Examples:
 
 // sessionShowCmd represents the session show command
 var sessionShowCmd = &cobra.Command{
-	Use:     "show [session-id]",
-	Short:   "Show session details",
-	Long:    `Display detailed information about a specific session including messages.`,
+	Use:   "show [session-id]",
+	Short: "Show session details",
+	Long: `Display detailed information about a specific session including messages.
+
+By default only the first and last few messages are shown as a preview.
+
+Examples:
+  # Show a session summary with a message preview
+  ainative-code session show abc123
+
+  # Show every message in the session
+  ainative-code session show abc123 --full
+
+  # Output session details as JSON
+  ainative-code session show abc123 --json`,
 	Aliases: []string{"view", "get"},
 	Args:    cobra.ExactArgs(1),
 	RunE:    runSessionShow,
@@ -229,6 +244,10 @@ func init() {
 	sessionListCmd.Flags().IntVarP(&sessionLimit, "limit", "n", 10, "limit number of sessions to display")
 	sessionListCmd.Flags().BoolVar(&sessionListJSON, "json", false, "output results as JSON")
 
+	// Session show flags
+	sessionShowCmd.Flags().BoolVar(&showJSON, "json", false, "output session details as JSON")
+	sessionShowCmd.Flags().BoolVar(&showFull, "full", false, "display all messages instead of a preview")
+
 	// Session export flags
 	// Note: --format uses long form only to avoid conflict with -f/--file (issue #121)
 	sessionExportCmd.Flags().StringVar(&exportFormat, "format", "json", "export format: json, markdown, html")
@@ -360,8 +379,18 @@ func runSessionList(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// showPreviewCount is how many messages are shown from each end of the
+// conversation when --full is not set
+const showPreviewCount = 3
+
 func runSessionShow(cmd *cobra.Command, args []string) error {
 	sessionID := args[0]
+
+	// Suppress INFO/DEBUG logs if JSON output is requested
+	if showJSON {
+		defer logger.SuppressInfoLogsForJSON()()
+	}
+
 	logger.DebugEvent().Str("session_id", sessionID).Msg("Showing session")
 
 	// Initialize database connection
@@ -377,9 +406,12 @@ func runSessionShow(cmd *cobra.Command, args []string) error {
 	// Create session manager
 	mgr := session.NewSQLiteManager(db)
 
-	// Get session
-	sess, err := mgr.GetSession(ctx, sessionID)
+	// Get session summary (metadata, message count, total tokens, tags)
+	summary, err := mgr.GetSessionSummary(ctx, sessionID)
 	if err != nil {
+		if errors.Is(err, session.ErrSessionNotFound) {
+			return fmt.Errorf("session %s not found; run 'ainative-code session list' to see available sessions", sessionID)
+		}
 		return fmt.Errorf("failed to get session: %w", err)
 	}
 
@@ -389,13 +421,46 @@ func runSessionShow(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get messages: %w", err)
 	}
 
+	// Without --full, preview only the first and last few messages
+	preview, omitted := messages, 0
+	if !showFull {
+		preview, omitted = previewMessages(messages, showPreviewCount)
+	}
+
+	if showJSON {
+		return outputAsJSON(struct {
+			*session.SessionSummary
+			Messages        []*session.Message `json:"messages"`
+			OmittedMessages int                `json:"omitted_messages,omitempty"`
+		}{
+			SessionSummary:  summary,
+			Messages:        preview,
+			OmittedMessages: omitted,
+		})
+	}
+
 	// Display session details
-	displaySessionDetails(sess, messages)
+	displaySessionDetails(summary, preview, omitted)
 
 	return nil
 }
 
-func displaySessionDetails(sess *session.Session, messages []*session.Message) {
+// previewMessages returns the first and last edge messages of a
+// conversation plus the number omitted in between
+func previewMessages(messages []*session.Message, edge int) ([]*session.Message, int) {
+	if len(messages) <= 2*edge {
+		return messages, 0
+	}
+
+	preview := make([]*session.Message, 0, 2*edge)
+	preview = append(preview, messages[:edge]...)
+	preview = append(preview, messages[len(messages)-edge:]...)
+
+	return preview, len(messages) - 2*edge
+}
+
+func displaySessionDetails(summary *session.SessionSummary, messages []*session.Message, omitted int) {
+	sess := &summary.Session
 	// Color codes for better readability
 	const (
 		colorReset  = "\033[0m"
@@ -428,42 +493,34 @@ func displaySessionDetails(sess *session.Session, messages []*session.Message) {
 		fmt.Printf("%sMax Tokens:%s %d\n", colorGray, colorReset, *sess.MaxTokens)
 	}
 
-	// Statistics
+	// Statistics from the summary, so totals cover the whole conversation
+	// even when only a preview of messages is displayed
 	fmt.Printf("\n%s=== Statistics ===%s\n\n", colorBold, colorReset)
-	fmt.Printf("%sTotal Messages:%s %d\n", colorGray, colorReset, len(messages))
-
-	// Count messages by role
-	roleCounts := make(map[string]int)
-	var totalTokens int64
-	for _, msg := range messages {
-		roleCounts[string(msg.Role)]++
-		if msg.TokensUsed != nil {
-			totalTokens += *msg.TokensUsed
-		}
-	}
+	fmt.Printf("%sTotal Messages:%s %d\n", colorGray, colorReset, summary.MessageCount)
 
-	if userCount := roleCounts["user"]; userCount > 0 {
-		fmt.Printf("%sUser Messages:%s %d\n", colorGray, colorReset, userCount)
-	}
-	if assistantCount := roleCounts["assistant"]; assistantCount > 0 {
-		fmt.Printf("%sAssistant Messages:%s %d\n", colorGray, colorReset, assistantCount)
-	}
-	if systemCount := roleCounts["system"]; systemCount > 0 {
-		fmt.Printf("%sSystem Messages:%s %d\n", colorGray, colorReset, systemCount)
-	}
-	if toolCount := roleCounts["tool"]; toolCount > 0 {
-		fmt.Printf("%sTool Messages:%s %d\n", colorGray, colorReset, toolCount)
+	if summary.TotalTokens > 0 {
+		fmt.Printf("%sTotal Tokens:%s %d\n", colorGray, colorReset, summary.TotalTokens)
 	}
 
-	if totalTokens > 0 {
-		fmt.Printf("%sTotal Tokens:%s %d\n", colorGray, colorReset, totalTokens)
+	if len(summary.Tags) > 0 {
+		fmt.Printf("%sTags:%s %s\n", colorGray, colorReset, strings.Join(summary.Tags, ", "))
 	}
 
 	// Messages
 	if len(messages) > 0 {
-		fmt.Printf("\n%s=== Messages ===%s\n\n", colorBold, colorReset)
+		if omitted > 0 {
+			fmt.Printf("\n%s=== Messages (preview, use --full for all) ===%s\n\n", colorBold, colorReset)
+		} else {
+			fmt.Printf("\n%s=== Messages ===%s\n\n", colorBold, colorReset)
+		}
 
 		for i, msg := range messages {
+			// When previewing, mark the gap between the first and
+			// last few messages
+			if omitted > 0 && i == len(messages)/2 {
+				fmt.Printf("%s... (%d messages omitted) ...%s\n\n", colorGray, omitted, colorReset)
+			}
+
 			// Role header with color
 			roleColor := colorGreen
 			if msg.Role == "user" {
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/AINative-studio/ainative-code/internal/session"
+)
+
+func makeMessages(count int) []*session.Message {
+	messages := make([]*session.Message, count)
+	for i := range messages {
+		messages[i] = &session.Message{
+			ID:      fmt.Sprintf("msg-%d", i),
+			Role:    session.RoleUser,
+			Content: fmt.Sprintf("message %d", i),
+		}
+	}
+	return messages
+}
+
+func TestPreviewMessages_ShortConversation(t *testing.T) {
+	// Conversations at or below 2*edge messages are returned unchanged
+	messages := makeMessages(6)
+
+	preview, omitted := previewMessages(messages, 3)
+
+	if omitted != 0 {
+		t.Errorf("expected 0 omitted messages, got %d", omitted)
+	}
+	if len(preview) != 6 {
+		t.Errorf("expected all 6 messages, got %d", len(preview))
+	}
+}
+
+func TestPreviewMessages_LongConversation(t *testing.T) {
+	messages := makeMessages(10)
+
+	preview, omitted := previewMessages(messages, 3)
+
+	if omitted != 4 {
+		t.Errorf("expected 4 omitted messages, got %d", omitted)
+	}
+	if len(preview) != 6 {
+		t.Fatalf("expected 6 preview messages, got %d", len(preview))
+	}
+
+	// First edge is the oldest messages, last edge is the newest
+	if preview[0].ID != "msg-0" || preview[2].ID != "msg-2" {
+		t.Errorf("unexpected first edge: %s..%s", preview[0].ID, preview[2].ID)
+	}
+	if preview[3].ID != "msg-7" || preview[5].ID != "msg-9" {
+		t.Errorf("unexpected last edge: %s..%s", preview[3].ID, preview[5].ID)
+	}
+}
+
+func TestPreviewMessages_Empty(t *testing.T) {
+	preview, omitted := previewMessages(nil, 3)
+
+	if omitted != 0 {
+		t.Errorf("expected 0 omitted messages, got %d", omitted)
+	}
+	if len(preview) != 0 {
+		t.Errorf("expected empty preview, got %d messages", len(preview))
+	}
+}
@@ -18,6 +18,7 @@ var (
 	setupConfigPath     string
 	setupForce          bool
 	setupNonInteractive bool
+	setupSection        string
 )
 
 // setupCmd represents the setup command
@@ -48,6 +49,9 @@ Examples:
   # Skip API key validation (faster setup)
   ainative-code setup --skip-validation
 
+  # Reconfigure a single section without re-running the whole wizard
+  ainative-code setup --section model
+
 Advanced Usage:
   # Skip setup wizard (for CI/CD or advanced users)
   ainative-code --skip-setup chat
@@ -57,13 +61,53 @@ Advanced Usage:
 	RunE: runSetup,
 }
 
+var setupExportRedact bool
+
+// setupExportCmd writes the wizard selections to a shareable file
+var setupExportCmd = &cobra.Command{
+	Use:   "export [file]",
+	Short: "Export setup answers to a shareable file",
+	Long: `Export the setup wizard answers derived from the current configuration
+to a file that teammates can import with 'setup import'.
+
+With --redact-secrets, API keys are replaced by a redaction marker so the
+file can be shared safely; importing it will prompt for the keys.
+
+Examples:
+  # Print shareable selections to stdout
+  ainative-code setup export --redact-secrets
+
+  # Write them to a file
+  ainative-code setup export --redact-secrets team-setup.yaml`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSetupExport,
+}
+
+// setupImportCmd pre-fills the wizard from an exported selections file
+var setupImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Run setup pre-filled from an exported answers file",
+	Long: `Run the setup wizard with answers pre-filled from a file created by
+'setup export'. Redacted secrets still prompt for input.
+
+Examples:
+  ainative-code setup import team-setup.yaml`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSetupImport,
+}
+
 func init() {
 	rootCmd.AddCommand(setupCmd)
+	setupCmd.AddCommand(setupExportCmd)
+	setupCmd.AddCommand(setupImportCmd)
+
+	setupExportCmd.Flags().BoolVar(&setupExportRedact, "redact-secrets", false, "replace API keys with a redaction marker")
 
 	setupCmd.Flags().BoolVar(&setupSkipValidation, "skip-validation", false, "skip API key validation")
 	setupCmd.Flags().StringVar(&setupConfigPath, "config", "", "custom config file path")
 	setupCmd.Flags().BoolVarP(&setupForce, "force", "f", false, "force re-run setup and overwrite existing config")
 	setupCmd.Flags().BoolVar(&setupNonInteractive, "non-interactive", false, "run in non-interactive mode (uses env vars)")
+	setupCmd.Flags().StringVar(&setupSection, "section", "", "reconfigure only one section (provider, model, platform, theme)")
 }
 
 func runSetup(cmd *cobra.Command, args []string) error {
@@ -72,8 +116,9 @@ func runSetup(cmd *cobra.Command, args []string) error {
 
 	logger.InfoEvent().Msg("Starting setup wizard")
 
-	// Check if already initialized - verify BOTH marker AND config file exist
-	if !setupForce {
+	// Check if already initialized - verify BOTH marker AND config file exist.
+	// Section mode expects an existing configuration, so it always proceeds.
+	if !setupForce && setupSection == "" {
 		homeDir, err := os.UserHomeDir()
 		if err == nil {
 			configPath := filepath.Join(homeDir, ".ainative-code.yaml")
@@ -95,6 +140,7 @@ func runSetup(cmd *cobra.Command, args []string) error {
 		SkipValidation:  setupSkipValidation,
 		InteractiveMode: !setupNonInteractive,
 		Force:           setupForce,
+		Section:         setupSection,
 	}
 
 	// Create and run wizard
@@ -114,6 +160,77 @@ func runSetup(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// runSetupExport writes shareable wizard selections to a file or stdout
+func runSetupExport(cmd *cobra.Command, args []string) error {
+	ctx, cancel := context.WithTimeout(cmd.Context(), time.Minute)
+	defer cancel()
+
+	wizard := setup.NewWizard(ctx, setup.WizardConfig{
+		ConfigPath: setupConfigPath,
+	})
+
+	if err := wizard.LoadExistingSelections(); err != nil {
+		return err
+	}
+
+	data, err := wizard.ExportSelections(setupExportRedact)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		fmt.Print(string(data))
+		return nil
+	}
+
+	if err := os.WriteFile(args[0], data, 0600); err != nil {
+		return fmt.Errorf("failed to write selections file: %w", err)
+	}
+
+	fmt.Printf("Setup answers exported to %s\n", args[0])
+	if !setupExportRedact {
+		fmt.Println("Warning: the file contains API keys; use --redact-secrets before sharing.")
+	}
+
+	return nil
+}
+
+// runSetupImport runs the wizard pre-filled from an exported answers file
+func runSetupImport(cmd *cobra.Command, args []string) error {
+	ctx, cancel := context.WithTimeout(cmd.Context(), 10*time.Minute)
+	defer cancel()
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to open selections file: %w", err)
+	}
+	defer file.Close()
+
+	wizard := setup.NewWizard(ctx, setup.WizardConfig{
+		ConfigPath:      setupConfigPath,
+		SkipValidation:  setupSkipValidation,
+		InteractiveMode: !setupNonInteractive,
+		Force:           true, // Imports reconfigure an existing install
+	})
+
+	if err := wizard.ImportSelections(file); err != nil {
+		return err
+	}
+
+	result, err := wizard.Run()
+	if err != nil {
+		logger.ErrorEvent().Err(err).Msg("Setup import failed")
+		return fmt.Errorf("setup import failed: %w", err)
+	}
+
+	logger.InfoEvent().
+		Str("config_path", result.ConfigPath).
+		Str("source_file", args[0]).
+		Msg("Setup import completed successfully")
+
+	return nil
+}
+
 // handleAlreadyInitialized handles the case where setup has already been run
 func handleAlreadyInitialized(cmd *cobra.Command) error {
 	homeDir, err := os.UserHomeDir()
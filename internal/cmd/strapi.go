@@ -1,13 +1,17 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/AINative-studio/ainative-code/internal/client"
+	"github.com/AINative-studio/ainative-code/internal/client/strapi"
 	"github.com/AINative-studio/ainative-code/internal/logger"
 )
 
@@ -80,11 +84,13 @@ var strapiPushCmd = &cobra.Command{
 
 // strapiListCmd represents the strapi list command
 var strapiListCmd = &cobra.Command{
-	Use:   "list",
-	Short: "List Strapi content types",
-	Long:  `List available content types in Strapi CMS.`,
+	Use:   "list [content-type]",
+	Short: "List Strapi content types or entries",
+	Long: `List available content types in Strapi CMS, or browse the entries
+of a specific content type when one is given.`,
+	Args:    cobra.MaximumNArgs(1),
 	Aliases: []string{"ls"},
-	RunE:  runStrapiList,
+	RunE:    runStrapiList,
 }
 
 // strapiSyncCmd represents the strapi sync command
@@ -116,6 +122,13 @@ func init() {
 	// Push flags
 	strapiPushCmd.Flags().BoolP("force", "f", false, "force push (overwrite remote data)")
 
+	// List flags
+	strapiListCmd.Flags().Int("page", 1, "page number when browsing entries")
+	strapiListCmd.Flags().Int("page-size", 25, "entries per page")
+	strapiListCmd.Flags().Int("start", -1, "offset-based pagination start (overrides --page)")
+	strapiListCmd.Flags().Int("limit", 0, "offset-based pagination limit (used with --start)")
+	strapiListCmd.Flags().String("sort", "", "sort order, e.g. createdAt:desc")
+
 	// Sync flags
 	strapiSyncCmd.Flags().String("strategy", "merge", "sync strategy (merge, local-wins, remote-wins)")
 }
@@ -322,75 +335,146 @@ func runStrapiPush(cmd *cobra.Command, args []string) error {
 }
 
 func runStrapiList(cmd *cobra.Command, args []string) error {
-	logger.Debug("Listing Strapi content types")
+	ctx := context.Background()
 
 	// Get Strapi URL from config
-	url := viper.GetString("strapi.url")
-	if url == "" {
+	strapiURL := viper.GetString("strapi.url")
+	if strapiURL == "" {
 		return fmt.Errorf("Strapi URL not configured. Use 'ainative-code strapi config' to set it up")
 	}
 
-	token := viper.GetString("strapi.token")
+	apiClient := client.New(
+		client.WithBaseURL(strapiURL),
+		client.WithTimeout(30*time.Second),
+	)
+
+	strapiClient := strapi.New(
+		strapi.WithAPIClient(apiClient),
+		strapi.WithBaseURL(strapiURL),
+	)
+
+	if len(args) > 0 {
+		return listStrapiEntries(ctx, cmd, strapiClient, args[0])
+	}
+
+	return listStrapiContentTypes(ctx, strapiClient)
+}
+
+func listStrapiContentTypes(ctx context.Context, strapiClient *strapi.Client) error {
+	logger.Debug("Listing Strapi content types")
+
+	contentTypes, err := strapiClient.ListContentTypes(ctx)
+	if err != nil {
+		// The content-type-builder endpoint requires admin access; fall
+		// back to the common types so the command stays useful.
+		if status := client.StatusCode(err); status == 401 || status == 403 {
+			fmt.Println("Authentication required or insufficient permissions.")
+			fmt.Println()
+			fmt.Println("This endpoint typically requires admin access.")
+			fmt.Println("Showing commonly used content types instead:")
+			fmt.Println()
+			displayCommonContentTypes()
+			return nil
+		}
+		return fmt.Errorf("failed to list content types: %w", err)
+	}
 
 	fmt.Println("Available Content Types:")
 	fmt.Println("========================")
 	fmt.Println()
 
-	// Create HTTP client
-	client := &http.Client{
-		Timeout: 10 * time.Second,
+	if len(contentTypes) == 0 {
+		fmt.Println("No content types found.")
+		return nil
 	}
 
-	// Build API URL for content types
-	apiURL := url
-	if !strings.HasSuffix(apiURL, "/") {
-		apiURL += "/"
+	for _, ct := range contentTypes {
+		name := ct.DisplayName
+		if name == "" {
+			name = ct.UID
+		}
+		fmt.Printf("  • %s (%s)\n", name, ct.UID)
+		if ct.Kind != "" {
+			fmt.Printf("    Kind: %s\n", ct.Kind)
+		}
+		if ct.Info != nil && ct.Info.Description != "" {
+			fmt.Printf("    %s\n", ct.Info.Description)
+		}
+		if len(ct.Attributes) > 0 {
+			fields := make([]string, 0, len(ct.Attributes))
+			for field := range ct.Attributes {
+				fields = append(fields, field)
+			}
+			sort.Strings(fields)
+			fmt.Printf("    Fields: %s\n", strings.Join(fields, ", "))
+		}
+		fmt.Println()
 	}
-	apiURL += "api/content-type-builder/content-types"
 
-	// Create request
-	req, err := http.NewRequest("GET", apiURL, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
+	fmt.Printf("Total: %d content types\n", len(contentTypes))
+	fmt.Println("Use 'ainative-code strapi list <content-type>' to browse entries.")
 
-	// Add authorization header if token is configured
-	if token != "" {
-		req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+func listStrapiEntries(ctx context.Context, cmd *cobra.Command, strapiClient *strapi.Client, contentType string) error {
+	logger.DebugEvent().
+		Str("content_type", contentType).
+		Msg("Listing Strapi entries")
+
+	page, _ := cmd.Flags().GetInt("page")
+	pageSize, _ := cmd.Flags().GetInt("page-size")
+	start, _ := cmd.Flags().GetInt("start")
+	limit, _ := cmd.Flags().GetInt("limit")
+	sortOrder, _ := cmd.Flags().GetString("sort")
+
+	opts := []strapi.ListOption{}
+	if start >= 0 {
+		opts = append(opts, strapi.WithStartLimit(start, limit))
+	} else {
+		opts = append(opts,
+			strapi.WithPage(page),
+			strapi.WithPageSize(pageSize),
+		)
+	}
+	if sortOrder != "" {
+		opts = append(opts, strapi.WithSort(sortOrder))
 	}
 
-	// Send request
-	resp, err := client.Do(req)
+	entries, meta, err := strapiClient.ListEntries(ctx, contentType, opts...)
 	if err != nil {
-		return fmt.Errorf("failed to connect to Strapi: %w", err)
+		return fmt.Errorf("failed to list entries: %w", err)
 	}
-	defer resp.Body.Close()
 
-	// Check response status
-	if resp.StatusCode == 401 || resp.StatusCode == 403 {
-		fmt.Println("Authentication required or insufficient permissions.")
-		fmt.Println()
-		fmt.Println("This endpoint typically requires admin access.")
-		fmt.Println("Alternative approach: List commonly used content types:")
-		fmt.Println()
-		displayCommonContentTypes()
+	fmt.Printf("Entries in %s:\n", contentType)
+	fmt.Println()
+
+	if len(entries) == 0 {
+		fmt.Println("No entries found.")
 		return nil
 	}
 
-	if resp.StatusCode != 200 {
-		fmt.Printf("Unable to fetch content types (Status: %d)\n", resp.StatusCode)
-		fmt.Println()
-		fmt.Println("Showing commonly used content types instead:")
+	for _, entry := range entries {
+		fmt.Printf("  ID %d:\n", entry.ID)
+		fields := make([]string, 0, len(entry.Attributes))
+		for field := range entry.Attributes {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			fmt.Printf("    %s: %v\n", field, entry.Attributes[field])
+		}
 		fmt.Println()
-		displayCommonContentTypes()
-		return nil
 	}
 
-	// For now, display common content types since parsing the response
-	// would require understanding the specific Strapi version and schema
-	fmt.Println("Common content types typically available in Strapi:")
-	fmt.Println()
-	displayCommonContentTypes()
+	if meta != nil && meta.Pagination != nil {
+		p := meta.Pagination
+		if p.PageCount > 0 {
+			fmt.Printf("Page %d of %d (%d total entries)\n", p.Page, p.PageCount, p.Total)
+		} else {
+			fmt.Printf("Showing %d entries from offset %d (%d total)\n", len(entries), p.Start, p.Total)
+		}
+	}
 
 	return nil
 }
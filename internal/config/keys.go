@@ -0,0 +1,161 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// keyTypes maps every dotted configuration key to the Go type of the field
+// it addresses. It is built once by reflecting over Config so the list can
+// never drift from the struct definitions.
+var (
+	keyTypesOnce sync.Once
+	keyTypes     map[string]reflect.Type
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// ValidKeys returns every dotted configuration key that can be addressed
+// individually (e.g. "llm.default_provider"), sorted alphabetically.
+func ValidKeys() []string {
+	types := loadKeyTypes()
+	keys := make([]string, 0, len(types))
+	for key := range types {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// KeyType reports the Go type of the field addressed by the dotted key and
+// whether the key exists in the configuration schema. Lookup is
+// case-insensitive to match viper's key handling.
+func KeyType(key string) (reflect.Type, bool) {
+	t, ok := loadKeyTypes()[strings.ToLower(key)]
+	return t, ok
+}
+
+// ParseValue converts a raw string into the typed value expected by the
+// field addressed by key. Booleans, integers, floats, durations, and
+// comma-separated string lists are supported; on a type mismatch the error
+// describes the expected form.
+func ParseValue(key, raw string) (interface{}, error) {
+	t, ok := KeyType(key)
+	if !ok {
+		return nil, fmt.Errorf("unknown configuration key '%s'", key)
+	}
+
+	if t == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' expects a duration (e.g. 30s, 2m), got '%s'", key, raw)
+		}
+		return d, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' expects a boolean (true or false), got '%s'", key, raw)
+		}
+		return b, nil
+
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' expects an integer, got '%s'", key, raw)
+		}
+		if t.Kind() == reflect.Int {
+			return int(n), nil
+		}
+		return n, nil
+
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' expects a number, got '%s'", key, raw)
+		}
+		return f, nil
+
+	case reflect.String:
+		return raw, nil
+
+	case reflect.Slice:
+		if t.Elem().Kind() != reflect.String {
+			break
+		}
+		parts := strings.Split(raw, ",")
+		values := make([]string, 0, len(parts))
+		for _, part := range parts {
+			if trimmed := strings.TrimSpace(part); trimmed != "" {
+				values = append(values, trimmed)
+			}
+		}
+		return values, nil
+	}
+
+	return nil, fmt.Errorf("'%s' holds a %s and cannot be set from the command line; edit the config file directly", key, t.Kind())
+}
+
+// KeysWithPrefix returns the valid keys that start with the given dotted
+// prefix, useful for suggesting alternatives when a key is not recognized.
+func KeysWithPrefix(prefix string) []string {
+	prefix = strings.ToLower(prefix)
+	var matches []string
+	for _, key := range ValidKeys() {
+		if strings.HasPrefix(key, prefix) {
+			matches = append(matches, key)
+		}
+	}
+	return matches
+}
+
+func loadKeyTypes() map[string]reflect.Type {
+	keyTypesOnce.Do(func() {
+		keyTypes = make(map[string]reflect.Type)
+		collectKeys(reflect.TypeOf(Config{}), "", keyTypes)
+	})
+	return keyTypes
+}
+
+// collectKeys walks the configuration struct recording the dotted
+// mapstructure path and type of every leaf field
+func collectKeys(t reflect.Type, prefix string, out map[string]reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		name := strings.Split(field.Tag.Get("mapstructure"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		// Profiles are free-form maps merged at load time; they are not
+		// addressable as individual typed keys
+		if name == "profiles" {
+			continue
+		}
+
+		ft := field.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		key := name
+		if prefix != "" {
+			key = prefix + "." + name
+		}
+
+		if ft.Kind() == reflect.Struct && ft != durationType {
+			collectKeys(ft, key, out)
+			continue
+		}
+
+		out[key] = ft
+	}
+}
@@ -0,0 +1,148 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidKeys(t *testing.T) {
+	keys := ValidKeys()
+	require.NotEmpty(t, keys, "ValidKeys should derive keys from the Config struct")
+
+	// Spot-check keys at different nesting depths
+	assert.Contains(t, keys, "llm.default_provider")
+	assert.Contains(t, keys, "llm.anthropic.max_tokens")
+	assert.Contains(t, keys, "llm.anthropic.extended_thinking.enabled")
+	assert.Contains(t, keys, "logging.level")
+	assert.Contains(t, keys, "version")
+
+	// Free-form profiles are not individually addressable
+	assert.NotContains(t, keys, "profiles")
+
+	// Intermediate struct nodes are not leaves
+	assert.NotContains(t, keys, "llm")
+	assert.NotContains(t, keys, "llm.anthropic")
+}
+
+func TestKeyType(t *testing.T) {
+	tests := []struct {
+		key      string
+		wantType reflect.Type
+		wantOK   bool
+	}{
+		{"llm.default_provider", reflect.TypeOf(""), true},
+		{"llm.anthropic.max_tokens", reflect.TypeOf(0), true},
+		{"llm.anthropic.temperature", reflect.TypeOf(0.0), true},
+		{"llm.fallback.enabled", reflect.TypeOf(false), true},
+		{"llm.anthropic.timeout", reflect.TypeOf(time.Duration(0)), true},
+		{"llm.fallback.providers", reflect.TypeOf([]string{}), true},
+		{"LLM.Default_Provider", reflect.TypeOf(""), true}, // case-insensitive
+		{"does.not.exist", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got, ok := KeyType(tt.key)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.wantType, got)
+			}
+		})
+	}
+}
+
+func TestParseValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		raw     string
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "string value",
+			key:  "llm.default_provider",
+			raw:  "anthropic",
+			want: "anthropic",
+		},
+		{
+			name: "integer value",
+			key:  "llm.anthropic.max_tokens",
+			raw:  "8192",
+			want: 8192,
+		},
+		{
+			name: "float value",
+			key:  "llm.anthropic.temperature",
+			raw:  "0.7",
+			want: 0.7,
+		},
+		{
+			name: "boolean value",
+			key:  "llm.fallback.enabled",
+			raw:  "true",
+			want: true,
+		},
+		{
+			name: "duration value",
+			key:  "llm.anthropic.timeout",
+			raw:  "45s",
+			want: 45 * time.Second,
+		},
+		{
+			name: "string slice value",
+			key:  "llm.fallback.providers",
+			raw:  "anthropic, openai,ollama",
+			want: []string{"anthropic", "openai", "ollama"},
+		},
+		{
+			name:    "integer type mismatch",
+			key:     "llm.anthropic.max_tokens",
+			raw:     "lots",
+			wantErr: true,
+		},
+		{
+			name:    "boolean type mismatch",
+			key:     "llm.fallback.enabled",
+			raw:     "maybe",
+			wantErr: true,
+		},
+		{
+			name:    "duration type mismatch",
+			key:     "llm.anthropic.timeout",
+			raw:     "soon",
+			wantErr: true,
+		},
+		{
+			name:    "unknown key",
+			key:     "does.not.exist",
+			raw:     "value",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseValue(tt.key, tt.raw)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestKeysWithPrefix(t *testing.T) {
+	matches := KeysWithPrefix("llm.fallback.")
+	assert.Contains(t, matches, "llm.fallback.enabled")
+	assert.Contains(t, matches, "llm.fallback.providers")
+	assert.NotContains(t, matches, "llm.default_provider")
+
+	assert.Empty(t, KeysWithPrefix("nosuchsection."))
+}
@@ -7,17 +7,19 @@ import (
 	"strings"
 
 	"github.com/AINative-studio/ainative-code/internal/errors"
+	"github.com/AINative-studio/ainative-code/internal/logger"
 	"github.com/spf13/viper"
 )
 
 // Loader handles loading configuration from multiple sources
 type Loader struct {
-	viper       *viper.Viper
-	configPaths []string
-	configName  string
-	configType  string
-	envPrefix   string
-	resolver    *Resolver
+	viper         *viper.Viper
+	configPaths   []string
+	configName    string
+	configType    string
+	envPrefix     string
+	resolver      *Resolver
+	activeProfile string
 }
 
 // LoaderOption is a functional option for configuring the Loader
@@ -75,6 +77,15 @@ func WithEnvPrefix(prefix string) LoaderOption {
 	}
 }
 
+// WithActiveProfile selects a named profile from the config's profiles map to
+// deep-merge over the base configuration. When no profile is set explicitly,
+// the AINATIVE_PROFILE environment variable is consulted.
+func WithActiveProfile(name string) LoaderOption {
+	return func(l *Loader) {
+		l.activeProfile = name
+	}
+}
+
 // WithResolver sets a custom API key resolver
 func WithResolver(resolver *Resolver) LoaderOption {
 	return func(l *Loader) {
@@ -108,12 +119,33 @@ func (l *Loader) Load() (*Config, error) {
 		}
 	}
 
+	// Upgrade older config schema versions, re-reading if the file changed
+	if configFile := l.viper.ConfigFileUsed(); configFile != "" {
+		migrated, err := l.migrateConfigFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+		if migrated {
+			if err := l.viper.ReadInConfig(); err != nil {
+				return nil, errors.NewConfigParseError(configFile, err)
+			}
+		}
+	}
+
+	// Merge the active profile, if any, over the base configuration
+	if err := l.applyProfile(); err != nil {
+		return nil, err
+	}
+
 	// Unmarshal into Config struct
 	var cfg Config
 	if err := l.viper.Unmarshal(&cfg); err != nil {
 		return nil, errors.NewConfigParseError("unmarshal", err)
 	}
 
+	// Map deprecated top-level keys onto their canonical locations
+	l.normalizeLegacyKeys(&cfg)
+
 	// Resolve dynamic API keys
 	if err := l.resolveAPIKeys(&cfg); err != nil {
 		return nil, errors.Wrap(err, errors.ErrCodeConfigInvalid, "failed to resolve API keys")
@@ -128,6 +160,44 @@ func (l *Loader) Load() (*Config, error) {
 	return &cfg, nil
 }
 
+// LoadProfile loads configuration with the named profile merged over the base
+// config, equivalent to constructing the loader with WithActiveProfile
+func (l *Loader) LoadProfile(name string) (*Config, error) {
+	l.activeProfile = name
+	return l.Load()
+}
+
+// applyProfile deep-merges the active profile's settings over the base
+// configuration. Profile values override the base config but remain below
+// environment variables and flags, so precedence stays
+// flags > env > profile > base > defaults.
+func (l *Loader) applyProfile() error {
+	name := l.activeProfile
+	if name == "" {
+		name = os.Getenv("AINATIVE_PROFILE")
+	}
+	if name == "" {
+		return nil
+	}
+
+	profile := l.viper.GetStringMap("profiles." + name)
+	if len(profile) == 0 {
+		return errors.NewConfigInvalidError(
+			"profiles."+name,
+			"profile is not defined in the configuration",
+		)
+	}
+
+	if err := l.viper.MergeConfigMap(profile); err != nil {
+		return errors.NewConfigInvalidError(
+			"profiles."+name,
+			fmt.Sprintf("failed to merge profile: %v", err),
+		)
+	}
+
+	return nil
+}
+
 // LoadFromFile loads configuration from a specific file path
 func (l *Loader) LoadFromFile(filePath string) (*Config, error) {
 	// Expand environment variables in path
@@ -138,6 +208,11 @@ func (l *Loader) LoadFromFile(filePath string) (*Config, error) {
 		return nil, errors.NewConfigParseError(expandedPath, fmt.Errorf("file does not exist"))
 	}
 
+	// Upgrade older config schema versions before reading
+	if _, err := l.migrateConfigFile(expandedPath); err != nil {
+		return nil, err
+	}
+
 	// Set config file
 	l.viper.SetConfigFile(expandedPath)
 
@@ -152,12 +227,20 @@ func (l *Loader) LoadFromFile(filePath string) (*Config, error) {
 		return nil, errors.NewConfigParseError(expandedPath, err)
 	}
 
+	// Merge the active profile, if any, over the base configuration
+	if err := l.applyProfile(); err != nil {
+		return nil, err
+	}
+
 	// Unmarshal into Config struct
 	var cfg Config
 	if err := l.viper.Unmarshal(&cfg); err != nil {
 		return nil, errors.NewConfigParseError(expandedPath, err)
 	}
 
+	// Map deprecated top-level keys onto their canonical locations
+	l.normalizeLegacyKeys(&cfg)
+
 	// Resolve dynamic API keys
 	if err := l.resolveAPIKeys(&cfg); err != nil {
 		return nil, errors.Wrap(err, errors.ErrCodeConfigInvalid, "failed to resolve API keys")
@@ -172,6 +255,80 @@ func (l *Loader) LoadFromFile(filePath string) (*Config, error) {
 	return &cfg, nil
 }
 
+// ValidateFile validates the given configuration file without loading it into
+// the running process, returning every issue found rather than failing on the
+// first. The error return covers problems that prevent validation entirely,
+// such as a missing file or a parse failure; validation findings, including
+// warning-level checks, are reported through the issue list. The loader's own
+// state is left untouched.
+func (l *Loader) ValidateFile(path string) ([]ValidationIssue, error) {
+	// Use a fresh loader so the dry-run cannot pollute this loader's viper
+	fresh := NewLoader(
+		WithConfigName(l.configName),
+		WithConfigType(l.configType),
+		WithEnvPrefix(l.envPrefix),
+		WithResolver(l.resolver),
+		WithActiveProfile(l.activeProfile),
+	)
+
+	expandedPath := os.ExpandEnv(path)
+	if _, err := os.Stat(expandedPath); os.IsNotExist(err) {
+		return nil, errors.NewConfigParseError(expandedPath, fmt.Errorf("file does not exist"))
+	}
+
+	fresh.viper.SetConfigFile(expandedPath)
+	fresh.setupEnvVars()
+	fresh.setDefaults()
+
+	if err := fresh.viper.ReadInConfig(); err != nil {
+		return nil, errors.NewConfigParseError(expandedPath, err)
+	}
+
+	var cfg Config
+	if err := fresh.viper.Unmarshal(&cfg); err != nil {
+		return nil, errors.NewConfigParseError(expandedPath, err)
+	}
+
+	fresh.normalizeLegacyKeys(&cfg)
+
+	validator := NewValidator(&cfg)
+
+	// Resolver failures are reported as issues so the remaining checks still run
+	if err := fresh.resolveAPIKeys(&cfg); err != nil {
+		validator.addError("llm", fmt.Sprintf("failed to resolve API keys: %v", err))
+	}
+
+	// Collect every issue; the aggregate error duplicates the issue list
+	_ = validator.Validate()
+	validator.checkWarnings()
+
+	return validator.Issues(), nil
+}
+
+// normalizeLegacyKeys maps deprecated configuration keys onto their canonical
+// fields. The top-level "provider" key predates the llm section and is treated
+// as an alias for "llm.default_provider"; the canonical key wins when both are
+// set explicitly.
+func (l *Loader) normalizeLegacyKeys(cfg *Config) {
+	legacy := strings.TrimSpace(l.viper.GetString("provider"))
+	if legacy == "" {
+		return
+	}
+
+	logger.WarnEvent().
+		Str("key", "provider").
+		Str("replacement", "llm.default_provider").
+		Msg("Top-level 'provider' config key is deprecated, use 'llm.default_provider' instead")
+
+	// Only map forward when the canonical key was not set explicitly; the
+	// default value set by setDefaults does not count
+	if l.viper.InConfig("llm.default_provider") {
+		return
+	}
+
+	cfg.LLM.DefaultProvider = legacy
+}
+
 // setupEnvVars configures environment variable support
 func (l *Loader) setupEnvVars() {
 	// Set environment variable prefix
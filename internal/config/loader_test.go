@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -122,6 +123,180 @@ logging:
 	}
 }
 
+func TestLoadFromFile_LegacyProviderKey(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "test-config.yaml")
+
+	// Only the deprecated top-level key is set
+	configContent := `
+app:
+  name: test-app
+  environment: development
+
+provider: openai
+
+llm:
+  openai:
+    api_key: sk-openai-test
+    model: gpt-4-turbo-preview
+
+platform:
+  authentication:
+    method: api_key
+    api_key: test-key
+
+logging:
+  level: info
+  format: json
+  output: stdout
+`
+
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to create test config file: %v", err)
+	}
+
+	loader := NewLoader()
+	cfg, err := loader.LoadFromFile(configPath)
+	if err != nil {
+		t.Fatalf("LoadFromFile() error = %v", err)
+	}
+
+	if cfg.LLM.DefaultProvider != "openai" {
+		t.Errorf("LLM.DefaultProvider = %v, want %v (mapped from legacy 'provider' key)", cfg.LLM.DefaultProvider, "openai")
+	}
+}
+
+func TestLoadFromFile_LegacyProviderKeyCanonicalWins(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "test-config.yaml")
+
+	// Both keys set: the canonical llm.default_provider takes precedence
+	configContent := `
+app:
+  name: test-app
+  environment: development
+
+provider: openai
+
+llm:
+  default_provider: anthropic
+  anthropic:
+    api_key: sk-ant-test
+    model: claude-3-5-sonnet-20241022
+  openai:
+    api_key: sk-openai-test
+    model: gpt-4-turbo-preview
+
+platform:
+  authentication:
+    method: api_key
+    api_key: test-key
+
+logging:
+  level: info
+  format: json
+  output: stdout
+`
+
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to create test config file: %v", err)
+	}
+
+	loader := NewLoader()
+	cfg, err := loader.LoadFromFile(configPath)
+	if err != nil {
+		t.Fatalf("LoadFromFile() error = %v", err)
+	}
+
+	if cfg.LLM.DefaultProvider != "anthropic" {
+		t.Errorf("LLM.DefaultProvider = %v, want %v (canonical key should win)", cfg.LLM.DefaultProvider, "anthropic")
+	}
+}
+
+func TestLoadFromFile_SecretReferences(t *testing.T) {
+	os.Setenv("TEST_LOADER_SECRET_KEY", "sk-ant-resolved-key")
+	defer os.Unsetenv("TEST_LOADER_SECRET_KEY")
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "test-config.yaml")
+
+	configContent := `
+app:
+  name: test-app
+  environment: development
+
+llm:
+  default_provider: anthropic
+  anthropic:
+    api_key: env://TEST_LOADER_SECRET_KEY
+    model: claude-3-5-sonnet-20241022
+
+platform:
+  authentication:
+    method: api_key
+    api_key: test-key
+
+logging:
+  level: info
+  format: json
+  output: stdout
+`
+
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to create test config file: %v", err)
+	}
+
+	loader := NewLoader()
+	cfg, err := loader.LoadFromFile(configPath)
+	if err != nil {
+		t.Fatalf("LoadFromFile() error = %v", err)
+	}
+
+	if cfg.LLM.Anthropic.APIKey != "sk-ant-resolved-key" {
+		t.Errorf("LLM.Anthropic.APIKey = %v, want resolved secret", cfg.LLM.Anthropic.APIKey)
+	}
+}
+
+func TestLoadFromFile_SecretReferenceMissing(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "test-config.yaml")
+
+	configContent := `
+app:
+  name: test-app
+  environment: development
+
+llm:
+  default_provider: anthropic
+  anthropic:
+    api_key: env://TEST_LOADER_MISSING_SECRET
+    model: claude-3-5-sonnet-20241022
+
+platform:
+  authentication:
+    method: api_key
+    api_key: test-key
+
+logging:
+  level: info
+  format: json
+  output: stdout
+`
+
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to create test config file: %v", err)
+	}
+
+	loader := NewLoader()
+	_, err := loader.LoadFromFile(configPath)
+	if err == nil {
+		t.Fatal("LoadFromFile() expected error for missing secret reference, got nil")
+	}
+	if !strings.Contains(err.Error(), "TEST_LOADER_MISSING_SECRET") {
+		t.Errorf("error %q should name the missing secret", err.Error())
+	}
+}
+
 func TestLoadFromFile_NonExistent(t *testing.T) {
 	loader := NewLoader()
 	_, err := loader.LoadFromFile("/nonexistent/path/config.yaml")
@@ -385,6 +560,9 @@ llm:
     timeout: 45s
     retry_attempts: 5
 
+  openai:
+    api_key: sk-openai-complete
+
   fallback:
     enabled: true
     providers:
@@ -0,0 +1,121 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/AINative-studio/ainative-code/internal/errors"
+	"github.com/AINative-studio/ainative-code/internal/logger"
+	"gopkg.in/yaml.v3"
+)
+
+// CurrentConfigVersion is the schema version written by this build. Files
+// with a lower version are migrated forward on load; files with a higher
+// version trigger a warning and a best-effort load.
+const CurrentConfigVersion = 1
+
+// migrateConfigFile upgrades an older configuration file to the current
+// schema version. When a migration changes the file, the original is kept as
+// a .bak copy next to it and the migrated content is written back. It returns
+// true when the file was rewritten so callers can re-read it.
+func (l *Loader) migrateConfigFile(path string) (bool, error) {
+	// Migrations rewrite the file, which is only supported for YAML
+	if l.configType != "yaml" {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		// Unreadable files surface as parse errors later in the load
+		return false, nil
+	}
+
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil || raw == nil {
+		// Malformed files surface as parse errors later in the load
+		return false, nil
+	}
+
+	version := configFileVersion(raw)
+
+	if version > CurrentConfigVersion {
+		logger.WarnEvent().
+			Str("file", path).
+			Int("file_version", version).
+			Int("supported_version", CurrentConfigVersion).
+			Msg("Config file was written by a newer version, attempting best-effort load")
+		return false, nil
+	}
+
+	changed := false
+	for version < CurrentConfigVersion {
+		switch version {
+		case 0:
+			if migrateConfigV0ToV1(raw) {
+				changed = true
+			}
+		}
+		version++
+	}
+
+	if !changed {
+		return false, nil
+	}
+
+	raw["version"] = CurrentConfigVersion
+
+	migrated, err := yaml.Marshal(raw)
+	if err != nil {
+		return false, errors.NewConfigParseError(path, fmt.Errorf("failed to marshal migrated config: %w", err))
+	}
+
+	backupPath := path + ".bak"
+	if err := os.WriteFile(backupPath, data, 0600); err != nil {
+		return false, errors.NewConfigParseError(path, fmt.Errorf("failed to write backup %s: %w", backupPath, err))
+	}
+	if err := os.WriteFile(path, migrated, 0600); err != nil {
+		return false, errors.NewConfigParseError(path, fmt.Errorf("failed to write migrated config: %w", err))
+	}
+
+	logger.WarnEvent().
+		Str("file", path).
+		Str("backup", backupPath).
+		Int("version", CurrentConfigVersion).
+		Msg("Config file migrated to current schema version")
+
+	return true, nil
+}
+
+// configFileVersion extracts the schema version from a raw config map,
+// treating missing or malformed values as version 0
+func configFileVersion(raw map[string]interface{}) int {
+	switch v := raw["version"].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	}
+	return 0
+}
+
+// migrateConfigV0ToV1 moves keys renamed between v0 and v1: the default
+// provider lived at the top-level "provider" key before the llm section
+// existed. It reports whether anything was changed.
+func migrateConfigV0ToV1(raw map[string]interface{}) bool {
+	provider, ok := raw["provider"]
+	if !ok {
+		return false
+	}
+
+	llm, _ := raw["llm"].(map[string]interface{})
+	if llm == nil {
+		llm = make(map[string]interface{})
+		raw["llm"] = llm
+	}
+	if _, exists := llm["default_provider"]; !exists {
+		llm["default_provider"] = provider
+	}
+	delete(raw, "provider")
+
+	return true
+}
@@ -0,0 +1,176 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMigrateConfigFile_V0ToV1(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "test-config.yaml")
+
+	// A v0 file: no version field, default provider at the top level
+	originalContent := `
+app:
+  name: test-app
+  environment: development
+
+provider: openai
+
+llm:
+  openai:
+    api_key: sk-openai-test
+    model: gpt-4-turbo-preview
+
+platform:
+  authentication:
+    method: api_key
+    api_key: test-key
+
+logging:
+  level: info
+  format: json
+  output: stdout
+`
+
+	if err := os.WriteFile(configPath, []byte(originalContent), 0644); err != nil {
+		t.Fatalf("Failed to create test config file: %v", err)
+	}
+
+	loader := NewLoader()
+	cfg, err := loader.LoadFromFile(configPath)
+	if err != nil {
+		t.Fatalf("LoadFromFile() error = %v", err)
+	}
+
+	// The renamed key is moved to its canonical location
+	if cfg.LLM.DefaultProvider != "openai" {
+		t.Errorf("LLM.DefaultProvider = %v, want openai", cfg.LLM.DefaultProvider)
+	}
+	if cfg.Version != CurrentConfigVersion {
+		t.Errorf("Version = %v, want %v", cfg.Version, CurrentConfigVersion)
+	}
+
+	// The migrated file was written back with the current version and
+	// without the legacy key
+	migrated, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("Failed to read migrated config: %v", err)
+	}
+	if !strings.Contains(string(migrated), "version: 1") {
+		t.Error("migrated file should contain 'version: 1'")
+	}
+	if !strings.Contains(string(migrated), "default_provider: openai") {
+		t.Error("migrated file should contain the moved default_provider key")
+	}
+	if strings.Contains(string(migrated), "\nprovider:") {
+		t.Error("migrated file should not contain the legacy top-level provider key")
+	}
+
+	// The original content is preserved as a backup
+	backup, err := os.ReadFile(configPath + ".bak")
+	if err != nil {
+		t.Fatalf("Failed to read backup file: %v", err)
+	}
+	if string(backup) != originalContent {
+		t.Error("backup file should contain the original content")
+	}
+}
+
+func TestMigrateConfigFile_CurrentVersionUntouched(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "test-config.yaml")
+
+	configContent := `
+version: 1
+
+app:
+  name: test-app
+  environment: development
+
+llm:
+  default_provider: anthropic
+  anthropic:
+    api_key: sk-ant-test
+    model: claude-3-5-sonnet-20241022
+
+platform:
+  authentication:
+    method: api_key
+    api_key: test-key
+
+logging:
+  level: info
+  format: json
+  output: stdout
+`
+
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to create test config file: %v", err)
+	}
+
+	loader := NewLoader()
+	if _, err := loader.LoadFromFile(configPath); err != nil {
+		t.Fatalf("LoadFromFile() error = %v", err)
+	}
+
+	if _, err := os.Stat(configPath + ".bak"); !os.IsNotExist(err) {
+		t.Error("current-version file should not be rewritten or backed up")
+	}
+
+	current, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("Failed to read config: %v", err)
+	}
+	if string(current) != configContent {
+		t.Error("current-version file content should be unchanged")
+	}
+}
+
+func TestMigrateConfigFile_FutureVersionBestEffort(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "test-config.yaml")
+
+	configContent := `
+version: 99
+
+app:
+  name: test-app
+  environment: development
+
+llm:
+  default_provider: anthropic
+  anthropic:
+    api_key: sk-ant-test
+    model: claude-3-5-sonnet-20241022
+
+platform:
+  authentication:
+    method: api_key
+    api_key: test-key
+
+logging:
+  level: info
+  format: json
+  output: stdout
+`
+
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to create test config file: %v", err)
+	}
+
+	loader := NewLoader()
+	cfg, err := loader.LoadFromFile(configPath)
+	if err != nil {
+		t.Fatalf("LoadFromFile() should best-effort load future versions, error = %v", err)
+	}
+
+	if cfg.LLM.DefaultProvider != "anthropic" {
+		t.Errorf("LLM.DefaultProvider = %v, want anthropic", cfg.LLM.DefaultProvider)
+	}
+	if _, err := os.Stat(configPath + ".bak"); !os.IsNotExist(err) {
+		t.Error("future-version file should not be rewritten or backed up")
+	}
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeProfileTestConfig(t *testing.T) string {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "test-config.yaml")
+
+	configContent := `
+app:
+  name: test-app
+  environment: development
+
+llm:
+  default_provider: anthropic
+  anthropic:
+    api_key: sk-ant-test
+    model: claude-3-5-sonnet-20241022
+  openai:
+    api_key: sk-openai-test
+    model: gpt-4-turbo-preview
+
+platform:
+  authentication:
+    method: api_key
+    api_key: test-key
+
+logging:
+  level: info
+  format: json
+  output: stdout
+
+profiles:
+  work:
+    llm:
+      default_provider: openai
+`
+
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to create test config file: %v", err)
+	}
+	return configPath
+}
+
+func TestLoadFromFile_ActiveProfile(t *testing.T) {
+	configPath := writeProfileTestConfig(t)
+
+	loader := NewLoader(WithActiveProfile("work"))
+	cfg, err := loader.LoadFromFile(configPath)
+	if err != nil {
+		t.Fatalf("LoadFromFile() error = %v", err)
+	}
+
+	// The profile overrides the default provider
+	if cfg.LLM.DefaultProvider != "openai" {
+		t.Errorf("LLM.DefaultProvider = %v, want openai (from profile)", cfg.LLM.DefaultProvider)
+	}
+
+	// Settings not touched by the profile are inherited from the base config
+	if cfg.Logging.Level != "info" {
+		t.Errorf("Logging.Level = %v, want info (inherited)", cfg.Logging.Level)
+	}
+	if cfg.LLM.Anthropic == nil || cfg.LLM.Anthropic.APIKey != "sk-ant-test" {
+		t.Error("base anthropic configuration should be inherited")
+	}
+}
+
+func TestLoadFromFile_ProfileFromEnv(t *testing.T) {
+	configPath := writeProfileTestConfig(t)
+
+	os.Setenv("AINATIVE_PROFILE", "work")
+	defer os.Unsetenv("AINATIVE_PROFILE")
+
+	loader := NewLoader()
+	cfg, err := loader.LoadFromFile(configPath)
+	if err != nil {
+		t.Fatalf("LoadFromFile() error = %v", err)
+	}
+
+	if cfg.LLM.DefaultProvider != "openai" {
+		t.Errorf("LLM.DefaultProvider = %v, want openai (profile from AINATIVE_PROFILE)", cfg.LLM.DefaultProvider)
+	}
+}
+
+func TestLoadFromFile_UnknownProfile(t *testing.T) {
+	configPath := writeProfileTestConfig(t)
+
+	loader := NewLoader(WithActiveProfile("nonexistent"))
+	if _, err := loader.LoadFromFile(configPath); err == nil {
+		t.Error("LoadFromFile() expected error for unknown profile, got nil")
+	}
+}
+
+func TestLoadProfile(t *testing.T) {
+	configPath := writeProfileTestConfig(t)
+
+	loader := NewLoader(
+		WithConfigPaths(filepath.Dir(configPath)),
+		WithConfigName("test-config"),
+	)
+	cfg, err := loader.LoadProfile("work")
+	if err != nil {
+		t.Fatalf("LoadProfile() error = %v", err)
+	}
+
+	if cfg.LLM.DefaultProvider != "openai" {
+		t.Errorf("LLM.DefaultProvider = %v, want openai", cfg.LLM.DefaultProvider)
+	}
+}
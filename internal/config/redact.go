@@ -0,0 +1,101 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+)
+
+// redactedSuffixLen is how many trailing characters of a secret survive
+// redaction, enough to identify which key is configured without exposing it
+const redactedSuffixLen = 4
+
+// RedactedCopy returns a deep copy of the configuration with every
+// secret-bearing field replaced by a redacted placeholder that preserves only
+// the last few characters. Fields are identified by the `redact:"true"` struct
+// tag, so new secret fields are covered automatically once tagged. The
+// original configuration is never modified.
+func (c *Config) RedactedCopy() *Config {
+	if c == nil {
+		return nil
+	}
+
+	copied := &Config{}
+	src := reflect.ValueOf(c).Elem()
+	dst := reflect.ValueOf(copied).Elem()
+	copyRedacted(dst, src, false)
+	return copied
+}
+
+// redactSecret masks a secret value, keeping the last redactedSuffixLen
+// characters when the value is long enough to make that safe
+func redactSecret(value string) string {
+	if value == "" {
+		return ""
+	}
+	if len(value) <= redactedSuffixLen*2 {
+		return "****"
+	}
+	return "****" + value[len(value)-redactedSuffixLen:]
+}
+
+// copyRedacted recursively copies src into dst, redacting string fields that
+// are marked with the redact tag (or contained within a marked field)
+func copyRedacted(dst, src reflect.Value, redact bool) {
+	switch src.Kind() {
+	case reflect.String:
+		if redact {
+			dst.SetString(redactSecret(src.String()))
+		} else {
+			dst.SetString(src.String())
+		}
+
+	case reflect.Ptr:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.New(src.Type().Elem()))
+		copyRedacted(dst.Elem(), src.Elem(), redact)
+
+	case reflect.Struct:
+		for i := 0; i < src.NumField(); i++ {
+			field := src.Type().Field(i)
+			if field.PkgPath != "" {
+				continue // unexported
+			}
+			fieldRedact := redact || strings.EqualFold(field.Tag.Get("redact"), "true")
+			copyRedacted(dst.Field(i), src.Field(i), fieldRedact)
+		}
+
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.MakeMapWithSize(src.Type(), src.Len()))
+		iter := src.MapRange()
+		for iter.Next() {
+			value := reflect.New(src.Type().Elem()).Elem()
+			copyRedacted(value, iter.Value(), redact)
+			dst.SetMapIndex(iter.Key(), value)
+		}
+
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.MakeSlice(src.Type(), src.Len(), src.Len()))
+		for i := 0; i < src.Len(); i++ {
+			copyRedacted(dst.Index(i), src.Index(i), redact)
+		}
+
+	case reflect.Interface:
+		if src.IsNil() {
+			return
+		}
+		// Interface values (e.g. profile maps) are copied as-is; secrets
+		// inside untyped maps cannot be identified by tag
+		dst.Set(src)
+
+	default:
+		dst.Set(src)
+	}
+}
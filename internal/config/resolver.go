@@ -69,15 +69,17 @@ func WithCommandExecution(enabled bool) ResolverOption {
 //
 // Supported formats:
 //   - Direct string: "sk-ant-api-key-123"
+//   - Secret reference: "env://OPENAI_API_KEY" or "file:///path/to/secret"
 //   - Command execution: "$(pass show anthropic)" or "$(security find-generic-password -s anthropic -w)"
 //   - Environment variable: "${OPENAI_API_KEY}" or "$OPENAI_API_KEY"
 //   - File path: "~/secrets/api-key.txt" or "/path/to/key.txt"
 //
 // Resolution priority:
-//   1. Command execution (if matches pattern)
-//   2. Environment variable (if matches pattern)
-//   3. File path (if looks like a path)
-//   4. Direct string (fallback)
+//   1. Secret reference (env:// or file:// scheme)
+//   2. Command execution (if matches pattern)
+//   3. Environment variable (if matches pattern)
+//   4. File path (if looks like a path)
+//   5. Direct string (fallback)
 //
 // Security features:
 //   - Command execution timeout (default 5 seconds)
@@ -93,6 +95,14 @@ func (r *Resolver) Resolve(value string) (string, error) {
 	// Trim whitespace
 	value = strings.TrimSpace(value)
 
+	// Check for explicit secret references: env://VAR or file:///path
+	if matched, result, err := r.resolveSecretRef(value); matched {
+		if err != nil {
+			return "", err
+		}
+		return result, nil
+	}
+
 	// Check for command execution: $(command)
 	if matched, result, err := r.resolveCommand(value); matched {
 		if err != nil {
@@ -121,6 +131,50 @@ func (r *Resolver) Resolve(value string) (string, error) {
 	return value, nil
 }
 
+// resolveSecretRef handles explicit secret reference schemes: env://VAR_NAME
+// reads an environment variable, file:///path/to/secret reads a file. Unlike
+// the bare ${VAR} and path patterns these are unambiguous, so a missing secret
+// is always an error rather than a fallthrough to the direct string.
+func (r *Resolver) resolveSecretRef(value string) (bool, string, error) {
+	switch {
+	case strings.HasPrefix(value, "env://"):
+		varName := strings.TrimPrefix(value, "env://")
+		if varName == "" {
+			return true, "", errors.NewConfigInvalidError(
+				"secret_reference",
+				"env:// reference is missing a variable name",
+			)
+		}
+		result := os.Getenv(varName)
+		if result == "" {
+			return true, "", errors.NewConfigMissingError(
+				fmt.Sprintf("environment variable %s (referenced as %s)", varName, value),
+			)
+		}
+		return true, strings.TrimSpace(result), nil
+
+	case strings.HasPrefix(value, "file://"):
+		path := strings.TrimPrefix(value, "file://")
+		if path == "" {
+			return true, "", errors.NewConfigInvalidError(
+				"secret_reference",
+				"file:// reference is missing a path",
+			)
+		}
+		// Reuse the file path resolution for its size and safety checks
+		matched, result, err := r.resolveFilePath(path)
+		if !matched {
+			return true, "", errors.NewConfigInvalidError(
+				"secret_reference",
+				fmt.Sprintf("file:// reference is not a valid path: %s", path),
+			)
+		}
+		return true, result, err
+	}
+
+	return false, "", nil
+}
+
 // resolveCommand handles command execution pattern: $(command)
 func (r *Resolver) resolveCommand(value string) (bool, string, error) {
 	// Pattern: $(command args...)
@@ -130,6 +130,54 @@ func TestResolver_ResolveEnvVar(t *testing.T) {
 	})
 }
 
+func TestResolver_ResolveSecretRef(t *testing.T) {
+	resolver := NewResolver()
+
+	t.Run("resolves env:// reference", func(t *testing.T) {
+		os.Setenv("TEST_SECRET_REF_KEY", "sk-ant-from-env")
+		defer os.Unsetenv("TEST_SECRET_REF_KEY")
+
+		result, err := resolver.Resolve("env://TEST_SECRET_REF_KEY")
+		require.NoError(t, err)
+		assert.Equal(t, "sk-ant-from-env", result)
+	})
+
+	t.Run("errors on missing env:// variable", func(t *testing.T) {
+		result, err := resolver.Resolve("env://NONEXISTENT_SECRET_REF")
+		assert.Error(t, err)
+		assert.Empty(t, result)
+		assert.Contains(t, err.Error(), "NONEXISTENT_SECRET_REF")
+	})
+
+	t.Run("errors on env:// without a name", func(t *testing.T) {
+		result, err := resolver.Resolve("env://")
+		assert.Error(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("resolves file:// reference", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		secretPath := filepath.Join(tmpDir, "api.key")
+		require.NoError(t, os.WriteFile(secretPath, []byte("sk-ant-from-file\n"), 0600))
+
+		result, err := resolver.Resolve("file://" + secretPath)
+		require.NoError(t, err)
+		assert.Equal(t, "sk-ant-from-file", result)
+	})
+
+	t.Run("errors on missing file:// target", func(t *testing.T) {
+		result, err := resolver.Resolve("file:///nonexistent/secret.key")
+		assert.Error(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("errors on file:// without a path", func(t *testing.T) {
+		result, err := resolver.Resolve("file://")
+		assert.Error(t, err)
+		assert.Empty(t, result)
+	})
+}
+
 func TestResolver_ResolveFilePath(t *testing.T) {
 	resolver := NewResolver()
 
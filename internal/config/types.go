@@ -6,6 +6,10 @@ import (
 
 // Config represents the complete application configuration
 type Config struct {
+	// Schema version of the configuration file, used to migrate older
+	// files forward on load
+	Version int `mapstructure:"version,omitempty" yaml:"version,omitempty"`
+
 	// Application settings
 	App AppConfig `mapstructure:"app" yaml:"app"`
 
@@ -29,6 +33,10 @@ type Config struct {
 
 	// Security settings
 	Security SecurityConfig `mapstructure:"security" yaml:"security"`
+
+	// Named configuration profiles that can be merged over the base config
+	// via WithActiveProfile or the AINATIVE_PROFILE environment variable
+	Profiles map[string]map[string]interface{} `mapstructure:"profiles,omitempty" yaml:"profiles,omitempty"`
 }
 
 // AppConfig contains general application settings
@@ -54,7 +62,7 @@ type LLMConfig struct {
 
 // AnthropicConfig contains Anthropic Claude configuration
 type AnthropicConfig struct {
-	APIKey          string        `mapstructure:"api_key" yaml:"api_key"`
+	APIKey          string        `mapstructure:"api_key" yaml:"api_key" redact:"true"`
 	Model           string        `mapstructure:"model" yaml:"model"`
 	MaxTokens       int           `mapstructure:"max_tokens" yaml:"max_tokens"`
 	Temperature     float64       `mapstructure:"temperature" yaml:"temperature"`
@@ -94,7 +102,7 @@ type RetryConfig struct {
 
 // OpenAIConfig contains OpenAI configuration
 type OpenAIConfig struct {
-	APIKey          string        `mapstructure:"api_key" yaml:"api_key"`
+	APIKey          string        `mapstructure:"api_key" yaml:"api_key" redact:"true"`
 	Model           string        `mapstructure:"model" yaml:"model"`
 	Organization    string        `mapstructure:"organization,omitempty" yaml:"organization,omitempty"`
 	MaxTokens       int           `mapstructure:"max_tokens" yaml:"max_tokens"`
@@ -110,7 +118,7 @@ type OpenAIConfig struct {
 
 // GoogleConfig contains Google (Gemini) configuration
 type GoogleConfig struct {
-	APIKey          string        `mapstructure:"api_key" yaml:"api_key"`
+	APIKey          string        `mapstructure:"api_key" yaml:"api_key" redact:"true"`
 	Model           string        `mapstructure:"model" yaml:"model"`
 	ProjectID       string        `mapstructure:"project_id,omitempty" yaml:"project_id,omitempty"`
 	Location        string        `mapstructure:"location,omitempty" yaml:"location,omitempty"`
@@ -129,9 +137,9 @@ type GoogleConfig struct {
 type BedrockConfig struct {
 	Region          string        `mapstructure:"region" yaml:"region"`
 	Model           string        `mapstructure:"model" yaml:"model"`
-	AccessKeyID     string        `mapstructure:"access_key_id,omitempty" yaml:"access_key_id,omitempty"`
-	SecretAccessKey string        `mapstructure:"secret_access_key,omitempty" yaml:"secret_access_key,omitempty"`
-	SessionToken    string        `mapstructure:"session_token,omitempty" yaml:"session_token,omitempty"`
+	AccessKeyID     string        `mapstructure:"access_key_id,omitempty" yaml:"access_key_id,omitempty" redact:"true"`
+	SecretAccessKey string        `mapstructure:"secret_access_key,omitempty" yaml:"secret_access_key,omitempty" redact:"true"`
+	SessionToken    string        `mapstructure:"session_token,omitempty" yaml:"session_token,omitempty" redact:"true"`
 	Profile         string        `mapstructure:"profile,omitempty" yaml:"profile,omitempty"`
 	MaxTokens       int           `mapstructure:"max_tokens" yaml:"max_tokens"`
 	Temperature     float64       `mapstructure:"temperature" yaml:"temperature"`
@@ -142,7 +150,7 @@ type BedrockConfig struct {
 
 // AzureConfig contains Azure OpenAI configuration
 type AzureConfig struct {
-	APIKey          string        `mapstructure:"api_key" yaml:"api_key"`
+	APIKey          string        `mapstructure:"api_key" yaml:"api_key" redact:"true"`
 	Endpoint        string        `mapstructure:"endpoint" yaml:"endpoint"`
 	DeploymentName  string        `mapstructure:"deployment_name" yaml:"deployment_name"`
 	APIVersion      string        `mapstructure:"api_version" yaml:"api_version"`
@@ -168,7 +176,7 @@ type OllamaConfig struct {
 
 // MetaLlamaConfig contains Meta Llama configuration
 type MetaLlamaConfig struct {
-	APIKey           string        `mapstructure:"api_key" yaml:"api_key"`
+	APIKey           string        `mapstructure:"api_key" yaml:"api_key" redact:"true"`
 	Model            string        `mapstructure:"model" yaml:"model"`
 	MaxTokens        int           `mapstructure:"max_tokens" yaml:"max_tokens"`
 	Temperature      float64       `mapstructure:"temperature" yaml:"temperature"`
@@ -198,11 +206,11 @@ type PlatformConfig struct {
 // AuthConfig contains authentication settings
 type AuthConfig struct {
 	Method       string        `mapstructure:"method" yaml:"method"` // jwt, api_key, oauth2
-	APIKey       string        `mapstructure:"api_key,omitempty" yaml:"api_key,omitempty"`
-	Token        string        `mapstructure:"token,omitempty" yaml:"token,omitempty"`
-	RefreshToken string        `mapstructure:"refresh_token,omitempty" yaml:"refresh_token,omitempty"`
+	APIKey       string        `mapstructure:"api_key,omitempty" yaml:"api_key,omitempty" redact:"true"`
+	Token        string        `mapstructure:"token,omitempty" yaml:"token,omitempty" redact:"true"`
+	RefreshToken string        `mapstructure:"refresh_token,omitempty" yaml:"refresh_token,omitempty" redact:"true"`
 	ClientID     string        `mapstructure:"client_id,omitempty" yaml:"client_id,omitempty"`
-	ClientSecret string        `mapstructure:"client_secret,omitempty" yaml:"client_secret,omitempty"`
+	ClientSecret string        `mapstructure:"client_secret,omitempty" yaml:"client_secret,omitempty" redact:"true"`
 	TokenURL     string        `mapstructure:"token_url,omitempty" yaml:"token_url,omitempty"`
 	Scopes       []string      `mapstructure:"scopes,omitempty" yaml:"scopes,omitempty"`
 	Timeout      time.Duration `mapstructure:"timeout" yaml:"timeout"`
@@ -231,7 +239,7 @@ type ZeroDBConfig struct {
 	Endpoint         string        `mapstructure:"endpoint" yaml:"endpoint"`
 	Database         string        `mapstructure:"database" yaml:"database"`
 	Username         string        `mapstructure:"username,omitempty" yaml:"username,omitempty"`
-	Password         string        `mapstructure:"password,omitempty" yaml:"password,omitempty"`
+	Password         string        `mapstructure:"password,omitempty" yaml:"password,omitempty" redact:"true"`
 	SSL              bool          `mapstructure:"ssl" yaml:"ssl"`
 	SSLMode          string        `mapstructure:"ssl_mode,omitempty" yaml:"ssl_mode,omitempty"`
 	MaxConnections   int           `mapstructure:"max_connections" yaml:"max_connections"`
@@ -246,7 +254,7 @@ type ZeroDBConfig struct {
 type DesignConfig struct {
 	Enabled       bool          `mapstructure:"enabled" yaml:"enabled"`
 	Endpoint      string        `mapstructure:"endpoint" yaml:"endpoint"`
-	APIKey        string        `mapstructure:"api_key,omitempty" yaml:"api_key,omitempty"`
+	APIKey        string        `mapstructure:"api_key,omitempty" yaml:"api_key,omitempty" redact:"true"`
 	Timeout       time.Duration `mapstructure:"timeout" yaml:"timeout"`
 	RetryAttempts int           `mapstructure:"retry_attempts" yaml:"retry_attempts"`
 }
@@ -255,7 +263,7 @@ type DesignConfig struct {
 type StrapiConfig struct {
 	Enabled       bool          `mapstructure:"enabled" yaml:"enabled"`
 	Endpoint      string        `mapstructure:"endpoint" yaml:"endpoint"`
-	APIKey        string        `mapstructure:"api_key,omitempty" yaml:"api_key,omitempty"`
+	APIKey        string        `mapstructure:"api_key,omitempty" yaml:"api_key,omitempty" redact:"true"`
 	Timeout       time.Duration `mapstructure:"timeout" yaml:"timeout"`
 	RetryAttempts int           `mapstructure:"retry_attempts" yaml:"retry_attempts"`
 }
@@ -264,7 +272,7 @@ type StrapiConfig struct {
 type RLHFConfig struct {
 	Enabled       bool          `mapstructure:"enabled" yaml:"enabled"`
 	Endpoint      string        `mapstructure:"endpoint" yaml:"endpoint"`
-	APIKey        string        `mapstructure:"api_key,omitempty" yaml:"api_key,omitempty"`
+	APIKey        string        `mapstructure:"api_key,omitempty" yaml:"api_key,omitempty" redact:"true"`
 	Timeout       time.Duration `mapstructure:"timeout" yaml:"timeout"`
 	RetryAttempts int           `mapstructure:"retry_attempts" yaml:"retry_attempts"`
 	ModelID       string        `mapstructure:"model_id,omitempty" yaml:"model_id,omitempty"`
@@ -396,7 +404,7 @@ type LoggingConfig struct {
 // SecurityConfig contains security settings
 type SecurityConfig struct {
 	EncryptConfig    bool     `mapstructure:"encrypt_config" yaml:"encrypt_config"`
-	EncryptionKey    string   `mapstructure:"encryption_key,omitempty" yaml:"encryption_key,omitempty"`
+	EncryptionKey    string   `mapstructure:"encryption_key,omitempty" yaml:"encryption_key,omitempty" redact:"true"`
 	AllowedOrigins   []string `mapstructure:"allowed_origins" yaml:"allowed_origins"`
 	TLSEnabled       bool     `mapstructure:"tls_enabled" yaml:"tls_enabled"`
 	TLSCertPath      string   `mapstructure:"tls_cert_path,omitempty" yaml:"tls_cert_path,omitempty"`
@@ -0,0 +1,186 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeValidateTestConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "test-config.yaml")
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	return configPath
+}
+
+func issueForField(issues []ValidationIssue, field string) *ValidationIssue {
+	for i := range issues {
+		if issues[i].Field == field {
+			return &issues[i]
+		}
+	}
+	return nil
+}
+
+func TestValidateFile_Valid(t *testing.T) {
+	configPath := writeValidateTestConfig(t, `
+app:
+  name: test-app
+  environment: development
+
+llm:
+  default_provider: anthropic
+  anthropic:
+    api_key: sk-ant-test
+    model: claude-3-5-sonnet-20241022
+
+platform:
+  authentication:
+    method: api_key
+    api_key: test-key
+
+logging:
+  level: info
+  format: json
+  output: stdout
+`)
+
+	loader := NewLoader()
+	issues, err := loader.ValidateFile(configPath)
+	if err != nil {
+		t.Fatalf("ValidateFile() error = %v", err)
+	}
+
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			t.Errorf("unexpected error-level issue: %+v", issue)
+		}
+	}
+}
+
+func TestValidateFile_ReportsAllErrors(t *testing.T) {
+	// Two independent problems: a bad environment and an unknown provider
+	configPath := writeValidateTestConfig(t, `
+app:
+  name: test-app
+  environment: nonsense
+
+llm:
+  default_provider: not-a-provider
+
+platform:
+  authentication:
+    method: api_key
+    api_key: test-key
+
+logging:
+  level: info
+  format: json
+  output: stdout
+`)
+
+	loader := NewLoader()
+	issues, err := loader.ValidateFile(configPath)
+	if err != nil {
+		t.Fatalf("ValidateFile() error = %v", err)
+	}
+
+	envIssue := issueForField(issues, "app.environment")
+	if envIssue == nil {
+		t.Error("expected issue for app.environment")
+	} else if envIssue.Severity != SeverityError {
+		t.Errorf("app.environment severity = %v, want %v", envIssue.Severity, SeverityError)
+	}
+
+	providerIssue := issueForField(issues, "llm.default_provider")
+	if providerIssue == nil {
+		t.Error("expected issue for llm.default_provider")
+	}
+}
+
+func TestValidateFile_PlaceholderAPIKeyWarning(t *testing.T) {
+	configPath := writeValidateTestConfig(t, `
+app:
+  name: test-app
+  environment: development
+
+llm:
+  default_provider: anthropic
+  anthropic:
+    api_key: sk-ant-your-api-key-here
+    model: claude-3-5-sonnet-20241022
+
+platform:
+  authentication:
+    method: api_key
+    api_key: test-key
+
+logging:
+  level: info
+  format: json
+  output: stdout
+`)
+
+	loader := NewLoader()
+	issues, err := loader.ValidateFile(configPath)
+	if err != nil {
+		t.Fatalf("ValidateFile() error = %v", err)
+	}
+
+	keyIssue := issueForField(issues, "llm.anthropic.api_key")
+	if keyIssue == nil {
+		t.Fatal("expected warning for placeholder llm.anthropic.api_key")
+	}
+	if keyIssue.Severity != SeverityWarning {
+		t.Errorf("llm.anthropic.api_key severity = %v, want %v", keyIssue.Severity, SeverityWarning)
+	}
+}
+
+func TestValidateFile_DoesNotMutateLoader(t *testing.T) {
+	configPath := writeValidateTestConfig(t, `
+app:
+  environment: development
+
+llm:
+  default_provider: not-a-provider
+
+platform:
+  authentication:
+    method: api_key
+    api_key: test-key
+
+logging:
+  level: info
+  format: json
+  output: stdout
+`)
+
+	loader := NewLoader()
+	if _, err := loader.ValidateFile(configPath); err != nil {
+		t.Fatalf("ValidateFile() error = %v", err)
+	}
+
+	if used := loader.viper.ConfigFileUsed(); used != "" {
+		t.Errorf("ValidateFile() left config file %q on the loader", used)
+	}
+}
+
+func TestValidateFile_NonExistent(t *testing.T) {
+	loader := NewLoader()
+	if _, err := loader.ValidateFile("/nonexistent/config.yaml"); err == nil {
+		t.Error("ValidateFile() expected error for missing file, got nil")
+	}
+}
+
+func TestValidateFile_InvalidYAML(t *testing.T) {
+	configPath := writeValidateTestConfig(t, "llm: [broken")
+
+	loader := NewLoader()
+	if _, err := loader.ValidateFile(configPath); err == nil {
+		t.Error("ValidateFile() expected error for invalid YAML, got nil")
+	}
+}
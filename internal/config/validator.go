@@ -11,10 +11,30 @@ import (
 	"github.com/AINative-studio/ainative-code/internal/errors"
 )
 
+// Severity classifies how serious a validation issue is
+type Severity string
+
+const (
+	// SeverityError marks issues that make the configuration unusable
+	SeverityError Severity = "error"
+
+	// SeverityWarning marks issues that are suspicious but not fatal
+	SeverityWarning Severity = "warning"
+)
+
+// ValidationIssue describes a single problem found during validation in a
+// structured form suitable for rendering or machine parsing
+type ValidationIssue struct {
+	Field    string   `json:"field"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
 // Validator provides configuration validation functionality
 type Validator struct {
 	config *Config
 	errs   []error
+	issues []ValidationIssue
 }
 
 // NewValidator creates a new configuration validator
@@ -22,6 +42,7 @@ func NewValidator(cfg *Config) *Validator {
 	return &Validator{
 		config: cfg,
 		errs:   make([]error, 0),
+		issues: make([]ValidationIssue, 0),
 	}
 }
 
@@ -43,6 +64,59 @@ func (v *Validator) Validate() error {
 	return nil
 }
 
+// Issues returns every issue collected during validation, including
+// warning-level checks that do not cause Validate to fail
+func (v *Validator) Issues() []ValidationIssue {
+	return v.issues
+}
+
+// checkWarnings runs non-fatal checks that only produce warning-level issues
+func (v *Validator) checkWarnings() {
+	type keyed struct {
+		field string
+		key   string
+	}
+	var keys []keyed
+
+	if v.config.LLM.Anthropic != nil {
+		keys = append(keys, keyed{"llm.anthropic.api_key", v.config.LLM.Anthropic.APIKey})
+	}
+	if v.config.LLM.OpenAI != nil {
+		keys = append(keys, keyed{"llm.openai.api_key", v.config.LLM.OpenAI.APIKey})
+	}
+	if v.config.LLM.Google != nil {
+		keys = append(keys, keyed{"llm.google.api_key", v.config.LLM.Google.APIKey})
+	}
+	if v.config.LLM.Azure != nil {
+		keys = append(keys, keyed{"llm.azure.api_key", v.config.LLM.Azure.APIKey})
+	}
+	keys = append(keys, keyed{"platform.authentication.api_key", v.config.Platform.Authentication.APIKey})
+
+	for _, k := range keys {
+		if k.key != "" && looksLikePlaceholder(k.key) {
+			v.addWarning(k.field, "API key looks like a placeholder value")
+		}
+	}
+}
+
+// looksLikePlaceholder reports whether an API key value looks like an
+// unfilled template rather than a real credential
+func looksLikePlaceholder(key string) bool {
+	lower := strings.ToLower(key)
+	if strings.HasPrefix(key, "<") || strings.HasSuffix(key, ">") {
+		return true
+	}
+	if strings.Contains(key, "${") || strings.HasSuffix(key, "...") {
+		return true
+	}
+	for _, marker := range []string{"your-api-key", "your_api_key", "changeme", "change-me", "placeholder", "replace-me", "xxxx", "todo"} {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
+
 // validateApp validates application configuration
 func (v *Validator) validateApp() {
 	if v.config.App.Name == "" {
@@ -431,7 +505,11 @@ func (v *Validator) validateFallback() {
 	for _, provider := range cfg.Providers {
 		if !v.isValidEnum(provider, validProviders) {
 			v.addError("llm.fallback.providers", fmt.Sprintf("invalid provider '%s', must be one of: %s", provider, strings.Join(validProviders, ", ")))
+			continue
 		}
+		// A fallback entry without credentials fails silently at runtime,
+		// so require each listed provider to actually be configured
+		v.validateFallbackProvider(provider)
 	}
 
 	if cfg.MaxRetries < 0 {
@@ -443,6 +521,49 @@ func (v *Validator) validateFallback() {
 	}
 }
 
+// validateFallbackProvider checks that a fallback entry refers to a provider
+// section with usable credentials (or, for Ollama, a usable URL)
+func (v *Validator) validateFallbackProvider(provider string) {
+	llm := &v.config.LLM
+
+	switch provider {
+	case "anthropic":
+		if llm.Anthropic == nil || llm.Anthropic.APIKey == "" {
+			v.addError("llm.fallback.providers", "fallback provider 'anthropic' has no API key configured")
+		}
+	case "openai":
+		if llm.OpenAI == nil || llm.OpenAI.APIKey == "" {
+			v.addError("llm.fallback.providers", "fallback provider 'openai' has no API key configured")
+		}
+	case "google":
+		if llm.Google == nil || llm.Google.APIKey == "" {
+			v.addError("llm.fallback.providers", "fallback provider 'google' has no API key configured")
+		}
+	case "bedrock":
+		hasCredentials := llm.Bedrock != nil && llm.Bedrock.AccessKeyID != "" && llm.Bedrock.SecretAccessKey != ""
+		hasProfile := llm.Bedrock != nil && llm.Bedrock.Profile != ""
+		if !hasCredentials && !hasProfile {
+			v.addError("llm.fallback.providers", "fallback provider 'bedrock' has no credentials or profile configured")
+		}
+	case "azure":
+		if llm.Azure == nil || llm.Azure.APIKey == "" || llm.Azure.Endpoint == "" {
+			v.addError("llm.fallback.providers", "fallback provider 'azure' needs api_key and endpoint configured")
+		}
+	case "ollama":
+		// Ollama is keyless; it just needs a section with a usable URL
+		// (an empty base_url falls back to the localhost default)
+		if llm.Ollama == nil {
+			v.addError("llm.fallback.providers", "fallback provider 'ollama' is not configured")
+		} else if llm.Ollama.BaseURL != "" && !v.isValidURL(llm.Ollama.BaseURL) {
+			v.addError("llm.fallback.providers", "fallback provider 'ollama' base_url must be a valid URL")
+		}
+	case "meta_llama", "meta":
+		if llm.MetaLlama == nil || llm.MetaLlama.APIKey == "" {
+			v.addError("llm.fallback.providers", "fallback provider 'meta_llama' has no API key configured")
+		}
+	}
+}
+
 // validatePlatform validates platform configuration
 func (v *Validator) validatePlatform() {
 	v.validateAuthentication()
@@ -896,6 +1017,11 @@ func (v *Validator) validateSecurity() {
 
 func (v *Validator) addError(key, message string) {
 	v.errs = append(v.errs, errors.NewConfigValidationError(key, message))
+	v.issues = append(v.issues, ValidationIssue{Field: key, Severity: SeverityError, Message: message})
+}
+
+func (v *Validator) addWarning(key, message string) {
+	v.issues = append(v.issues, ValidationIssue{Field: key, Severity: SeverityWarning, Message: message})
 }
 
 func (v *Validator) isValidEnum(value string, validValues []string) bool {
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"strings"
 	"testing"
 	"time"
 )
@@ -397,7 +398,10 @@ func TestValidateFallback(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := &Config{
 				LLM: LLMConfig{
-					Fallback: tt.config,
+					// Fallback entries must be configured to be valid
+					Anthropic: &AnthropicConfig{APIKey: "sk-ant-test"},
+					OpenAI:    &OpenAIConfig{APIKey: "sk-test"},
+					Fallback:  tt.config,
 				},
 			}
 			validator := NewValidator(cfg)
@@ -411,6 +415,99 @@ func TestValidateFallback(t *testing.T) {
 	}
 }
 
+func TestValidateFallbackUnconfiguredProviders(t *testing.T) {
+	tests := []struct {
+		name    string
+		llm     LLMConfig
+		wantErr bool
+		errPart string
+	}{
+		{
+			name: "one configured and one unconfigured provider",
+			llm: LLMConfig{
+				Anthropic: &AnthropicConfig{APIKey: "sk-ant-test"},
+				Fallback: &FallbackConfig{
+					Enabled:   true,
+					Providers: []string{"anthropic", "google"},
+				},
+			},
+			wantErr: true,
+			errPart: "fallback provider 'google'",
+		},
+		{
+			name: "provider section present but key missing",
+			llm: LLMConfig{
+				OpenAI: &OpenAIConfig{Model: "gpt-4-turbo-preview"},
+				Fallback: &FallbackConfig{
+					Enabled:   true,
+					Providers: []string{"openai"},
+				},
+			},
+			wantErr: true,
+			errPart: "fallback provider 'openai'",
+		},
+		{
+			name: "ollama with valid url is keyless",
+			llm: LLMConfig{
+				Ollama: &OllamaConfig{BaseURL: "http://localhost:11434"},
+				Fallback: &FallbackConfig{
+					Enabled:   true,
+					Providers: []string{"ollama"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ollama with invalid url",
+			llm: LLMConfig{
+				Ollama: &OllamaConfig{BaseURL: "not-a-url"},
+				Fallback: &FallbackConfig{
+					Enabled:   true,
+					Providers: []string{"ollama"},
+				},
+			},
+			wantErr: true,
+			errPart: "fallback provider 'ollama'",
+		},
+		{
+			name: "bedrock with profile",
+			llm: LLMConfig{
+				Bedrock: &BedrockConfig{Region: "us-east-1", Profile: "default"},
+				Fallback: &FallbackConfig{
+					Enabled:   true,
+					Providers: []string{"bedrock"},
+				},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{LLM: tt.llm}
+			validator := NewValidator(cfg)
+			validator.validateFallback()
+
+			hasErrors := len(validator.errs) > 0
+			if hasErrors != tt.wantErr {
+				t.Fatalf("validateFallback() error = %v, wantErr %v", hasErrors, tt.wantErr)
+			}
+
+			if tt.wantErr && tt.errPart != "" {
+				found := false
+				for _, err := range validator.errs {
+					if strings.Contains(err.Error(), tt.errPart) {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("expected an error naming %q, got %v", tt.errPart, validator.errs)
+				}
+			}
+		})
+	}
+}
+
 func TestValidateCache(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/AINative-studio/ainative-code/internal/errors"
+	"github.com/AINative-studio/ainative-code/internal/logger"
+	"github.com/fsnotify/fsnotify"
+)
+
+// Watch watches the resolved configuration file and invokes onChange with a
+// freshly loaded and validated config whenever the file is rewritten. Reloads
+// that fail to parse or validate are logged and dropped so the running process
+// keeps its last good configuration. Watch blocks until the context is
+// cancelled, so callers normally run it in a goroutine.
+func (l *Loader) Watch(ctx context.Context, onChange func(*Config)) error {
+	configFile := l.viper.ConfigFileUsed()
+	if configFile == "" {
+		return errors.NewConfigParseError("watch", fmt.Errorf("no configuration file loaded"))
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.NewConfigParseError(configFile, fmt.Errorf("failed to create watcher: %w", err))
+	}
+	defer watcher.Close()
+
+	// Watch the directory rather than the file itself: editors and atomic
+	// writers replace the file, which would otherwise drop the watch
+	configDir := filepath.Dir(configFile)
+	if err := watcher.Add(configDir); err != nil {
+		return errors.NewConfigParseError(configFile, fmt.Errorf("failed to watch directory: %w", err))
+	}
+
+	cleanPath := filepath.Clean(configFile)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if filepath.Clean(event.Name) != cleanPath {
+				continue
+			}
+			if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) {
+				continue
+			}
+
+			cfg, err := l.reload(configFile)
+			if err != nil {
+				logger.WarnEvent().
+					Str("file", configFile).
+					Err(err).
+					Msg("Config reload failed, keeping previous configuration")
+				continue
+			}
+
+			onChange(cfg)
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			logger.WarnEvent().
+				Str("file", configFile).
+				Err(err).
+				Msg("Config watcher error")
+		}
+	}
+}
+
+// reload loads the config file through a fresh loader so a broken file never
+// pollutes the watching loader's viper state
+func (l *Loader) reload(configFile string) (*Config, error) {
+	fresh := NewLoader(
+		WithConfigName(l.configName),
+		WithConfigType(l.configType),
+		WithEnvPrefix(l.envPrefix),
+		WithResolver(l.resolver),
+		WithActiveProfile(l.activeProfile),
+	)
+	return fresh.LoadFromFile(configFile)
+}
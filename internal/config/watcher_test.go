@@ -0,0 +1,122 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeWatcherConfig(t *testing.T, path, provider string) {
+	t.Helper()
+
+	configContent := `
+app:
+  name: test-app
+  environment: development
+
+llm:
+  default_provider: ` + provider + `
+  anthropic:
+    api_key: sk-ant-test
+    model: claude-3-5-sonnet-20241022
+  openai:
+    api_key: sk-openai-test
+    model: gpt-4-turbo-preview
+
+platform:
+  authentication:
+    method: api_key
+    api_key: test-key
+
+logging:
+  level: info
+  format: json
+  output: stdout
+`
+
+	if err := os.WriteFile(path, []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "test-config.yaml")
+	writeWatcherConfig(t, configPath, "anthropic")
+
+	loader := NewLoader()
+	if _, err := loader.LoadFromFile(configPath); err != nil {
+		t.Fatalf("LoadFromFile() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reloads := make(chan *Config, 4)
+	done := make(chan error, 1)
+	go func() {
+		done <- loader.Watch(ctx, func(cfg *Config) {
+			reloads <- cfg
+		})
+	}()
+
+	// Give the watcher time to register before the first write
+	time.Sleep(100 * time.Millisecond)
+
+	// A valid rewrite triggers the callback with the new config
+	writeWatcherConfig(t, configPath, "openai")
+
+	select {
+	case cfg := <-reloads:
+		if cfg.LLM.DefaultProvider != "openai" {
+			t.Errorf("expected reloaded default_provider 'openai', got %q", cfg.LLM.DefaultProvider)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for config reload")
+	}
+
+	// An invalid rewrite is dropped without invoking the callback
+	if err := os.WriteFile(configPath, []byte("llm: [broken"), 0644); err != nil {
+		t.Fatalf("Failed to write invalid config: %v", err)
+	}
+
+	select {
+	case cfg := <-reloads:
+		t.Errorf("unexpected reload for invalid config: %+v", cfg)
+	case <-time.After(500 * time.Millisecond):
+		// Expected: invalid config is ignored
+	}
+
+	// Recovery: the next valid write comes through again
+	writeWatcherConfig(t, configPath, "anthropic")
+
+	select {
+	case cfg := <-reloads:
+		if cfg.LLM.DefaultProvider != "anthropic" {
+			t.Errorf("expected reloaded default_provider 'anthropic', got %q", cfg.LLM.DefaultProvider)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for config reload after recovery")
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != context.Canceled {
+			t.Errorf("Watch() error = %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Watch() did not return after context cancellation")
+	}
+}
+
+func TestWatch_NoConfigFile(t *testing.T) {
+	loader := NewLoader()
+
+	err := loader.Watch(context.Background(), func(*Config) {})
+	if err == nil {
+		t.Error("Watch() expected error when no config file is loaded, got nil")
+	}
+}
@@ -0,0 +1,225 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+
+	"github.com/AINative-studio/ainative-code/internal/errors"
+)
+
+const (
+	// DefaultBackupRetention is the number of pre-migration backups kept
+	// when ConnectionConfig.BackupRetention is not set
+	DefaultBackupRetention = 5
+
+	// backupSuffix is appended to backup file names
+	backupSuffix = ".bak"
+
+	// backupTimestampFormat names backup files so they sort chronologically
+	backupTimestampFormat = "20060102-150405"
+)
+
+// Backup takes an online snapshot of the live database into destPath using
+// SQLite's backup API. The snapshot is transactionally consistent even while
+// other connections are writing.
+func (d *DB) Backup(ctx context.Context, destPath string) error {
+	return backupDatabase(ctx, d.db, destPath)
+}
+
+// Restore replaces the contents of the live database with the backup at
+// srcPath using SQLite's backup API in reverse
+func (d *DB) Restore(srcPath string) error {
+	return restoreDatabase(context.Background(), d.db, srcPath)
+}
+
+// backupDatabase copies the main database of db into destPath
+func backupDatabase(ctx context.Context, db *sql.DB, destPath string) error {
+	if db == nil {
+		return errors.NewDatabaseError(errors.ErrCodeDBConnection, "database connection is nil")
+	}
+	if destPath == "" {
+		return errors.NewDatabaseError(errors.ErrCodeDBConnection, "backup destination path is empty")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(destPath), 0755); err != nil {
+		return errors.NewDBConnectionError(destPath, err)
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return errors.NewDBConnectionError(destPath, err)
+	}
+	defer conn.Close()
+
+	err = conn.Raw(func(driverConn interface{}) error {
+		srcConn, ok := driverConn.(*sqlite3.SQLiteConn)
+		if !ok {
+			return fmt.Errorf("connection is not a SQLite connection")
+		}
+		return withSQLiteConn(destPath, func(destConn *sqlite3.SQLiteConn) error {
+			return copyDatabase(destConn, srcConn)
+		})
+	})
+	if err != nil {
+		return errors.NewDatabaseError(errors.ErrCodeDBConnection, fmt.Sprintf("backup to %s failed: %v", destPath, err))
+	}
+
+	return nil
+}
+
+// restoreDatabase loads the backup at srcPath into the main database of db
+func restoreDatabase(ctx context.Context, db *sql.DB, srcPath string) error {
+	if db == nil {
+		return errors.NewDatabaseError(errors.ErrCodeDBConnection, "database connection is nil")
+	}
+	if _, err := os.Stat(srcPath); err != nil {
+		return errors.NewDBConnectionError(srcPath, err)
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return errors.NewDBConnectionError(srcPath, err)
+	}
+	defer conn.Close()
+
+	err = conn.Raw(func(driverConn interface{}) error {
+		destConn, ok := driverConn.(*sqlite3.SQLiteConn)
+		if !ok {
+			return fmt.Errorf("connection is not a SQLite connection")
+		}
+		return withSQLiteConn(srcPath, func(srcConn *sqlite3.SQLiteConn) error {
+			return copyDatabase(destConn, srcConn)
+		})
+	})
+	if err != nil {
+		return errors.NewDatabaseError(errors.ErrCodeDBConnection, fmt.Sprintf("restore from %s failed: %v", srcPath, err))
+	}
+
+	return nil
+}
+
+// withSQLiteConn opens a raw SQLite connection to path for the duration of fn
+func withSQLiteConn(path string, fn func(*sqlite3.SQLiteConn) error) error {
+	driverConn, err := (&sqlite3.SQLiteDriver{}).Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer driverConn.Close()
+
+	conn, ok := driverConn.(*sqlite3.SQLiteConn)
+	if !ok {
+		return fmt.Errorf("connection is not a SQLite connection")
+	}
+
+	return fn(conn)
+}
+
+// copyDatabase runs the online backup API from srcConn into destConn
+func copyDatabase(destConn, srcConn *sqlite3.SQLiteConn) error {
+	backup, err := destConn.Backup("main", srcConn, "main")
+	if err != nil {
+		return fmt.Errorf("failed to start backup: %w", err)
+	}
+	defer backup.Finish()
+
+	for {
+		done, err := backup.Step(-1)
+		if err != nil {
+			return fmt.Errorf("backup step failed: %w", err)
+		}
+		if done {
+			break
+		}
+	}
+
+	return nil
+}
+
+// backupPath returns the timestamped backup file name for a database path
+func backupPath(dbPath string, now time.Time) string {
+	return fmt.Sprintf("%s.%s%s", dbPath, now.UTC().Format(backupTimestampFormat), backupSuffix)
+}
+
+// backupBeforeMigration snapshots the database when migrations are pending
+// so a bad migration cannot destroy session history. In-memory databases and
+// databases with no pending migrations are left alone.
+func backupBeforeMigration(ctx context.Context, db *sql.DB, config *ConnectionConfig) error {
+	if config == nil || config.Path == ":memory:" {
+		return nil
+	}
+
+	status, err := GetStatusContext(ctx, db)
+	if err != nil {
+		return err
+	}
+	if len(status.Pending) == 0 {
+		return nil
+	}
+
+	// A fresh database has nothing worth snapshotting
+	if info, err := os.Stat(config.Path); err != nil || info.Size() == 0 {
+		return nil
+	}
+
+	if err := backupDatabase(ctx, db, backupPath(config.Path, time.Now())); err != nil {
+		return err
+	}
+
+	retention := config.BackupRetention
+	if retention <= 0 {
+		retention = DefaultBackupRetention
+	}
+
+	return pruneBackups(config.Path, retention)
+}
+
+// pruneBackups removes the oldest pre-migration backups beyond keep
+func pruneBackups(dbPath string, keep int) error {
+	backups, err := listBackups(dbPath)
+	if err != nil {
+		return err
+	}
+
+	if len(backups) <= keep {
+		return nil
+	}
+
+	for _, stale := range backups[:len(backups)-keep] {
+		if err := os.Remove(stale); err != nil {
+			return fmt.Errorf("failed to prune backup %s: %w", stale, err)
+		}
+	}
+
+	return nil
+}
+
+// listBackups returns the backup files for a database path, oldest first
+func listBackups(dbPath string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Dir(dbPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup directory: %w", err)
+	}
+
+	prefix := filepath.Base(dbPath) + "."
+	var backups []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, backupSuffix) {
+			continue
+		}
+		backups = append(backups, filepath.Join(filepath.Dir(dbPath), name))
+	}
+
+	// Timestamped names sort chronologically
+	sort.Strings(backups)
+
+	return backups, nil
+}
@@ -0,0 +1,215 @@
+package database
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newFileDB connects a file-backed database in a temp directory
+func newFileDB(t *testing.T) (*DB, string) {
+	t.Helper()
+
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	sqlDB, err := Connect(DefaultConfig(dbPath))
+	if err != nil {
+		t.Fatalf("failed to connect to database: %v", err)
+	}
+
+	db := NewDB(sqlDB)
+	t.Cleanup(func() { db.Close() })
+
+	return db, dbPath
+}
+
+func TestBackup(t *testing.T) {
+	db, dbPath := newFileDB(t)
+
+	// Write a row so the backup has recognizable content
+	if _, err := db.DB().Exec("CREATE TABLE backup_probe (id INTEGER PRIMARY KEY, note TEXT)"); err != nil {
+		t.Fatalf("failed to create probe table: %v", err)
+	}
+	if _, err := db.DB().Exec("INSERT INTO backup_probe (note) VALUES ('before backup')"); err != nil {
+		t.Fatalf("failed to insert probe row: %v", err)
+	}
+
+	backupFile := filepath.Join(filepath.Dir(dbPath), "snapshot.bak")
+	if err := db.Backup(context.Background(), backupFile); err != nil {
+		t.Fatalf("backup failed: %v", err)
+	}
+
+	// The backup must open as a standalone database with the same content
+	restoredDB, err := Connect(DefaultConfig(backupFile))
+	if err != nil {
+		t.Fatalf("failed to open backup: %v", err)
+	}
+	restored := NewDB(restoredDB)
+	defer restored.Close()
+
+	var note string
+	if err := restored.DB().QueryRow("SELECT note FROM backup_probe").Scan(&note); err != nil {
+		t.Fatalf("failed to read backup content: %v", err)
+	}
+	if note != "before backup" {
+		t.Errorf("expected backup to contain probe row, got %q", note)
+	}
+}
+
+func TestBackupEmptyDestination(t *testing.T) {
+	db, _ := newFileDB(t)
+
+	if err := db.Backup(context.Background(), ""); err == nil {
+		t.Error("expected error for empty destination path")
+	}
+}
+
+func TestRestore(t *testing.T) {
+	db, dbPath := newFileDB(t)
+
+	if _, err := db.DB().Exec("CREATE TABLE backup_probe (id INTEGER PRIMARY KEY, note TEXT)"); err != nil {
+		t.Fatalf("failed to create probe table: %v", err)
+	}
+	if _, err := db.DB().Exec("INSERT INTO backup_probe (note) VALUES ('precious')"); err != nil {
+		t.Fatalf("failed to insert probe row: %v", err)
+	}
+
+	backupFile := filepath.Join(filepath.Dir(dbPath), "snapshot.bak")
+	if err := db.Backup(context.Background(), backupFile); err != nil {
+		t.Fatalf("backup failed: %v", err)
+	}
+
+	// Simulate a bad change after the snapshot
+	if _, err := db.DB().Exec("DROP TABLE backup_probe"); err != nil {
+		t.Fatalf("failed to drop probe table: %v", err)
+	}
+
+	if err := db.Restore(backupFile); err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+
+	var note string
+	if err := db.DB().QueryRow("SELECT note FROM backup_probe").Scan(&note); err != nil {
+		t.Fatalf("failed to read restored content: %v", err)
+	}
+	if note != "precious" {
+		t.Errorf("expected restored row, got %q", note)
+	}
+}
+
+func TestRestoreMissingFile(t *testing.T) {
+	db, dbPath := newFileDB(t)
+
+	if err := db.Restore(filepath.Join(filepath.Dir(dbPath), "missing.bak")); err == nil {
+		t.Error("expected error for missing backup file")
+	}
+}
+
+func TestBackupBeforeMigration(t *testing.T) {
+	db, dbPath := newFileDB(t)
+	config := DefaultConfig(dbPath)
+	ctx := context.Background()
+
+	// Give the database content worth protecting and flush it to disk
+	if _, err := db.DB().Exec("CREATE TABLE backup_probe (id INTEGER PRIMARY KEY)"); err != nil {
+		t.Fatalf("failed to create probe table: %v", err)
+	}
+	if _, err := db.DB().Exec("PRAGMA wal_checkpoint(TRUNCATE)"); err != nil {
+		t.Fatalf("failed to checkpoint: %v", err)
+	}
+
+	// All embedded migrations are pending, so a snapshot is taken
+	if err := backupBeforeMigration(ctx, db.DB(), config); err != nil {
+		t.Fatalf("backup before migration failed: %v", err)
+	}
+
+	backups, err := listBackups(dbPath)
+	if err != nil {
+		t.Fatalf("failed to list backups: %v", err)
+	}
+	if len(backups) != 1 {
+		t.Fatalf("expected one pre-migration backup, got %d", len(backups))
+	}
+
+	// Mark every migration applied: no pending work means no new snapshot
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	for _, m := range migrations {
+		if _, err := db.DB().Exec(
+			"INSERT INTO schema_migrations (version, name, description) VALUES (?, ?, ?)",
+			m.Version, m.Name, m.Description,
+		); err != nil {
+			t.Fatalf("failed to record migration: %v", err)
+		}
+	}
+
+	if err := backupBeforeMigration(ctx, db.DB(), config); err != nil {
+		t.Fatalf("backup before migration failed: %v", err)
+	}
+
+	backups, err = listBackups(dbPath)
+	if err != nil {
+		t.Fatalf("failed to list backups: %v", err)
+	}
+	if len(backups) != 1 {
+		t.Errorf("expected no additional backup when nothing is pending, got %d", len(backups))
+	}
+}
+
+func TestBackupBeforeMigrationInMemory(t *testing.T) {
+	config := DefaultConfig(":memory:")
+	sqlDB, err := Connect(config)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer sqlDB.Close()
+
+	// In-memory databases are never snapshotted
+	if err := backupBeforeMigration(context.Background(), sqlDB, config); err != nil {
+		t.Fatalf("expected in-memory database to be skipped: %v", err)
+	}
+}
+
+func TestPruneBackups(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+
+	// Create backup files with ascending timestamps
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var paths []string
+	for i := 0; i < 5; i++ {
+		path := backupPath(dbPath, base.Add(time.Duration(i)*time.Minute))
+		if err := os.WriteFile(path, []byte("backup"), 0644); err != nil {
+			t.Fatalf("failed to write backup file: %v", err)
+		}
+		paths = append(paths, path)
+	}
+
+	if err := pruneBackups(dbPath, 2); err != nil {
+		t.Fatalf("prune failed: %v", err)
+	}
+
+	remaining, err := listBackups(dbPath)
+	if err != nil {
+		t.Fatalf("failed to list backups: %v", err)
+	}
+	if len(remaining) != 2 {
+		t.Fatalf("expected 2 backups after pruning, got %d", len(remaining))
+	}
+
+	// The newest backups survive
+	if remaining[0] != paths[3] || remaining[1] != paths[4] {
+		t.Errorf("expected newest backups to remain, got %v", remaining)
+	}
+}
+
+func TestBackupPath(t *testing.T) {
+	timestamp := time.Date(2026, 8, 30, 10, 15, 0, 0, time.UTC)
+	got := backupPath("/tmp/session.db", timestamp)
+	want := "/tmp/session.db.20260830-101500.bak"
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
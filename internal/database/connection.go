@@ -12,7 +12,12 @@ import (
 	"github.com/AINative-studio/ainative-code/internal/errors"
 )
 
-// ConnectionConfig holds database connection configuration
+// ConnectionConfig holds database connection configuration.
+//
+// The defaults from DefaultConfig are tuned for concurrent access from the
+// TUI plus background refreshes: WAL journal mode lets readers proceed while
+// a writer is active, and BusyTimeout makes writers wait for the lock
+// instead of failing immediately with "database is locked".
 type ConnectionConfig struct {
 	Path            string        // Database file path
 	MaxOpenConns    int           // Maximum number of open connections
@@ -22,9 +27,13 @@ type ConnectionConfig struct {
 	BusyTimeout     int           // SQLite busy timeout in milliseconds
 	JournalMode     string        // SQLite journal mode (WAL, DELETE, etc.)
 	Synchronous     string        // SQLite synchronous mode (NORMAL, FULL, OFF)
+	BackupRetention int           // Number of pre-migration backups to keep (0 uses DefaultBackupRetention)
 }
 
-// DefaultConfig returns a default database configuration
+// DefaultConfig returns a default database configuration. WAL journal mode
+// is the default because it allows concurrent readers during writes; set
+// JournalMode to DELETE only for databases on filesystems without mmap
+// support (e.g. some network mounts).
 func DefaultConfig(dbPath string) *ConnectionConfig {
 	return &ConnectionConfig{
 		Path:            dbPath,
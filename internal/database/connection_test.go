@@ -3,6 +3,8 @@ package database
 import (
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -202,6 +204,61 @@ func TestClose_NilDB(t *testing.T) {
 	}
 }
 
+func TestConcurrentWriters_NoLockErrors(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "concurrent.db")
+	db, err := Connect(DefaultConfig(dbPath))
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec("CREATE TABLE writes (id INTEGER PRIMARY KEY, worker INTEGER, seq INTEGER)"); err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	// WAL mode plus the busy timeout must absorb lock contention from
+	// concurrent writers without surfacing "database is locked"
+	const (
+		workers         = 8
+		writesPerWorker = 50
+	)
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, workers)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < writesPerWorker; i++ {
+				if _, err := db.Exec("INSERT INTO writes (worker, seq) VALUES (?, ?)", worker, i); err != nil {
+					errCh <- err
+					return
+				}
+			}
+		}(w)
+	}
+
+	wg.Wait()
+	close(errCh)
+
+	for err := range errCh {
+		if strings.Contains(err.Error(), "database is locked") {
+			t.Errorf("writer hit lock contention: %v", err)
+		} else {
+			t.Errorf("writer failed: %v", err)
+		}
+	}
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM writes").Scan(&count); err != nil {
+		t.Fatalf("failed to count writes: %v", err)
+	}
+	if count != workers*writesPerWorker {
+		t.Errorf("expected %d writes, got %d", workers*writesPerWorker, count)
+	}
+}
+
 // Helper function to check if DSN contains a parameter
 func containsParam(dsn, param string) bool {
 	return contains(dsn, param)
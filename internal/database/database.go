@@ -48,6 +48,12 @@ func InitializeContext(ctx context.Context, config *ConnectionConfig) (*DB, erro
 		return nil, err
 	}
 
+	// Snapshot the database before touching the schema
+	if err := backupBeforeMigration(ctx, db, config); err != nil {
+		db.Close()
+		return nil, err
+	}
+
 	// Run migrations
 	if err := MigrateContext(ctx, db); err != nil {
 		db.Close()
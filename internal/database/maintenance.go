@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/mattn/go-sqlite3"
+
+	"github.com/AINative-studio/ainative-code/internal/errors"
+)
+
+// StorageStats reports the physical state of the SQLite database file
+type StorageStats struct {
+	PageCount int64 // Total pages in the database file
+	FreePages int64 // Pages on the freelist (reclaimable by VACUUM)
+	PageSize  int64 // Page size in bytes
+	FileSize  int64 // Database file size in bytes (0 for in-memory databases)
+}
+
+// Optimize compacts the database after heavy deletes. It runs PRAGMA
+// optimize, rebuilds the FTS5 message index, and finishes with VACUUM to
+// return free pages to the filesystem.
+//
+// VACUUM requires exclusive access: it cannot run inside a transaction and
+// will block (or fail with SQLITE_BUSY) while other connections hold locks.
+// Call it from maintenance paths only, not during an active chat session.
+func (d *DB) Optimize(ctx context.Context) error {
+	if d.db == nil {
+		return errors.NewDatabaseError(errors.ErrCodeDBConnection, "database connection is nil")
+	}
+
+	// Pin a single connection so every statement, including VACUUM, runs
+	// outside any transaction on the same connection
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return errors.NewDBConnectionError("optimize", err)
+	}
+	defer conn.Close()
+
+	if err := ensureAutoCommit(conn); err != nil {
+		return err
+	}
+
+	if _, err := conn.ExecContext(ctx, "PRAGMA optimize"); err != nil {
+		return errors.NewDBQueryError("optimize", "pragma", err)
+	}
+
+	// Rebuild the FTS5 index if the search migration has been applied
+	var ftsExists int
+	err = conn.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'messages_fts'",
+	).Scan(&ftsExists)
+	if err != nil {
+		return errors.NewDBQueryError("optimize", "sqlite_master", err)
+	}
+	if ftsExists > 0 {
+		if _, err := conn.ExecContext(ctx, "INSERT INTO messages_fts(messages_fts) VALUES ('rebuild')"); err != nil {
+			return errors.NewDBQueryError("rebuild FTS index", "messages_fts", err)
+		}
+	}
+
+	if _, err := conn.ExecContext(ctx, "VACUUM"); err != nil {
+		return errors.NewDBQueryError("vacuum", "main", err)
+	}
+
+	return nil
+}
+
+// StorageStats returns page counts and the on-disk size of the database.
+// For connection pool statistics, use Stats instead.
+func (d *DB) StorageStats(ctx context.Context) (*StorageStats, error) {
+	if d.db == nil {
+		return nil, errors.NewDatabaseError(errors.ErrCodeDBConnection, "database connection is nil")
+	}
+
+	stats := &StorageStats{}
+
+	if err := d.db.QueryRowContext(ctx, "PRAGMA page_count").Scan(&stats.PageCount); err != nil {
+		return nil, errors.NewDBQueryError("storage stats", "page_count", err)
+	}
+	if err := d.db.QueryRowContext(ctx, "PRAGMA freelist_count").Scan(&stats.FreePages); err != nil {
+		return nil, errors.NewDBQueryError("storage stats", "freelist_count", err)
+	}
+	if err := d.db.QueryRowContext(ctx, "PRAGMA page_size").Scan(&stats.PageSize); err != nil {
+		return nil, errors.NewDBQueryError("storage stats", "page_size", err)
+	}
+
+	// File size comes from the filesystem; in-memory databases report 0
+	var path string
+	if err := d.db.QueryRowContext(ctx, "SELECT file FROM pragma_database_list WHERE name = 'main'").Scan(&path); err == nil && path != "" {
+		if info, err := os.Stat(path); err == nil {
+			stats.FileSize = info.Size()
+		}
+	}
+
+	return stats, nil
+}
+
+// ensureAutoCommit fails if the connection has an open transaction, which
+// would make VACUUM error out halfway through maintenance
+func ensureAutoCommit(conn *sql.Conn) error {
+	return conn.Raw(func(driverConn interface{}) error {
+		sqliteConn, ok := driverConn.(*sqlite3.SQLiteConn)
+		if !ok {
+			return fmt.Errorf("connection is not a SQLite connection")
+		}
+		if !sqliteConn.AutoCommit() {
+			return errors.NewDatabaseError(
+				errors.ErrCodeDBTransaction,
+				"cannot run VACUUM inside a transaction",
+			)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,100 @@
+package database
+
+import (
+	"context"
+	"testing"
+)
+
+func TestOptimize(t *testing.T) {
+	db, _ := newFileDB(t)
+	ctx := context.Background()
+
+	// Create and bloat a table, then delete everything to build a freelist
+	if _, err := db.DB().Exec("CREATE TABLE bloat (id INTEGER PRIMARY KEY, payload TEXT)"); err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		if _, err := db.DB().Exec("INSERT INTO bloat (payload) VALUES (?)", string(make([]byte, 4096))); err != nil {
+			t.Fatalf("failed to insert row: %v", err)
+		}
+	}
+	if _, err := db.DB().Exec("DELETE FROM bloat"); err != nil {
+		t.Fatalf("failed to delete rows: %v", err)
+	}
+
+	before, err := db.StorageStats(ctx)
+	if err != nil {
+		t.Fatalf("failed to read stats: %v", err)
+	}
+	if before.FreePages == 0 {
+		t.Fatal("expected free pages after bulk delete")
+	}
+
+	if err := db.Optimize(ctx); err != nil {
+		t.Fatalf("optimize failed: %v", err)
+	}
+
+	after, err := db.StorageStats(ctx)
+	if err != nil {
+		t.Fatalf("failed to read stats: %v", err)
+	}
+	if after.FreePages != 0 {
+		t.Errorf("expected no free pages after VACUUM, got %d", after.FreePages)
+	}
+	if after.PageCount >= before.PageCount {
+		t.Errorf("expected page count to shrink, got %d -> %d", before.PageCount, after.PageCount)
+	}
+}
+
+func TestStorageStats(t *testing.T) {
+	db, _ := newFileDB(t)
+
+	if _, err := db.DB().Exec("CREATE TABLE probe (id INTEGER PRIMARY KEY)"); err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+	if _, err := db.DB().Exec("PRAGMA wal_checkpoint(TRUNCATE)"); err != nil {
+		t.Fatalf("failed to checkpoint: %v", err)
+	}
+
+	stats, err := db.StorageStats(context.Background())
+	if err != nil {
+		t.Fatalf("failed to read stats: %v", err)
+	}
+
+	if stats.PageCount == 0 {
+		t.Error("expected non-zero page count")
+	}
+	if stats.PageSize == 0 {
+		t.Error("expected non-zero page size")
+	}
+	if stats.FileSize == 0 {
+		t.Error("expected non-zero file size for file-backed database")
+	}
+}
+
+func TestEnsureAutoCommit(t *testing.T) {
+	db, _ := newFileDB(t)
+	ctx := context.Background()
+
+	conn, err := db.DB().Conn(ctx)
+	if err != nil {
+		t.Fatalf("failed to get connection: %v", err)
+	}
+	defer conn.Close()
+
+	// Outside a transaction the guard passes
+	if err := ensureAutoCommit(conn); err != nil {
+		t.Errorf("expected no error outside transaction: %v", err)
+	}
+
+	// Inside a transaction it refuses to proceed
+	if _, err := conn.ExecContext(ctx, "BEGIN"); err != nil {
+		t.Fatalf("failed to begin transaction: %v", err)
+	}
+	if err := ensureAutoCommit(conn); err == nil {
+		t.Error("expected error inside transaction")
+	}
+	if _, err := conn.ExecContext(ctx, "ROLLBACK"); err != nil {
+		t.Fatalf("failed to rollback: %v", err)
+	}
+}
@@ -0,0 +1,20 @@
+-- Migration: 003_add_session_tags
+-- Description: Add session_tags table for labeling sessions
+-- Author: AINative-Code Team
+-- Date: 2026-08-29
+
+-- +migrate Up
+-- Tags are unique per session; deleting a session removes its tags
+CREATE TABLE IF NOT EXISTS session_tags (
+    session_id TEXT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    tag TEXT NOT NULL,
+    created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (session_id, tag)
+);
+
+-- Index for tag-based listing
+CREATE INDEX IF NOT EXISTS idx_session_tags_tag ON session_tags(tag);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_session_tags_tag;
+DROP TABLE IF EXISTS session_tags;
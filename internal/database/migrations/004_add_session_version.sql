@@ -0,0 +1,10 @@
+-- Migration: 004_add_session_version
+-- Description: Add version column to sessions for optimistic concurrency control
+-- Author: AINative-Code Team
+-- Date: 2026-08-30
+
+-- +migrate Up
+ALTER TABLE sessions ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+-- +migrate Down
+ALTER TABLE sessions DROP COLUMN version;
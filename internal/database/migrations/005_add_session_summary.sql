@@ -0,0 +1,15 @@
+-- Migration: 005_add_session_summary
+-- Description: Add rolling conversation summary columns to sessions
+-- Author: AINative-Code Team
+-- Date: 2026-08-30
+
+-- +migrate Up
+-- summary holds a provider-generated digest of the conversation so far;
+-- summary_message_count records how many messages that digest covers so
+-- re-summarization can run incrementally over only the newer messages
+ALTER TABLE sessions ADD COLUMN summary TEXT;
+ALTER TABLE sessions ADD COLUMN summary_message_count INTEGER NOT NULL DEFAULT 0;
+
+-- +migrate Down
+ALTER TABLE sessions DROP COLUMN summary_message_count;
+ALTER TABLE sessions DROP COLUMN summary;
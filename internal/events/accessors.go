@@ -0,0 +1,109 @@
+package events
+
+import (
+	"encoding/json"
+)
+
+// Usage holds the token counts carried by an EventUsage event
+type Usage struct {
+	PromptTokens     int
+	CompletionTokens int
+	TotalTokens      int
+}
+
+// Text returns the text payload of an EventTextDelta event. The second
+// return value is false when the event is a different type or the payload
+// is missing or mistyped.
+func (e *Event) Text() (string, bool) {
+	if e.Type != EventTextDelta {
+		return "", false
+	}
+	return stringValue(e.Data["text"])
+}
+
+// Thinking returns the thinking payload of an EventThinking event
+func (e *Event) Thinking() (string, bool) {
+	if e.Type != EventThinking {
+		return "", false
+	}
+	return stringValue(e.Data["thinking"])
+}
+
+// ErrorMsg returns the error message of an EventError event
+func (e *Event) ErrorMsg() (string, bool) {
+	if e.Type != EventError {
+		return "", false
+	}
+	return stringValue(e.Data["error"])
+}
+
+// Usage returns the token counts of an EventUsage event
+func (e *Event) Usage() (Usage, bool) {
+	if e.Type != EventUsage {
+		return Usage{}, false
+	}
+
+	prompt, ok := intValue(e.Data["prompt_tokens"])
+	if !ok {
+		return Usage{}, false
+	}
+	completion, ok := intValue(e.Data["completion_tokens"])
+	if !ok {
+		return Usage{}, false
+	}
+	total, ok := intValue(e.Data["total_tokens"])
+	if !ok {
+		return Usage{}, false
+	}
+
+	return Usage{
+		PromptTokens:     prompt,
+		CompletionTokens: completion,
+		TotalTokens:      total,
+	}, true
+}
+
+// MessageID returns the message ID of an EventMessageStart or
+// EventMessageStop event
+func (e *Event) MessageID() (string, bool) {
+	if e.Type != EventMessageStart && e.Type != EventMessageStop {
+		return "", false
+	}
+	return stringValue(e.Data["message_id"])
+}
+
+// StopReason returns the stop reason of an EventMessageStop event
+func (e *Event) StopReason() (string, bool) {
+	if e.Type != EventMessageStop {
+		return "", false
+	}
+	return stringValue(e.Data["stop_reason"])
+}
+
+// stringValue extracts a string from an untyped payload value
+func stringValue(v interface{}) (string, bool) {
+	s, ok := v.(string)
+	return s, ok
+}
+
+// intValue extracts an int from an untyped payload value. JSON decoding
+// turns numbers into float64, so both in-process and round-tripped events
+// are handled.
+func intValue(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		return int(n), true
+	case json.Number:
+		i, err := n.Int64()
+		if err != nil {
+			return 0, false
+		}
+		return int(i), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,92 @@
+package events
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventText(t *testing.T) {
+	event := TextDeltaEvent("hello")
+
+	text, ok := event.Text()
+	assert.True(t, ok)
+	assert.Equal(t, "hello", text)
+
+	// Wrong event type
+	_, ok = ThinkingEvent("pondering").Text()
+	assert.False(t, ok)
+
+	// Mistyped payload
+	event.Data["text"] = 42
+	_, ok = event.Text()
+	assert.False(t, ok)
+}
+
+func TestEventThinking(t *testing.T) {
+	thinking, ok := ThinkingEvent("pondering").Thinking()
+	assert.True(t, ok)
+	assert.Equal(t, "pondering", thinking)
+
+	_, ok = TextDeltaEvent("hello").Thinking()
+	assert.False(t, ok)
+}
+
+func TestEventErrorMsg(t *testing.T) {
+	msg, ok := ErrorEvent("boom").ErrorMsg()
+	assert.True(t, ok)
+	assert.Equal(t, "boom", msg)
+
+	_, ok = TextDeltaEvent("hello").ErrorMsg()
+	assert.False(t, ok)
+}
+
+func TestEventUsage(t *testing.T) {
+	usage, ok := UsageEvent(100, 50, 150).Usage()
+	assert.True(t, ok)
+	assert.Equal(t, Usage{PromptTokens: 100, CompletionTokens: 50, TotalTokens: 150}, usage)
+
+	_, ok = TextDeltaEvent("hello").Usage()
+	assert.False(t, ok)
+
+	// Missing counts
+	event := &Event{Type: EventUsage, Data: map[string]interface{}{}}
+	_, ok = event.Usage()
+	assert.False(t, ok)
+}
+
+func TestEventUsageAfterJSONRoundTrip(t *testing.T) {
+	data, err := json.Marshal(UsageEvent(100, 50, 150))
+	require.NoError(t, err)
+
+	var decoded Event
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	// JSON decoding turns the counts into float64
+	usage, ok := decoded.Usage()
+	assert.True(t, ok)
+	assert.Equal(t, Usage{PromptTokens: 100, CompletionTokens: 50, TotalTokens: 150}, usage)
+}
+
+func TestEventMessageIDAndStopReason(t *testing.T) {
+	id, ok := MessageStartEvent("msg-1").MessageID()
+	assert.True(t, ok)
+	assert.Equal(t, "msg-1", id)
+
+	stop := MessageStopEvent("msg-1", "end_turn")
+
+	id, ok = stop.MessageID()
+	assert.True(t, ok)
+	assert.Equal(t, "msg-1", id)
+
+	reason, ok := stop.StopReason()
+	assert.True(t, ok)
+	assert.Equal(t, "end_turn", reason)
+
+	_, ok = TextDeltaEvent("hello").MessageID()
+	assert.False(t, ok)
+	_, ok = MessageStartEvent("msg-1").StopReason()
+	assert.False(t, ok)
+}
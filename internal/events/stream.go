@@ -20,6 +20,12 @@ const (
 
 	// BackpressureDrop drops the event and returns an error
 	BackpressureDrop
+
+	// BackpressureSample drops intermediate EventTextDelta events once the
+	// buffer exceeds the high-water mark, but always delivers structural
+	// events (content boundaries, errors, usage, message stop). The UI
+	// stays coherent under load at the cost of coarser text updates.
+	BackpressureSample
 )
 
 // EventStream manages a stream of events with buffering and backpressure handling
@@ -37,24 +43,95 @@ type EventStream struct {
 	// backpressurePolicy determines how to handle buffer overflow
 	backpressurePolicy BackpressurePolicy
 
+	// highWaterMark is the buffer occupancy at which BackpressureSample
+	// starts dropping text deltas
+	highWaterMark int
+
+	// stats tracks delivery counters for the stream
+	stats StreamStats
+
+	// hooks holds the observability callbacks for the stream
+	hooks Hooks
+
 	// mu protects concurrent access to stream state
 	mu sync.RWMutex
 }
 
+// Hooks holds optional callbacks invoked synchronously inside stream
+// operations, so tracing (e.g. OpenTelemetry spans per event) can observe
+// the stream lifecycle without wrapping every call site. Nil fields are
+// skipped. Callbacks run on the goroutine performing the operation and are
+// never invoked while the stream lock is held, but a slow hook still slows
+// the operation itself.
+type Hooks struct {
+	// OnSend runs after an event is delivered into the buffer
+	OnSend func(*Event)
+
+	// OnReceive runs as an event is handed to the consumer. When set,
+	// Receive returns a forwarding channel and should be called once.
+	OnReceive func(*Event)
+
+	// OnDrop runs when an event is rejected by BackpressureDrop or
+	// discarded by BackpressureSample
+	OnDrop func(*Event)
+
+	// OnClose runs once after the stream is closed
+	OnClose func()
+}
+
+// StreamStats holds delivery counters for an event stream
+type StreamStats struct {
+	// Sent is the number of events delivered into the buffer
+	Sent uint64
+
+	// Dropped is the number of events rejected by BackpressureDrop
+	Dropped uint64
+
+	// Sampled is the number of text deltas discarded by BackpressureSample
+	Sampled uint64
+}
+
+// StreamOption configures an EventStream
+type StreamOption func(*EventStream)
+
+// WithBackpressurePolicy sets the initial backpressure policy
+func WithBackpressurePolicy(policy BackpressurePolicy) StreamOption {
+	return func(s *EventStream) {
+		s.backpressurePolicy = policy
+	}
+}
+
+// WithHighWaterMark sets the buffer occupancy at which BackpressureSample
+// begins dropping text deltas. Values outside (0, bufferSize] are ignored.
+func WithHighWaterMark(mark int) StreamOption {
+	return func(s *EventStream) {
+		if mark > 0 && mark <= s.bufferSize {
+			s.highWaterMark = mark
+		}
+	}
+}
+
 // NewEventStream creates a new event stream with the specified buffer size
 // If bufferSize is 0, defaultBufferSize is used
-func NewEventStream(bufferSize int) *EventStream {
+func NewEventStream(bufferSize int, opts ...StreamOption) *EventStream {
 	if bufferSize <= 0 {
 		bufferSize = defaultBufferSize
 	}
 
-	return &EventStream{
+	s := &EventStream{
 		events:             make(chan *Event, bufferSize),
 		bufferSize:         bufferSize,
 		closed:             false,
 		backpressurePolicy: BackpressureBlock,
+		highWaterMark:      bufferSize * 3 / 4,
 		mu:                 sync.RWMutex{},
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 // Send sends an event to the stream
@@ -73,6 +150,8 @@ func (s *EventStream) Send(event *Event) error {
 	s.mu.RLock()
 	closed := s.closed
 	policy := s.backpressurePolicy
+	highWater := s.highWaterMark
+	hooks := s.hooks
 	s.mu.RUnlock()
 
 	if closed {
@@ -80,23 +159,47 @@ func (s *EventStream) Send(event *Event) error {
 	}
 
 	// Handle backpressure based on policy
+	if policy == BackpressureSample && event.Type == EventTextDelta && len(s.events) >= highWater {
+		// Sample out intermediate text deltas above the high-water mark;
+		// structural events always go through below
+		s.countSampled()
+		if hooks.OnDrop != nil {
+			hooks.OnDrop(event)
+		}
+		return nil
+	}
+
 	if policy == BackpressureDrop {
 		// Non-blocking send
 		select {
 		case s.events <- event:
+			s.countSent()
+			if hooks.OnSend != nil {
+				hooks.OnSend(event)
+			}
 			return nil
 		default:
+			s.countDropped()
+			if hooks.OnDrop != nil {
+				hooks.OnDrop(event)
+			}
 			return ErrStreamFull("stream", s.bufferSize)
 		}
 	}
 
-	// BackpressureBlock - blocking send
+	// BackpressureBlock and BackpressureSample - blocking send
 	s.events <- event
+	s.countSent()
+	if hooks.OnSend != nil {
+		hooks.OnSend(event)
+	}
 	return nil
 }
 
 // SendWithContext sends an event to the stream with context support
 // Returns an error if the context is cancelled, the stream is closed, or the event is invalid
+// Under BackpressureBlock a pending send is unblocked by cancellation; the
+// event is either fully enqueued or not enqueued at all
 func (s *EventStream) SendWithContext(ctx context.Context, event *Event) error {
 	if event == nil {
 		return fmt.Errorf("event cannot be nil")
@@ -109,15 +212,26 @@ func (s *EventStream) SendWithContext(ctx context.Context, event *Event) error {
 
 	s.mu.RLock()
 	closed := s.closed
+	hooks := s.hooks
 	s.mu.RUnlock()
 
 	if closed {
 		return ErrStreamClosed("stream")
 	}
 
+	// An already-cancelled context must never enqueue the event: when both
+	// buffer space and cancellation are ready, select below picks randomly
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("failed to send event: %w", err)
+	}
+
 	// Send with context awareness
 	select {
 	case s.events <- event:
+		s.countSent()
+		if hooks.OnSend != nil {
+			hooks.OnSend(event)
+		}
 		return nil
 	case <-ctx.Done():
 		return fmt.Errorf("failed to send event: %w", ctx.Err())
@@ -126,8 +240,27 @@ func (s *EventStream) SendWithContext(ctx context.Context, event *Event) error {
 
 // Receive returns the receive-only channel for consuming events
 // The channel will be closed when the stream is closed
+// When an OnReceive hook is set, a forwarding channel is returned that
+// invokes the hook as each event is handed over; call Receive once in
+// that case so events are not split across forwarders
 func (s *EventStream) Receive() <-chan *Event {
-	return s.events
+	s.mu.RLock()
+	onReceive := s.hooks.OnReceive
+	s.mu.RUnlock()
+
+	if onReceive == nil {
+		return s.events
+	}
+
+	out := make(chan *Event)
+	go func() {
+		defer close(out)
+		for event := range s.events {
+			onReceive(event)
+			out <- event
+		}
+	}()
+	return out
 }
 
 // Close gracefully shuts down the stream
@@ -135,14 +268,20 @@ func (s *EventStream) Receive() <-chan *Event {
 // Returns an error if the stream is already closed
 func (s *EventStream) Close() error {
 	s.mu.Lock()
-	defer s.mu.Unlock()
 
 	if s.closed {
+		s.mu.Unlock()
 		return ErrStreamClosed("stream")
 	}
 
 	s.closed = true
 	close(s.events)
+	onClose := s.hooks.OnClose
+	s.mu.Unlock()
+
+	if onClose != nil {
+		onClose()
+	}
 
 	return nil
 }
@@ -162,6 +301,47 @@ func (s *EventStream) SetBackpressurePolicy(policy BackpressurePolicy) {
 	s.backpressurePolicy = policy
 }
 
+// SetHooks installs observability callbacks on the stream. It replaces any
+// previously installed hooks and should be called before the stream is in
+// active use.
+func (s *EventStream) SetHooks(hooks Hooks) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hooks = hooks
+}
+
+// Stats returns a snapshot of the stream's delivery counters
+func (s *EventStream) Stats() StreamStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stats
+}
+
+// HighWaterMark returns the sampling threshold for BackpressureSample
+func (s *EventStream) HighWaterMark() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.highWaterMark
+}
+
+func (s *EventStream) countSent() {
+	s.mu.Lock()
+	s.stats.Sent++
+	s.mu.Unlock()
+}
+
+func (s *EventStream) countDropped() {
+	s.mu.Lock()
+	s.stats.Dropped++
+	s.mu.Unlock()
+}
+
+func (s *EventStream) countSampled() {
+	s.mu.Lock()
+	s.stats.Sampled++
+	s.mu.Unlock()
+}
+
 // BufferSize returns the capacity of the event buffer
 func (s *EventStream) BufferSize() int {
 	return s.bufferSize
@@ -149,6 +149,45 @@ func TestEventStream_SendWithContext(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "context deadline exceeded")
 	})
+
+	t.Run("block mode with no receiver returns promptly on cancellation", func(t *testing.T) {
+		bufferSize := 2
+		stream := NewEventStream(bufferSize)
+		stream.SetBackpressurePolicy(BackpressureBlock)
+		defer stream.Close()
+
+		// Fill the buffer with nobody receiving
+		for i := 0; i < bufferSize; i++ {
+			require.NoError(t, stream.Send(TextDeltaEvent("filler")))
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		err := stream.SendWithContext(ctx, TextDeltaEvent("blocked"))
+		elapsed := time.Since(start)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, elapsed, 500*time.Millisecond, "cancellation should unblock the send promptly")
+
+		// The event must not be half-inserted
+		assert.Equal(t, bufferSize, stream.Len())
+	})
+
+	t.Run("pre-cancelled context never enqueues even with space", func(t *testing.T) {
+		stream := NewEventStream(10)
+		defer stream.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := stream.SendWithContext(ctx, TextDeltaEvent("hello"))
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 0, stream.Len())
+	})
 }
 
 func TestEventStream_Receive(t *testing.T) {
@@ -388,3 +427,171 @@ func TestEventStream_BufferSizeAndLen(t *testing.T) {
 	// Len should decrease
 	assert.Equal(t, 2, stream.Len())
 }
+
+func TestEventStream_SamplePolicy(t *testing.T) {
+	// High-water mark of 2: once two events are buffered, text deltas
+	// are sampled out while structural events still get through
+	stream := NewEventStream(10,
+		WithBackpressurePolicy(BackpressureSample),
+		WithHighWaterMark(2))
+	defer stream.Close()
+
+	require.NoError(t, stream.Send(TextDeltaEvent("kept1")))
+	require.NoError(t, stream.Send(TextDeltaEvent("kept2")))
+
+	// Buffer is at the high-water mark: deltas are discarded silently
+	require.NoError(t, stream.Send(TextDeltaEvent("sampled1")))
+	require.NoError(t, stream.Send(TextDeltaEvent("sampled2")))
+
+	// Structural events always land in the buffer
+	require.NoError(t, stream.Send(ContentEndEvent(0)))
+	require.NoError(t, stream.Send(UsageEvent(10, 5, 15)))
+	require.NoError(t, stream.Send(MessageStopEvent("msg-1", "end_turn")))
+
+	assert.Equal(t, 5, stream.Len())
+
+	stats := stream.Stats()
+	assert.Equal(t, uint64(5), stats.Sent)
+	assert.Equal(t, uint64(2), stats.Sampled)
+	assert.Equal(t, uint64(0), stats.Dropped)
+
+	// Delivered events keep their order with no sampled deltas in between
+	var types []EventType
+	for i := 0; i < 5; i++ {
+		event := <-stream.Receive()
+		types = append(types, event.Type)
+	}
+	assert.Equal(t, []EventType{
+		EventTextDelta, EventTextDelta,
+		EventContentEnd, EventUsage, EventMessageStop,
+	}, types)
+}
+
+func TestEventStream_SampleBelowHighWater(t *testing.T) {
+	stream := NewEventStream(10,
+		WithBackpressurePolicy(BackpressureSample),
+		WithHighWaterMark(5))
+	defer stream.Close()
+
+	// Below the high-water mark every delta is delivered
+	for i := 0; i < 4; i++ {
+		require.NoError(t, stream.Send(TextDeltaEvent("delta")))
+	}
+
+	assert.Equal(t, 4, stream.Len())
+	assert.Equal(t, uint64(0), stream.Stats().Sampled)
+}
+
+func TestEventStream_DefaultHighWaterMark(t *testing.T) {
+	stream := NewEventStream(100)
+	defer stream.Close()
+
+	// Defaults to 75% of the buffer size
+	assert.Equal(t, 75, stream.HighWaterMark())
+
+	// Out-of-range options are ignored
+	ignored := NewEventStream(10, WithHighWaterMark(0))
+	defer ignored.Close()
+	assert.Equal(t, 7, ignored.HighWaterMark())
+
+	tooBig := NewEventStream(10, WithHighWaterMark(11))
+	defer tooBig.Close()
+	assert.Equal(t, 7, tooBig.HighWaterMark())
+}
+
+func TestEventStream_DropCountsInStats(t *testing.T) {
+	stream := NewEventStream(1, WithBackpressurePolicy(BackpressureDrop))
+	defer stream.Close()
+
+	require.NoError(t, stream.Send(TextDeltaEvent("fits")))
+	require.Error(t, stream.Send(TextDeltaEvent("dropped")))
+
+	stats := stream.Stats()
+	assert.Equal(t, uint64(1), stats.Sent)
+	assert.Equal(t, uint64(1), stats.Dropped)
+}
+
+func TestEventStream_HooksOnSendAndDrop(t *testing.T) {
+	stream := NewEventStream(1, WithBackpressurePolicy(BackpressureDrop))
+	defer stream.Close()
+
+	var sent, dropped []*Event
+	stream.SetHooks(Hooks{
+		OnSend: func(e *Event) { sent = append(sent, e) },
+		OnDrop: func(e *Event) { dropped = append(dropped, e) },
+	})
+
+	require.NoError(t, stream.Send(TextDeltaEvent("fits")))
+	require.Error(t, stream.Send(TextDeltaEvent("dropped")))
+
+	require.Len(t, sent, 1)
+	require.Len(t, dropped, 1)
+	assert.Equal(t, "fits", sent[0].Data["text"])
+	assert.Equal(t, "dropped", dropped[0].Data["text"])
+}
+
+func TestEventStream_HooksOnDropForSampledDeltas(t *testing.T) {
+	stream := NewEventStream(4,
+		WithBackpressurePolicy(BackpressureSample),
+		WithHighWaterMark(1))
+	defer stream.Close()
+
+	var dropped int
+	stream.SetHooks(Hooks{
+		OnDrop: func(e *Event) { dropped++ },
+	})
+
+	require.NoError(t, stream.Send(TextDeltaEvent("first")))
+	// Buffer is at the high-water mark, so this delta is sampled out
+	require.NoError(t, stream.Send(TextDeltaEvent("sampled")))
+
+	assert.Equal(t, 1, dropped)
+}
+
+func TestEventStream_HooksOnReceive(t *testing.T) {
+	stream := NewEventStream(4)
+
+	var received []*Event
+	stream.SetHooks(Hooks{
+		OnReceive: func(e *Event) { received = append(received, e) },
+	})
+
+	require.NoError(t, stream.Send(TextDeltaEvent("one")))
+	require.NoError(t, stream.Send(TextDeltaEvent("two")))
+	require.NoError(t, stream.Close())
+
+	var consumed []*Event
+	for event := range stream.Receive() {
+		consumed = append(consumed, event)
+	}
+
+	require.Len(t, consumed, 2)
+	assert.Equal(t, consumed, received)
+}
+
+func TestEventStream_HooksOnClose(t *testing.T) {
+	stream := NewEventStream(4)
+
+	closed := 0
+	stream.SetHooks(Hooks{
+		OnClose: func() { closed++ },
+	})
+
+	require.NoError(t, stream.Close())
+	assert.Equal(t, 1, closed)
+
+	// A second Close errors and must not re-invoke the hook
+	require.Error(t, stream.Close())
+	assert.Equal(t, 1, closed)
+}
+
+func TestEventStream_NilHooksAreSkipped(t *testing.T) {
+	stream := NewEventStream(4)
+	stream.SetHooks(Hooks{})
+
+	require.NoError(t, stream.Send(TextDeltaEvent("ok")))
+	require.NoError(t, stream.Close())
+
+	event := <-stream.Receive()
+	assert.Equal(t, "ok", event.Data["text"])
+}
@@ -0,0 +1,202 @@
+package mcp
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTransport records calls and answers from a canned handler.
+type fakeTransport struct {
+	calls   atomic.Int64
+	handler func(req *JSONRPCRequest) (*JSONRPCResponse, error)
+}
+
+func (t *fakeTransport) RoundTrip(ctx context.Context, req *JSONRPCRequest) (*JSONRPCResponse, error) {
+	t.calls.Add(1)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return t.handler(req)
+}
+
+func (t *fakeTransport) Close() error {
+	return nil
+}
+
+// newCacheTestClient builds a client over a fake transport that returns a
+// fixed successful tool result.
+func newCacheTestClient(result *ToolResult) (*Client, *fakeTransport) {
+	transport := &fakeTransport{
+		handler: func(req *JSONRPCRequest) (*JSONRPCResponse, error) {
+			return &JSONRPCResponse{
+				JSONRPC: "2.0",
+				ID:      req.ID,
+				Result:  result,
+			}, nil
+		},
+	}
+
+	client := &Client{
+		server:    &Server{Name: "fake", Enabled: true},
+		transport: transport,
+	}
+
+	return client, transport
+}
+
+func textResult(text string) *ToolResult {
+	return &ToolResult{
+		Content: []ResultContent{{Type: "text", Text: text}},
+	}
+}
+
+func TestCallTool_CacheHitSkipsTransport(t *testing.T) {
+	client, transport := newCacheTestClient(textResult("cached"))
+	client.EnableResultCache(time.Minute)
+
+	args := map[string]interface{}{"url": "https://example.com", "depth": 2}
+
+	first, err := client.CallTool(context.Background(), "fetch", args)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), transport.calls.Load())
+
+	// Identical call within the TTL: served from cache, no round trip
+	second, err := client.CallTool(context.Background(), "fetch", args)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), transport.calls.Load())
+	assert.Equal(t, first, second)
+
+	// Same args in a different key order hit the same cache entry
+	_, err = client.CallTool(context.Background(), "fetch", map[string]interface{}{"depth": 2, "url": "https://example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), transport.calls.Load())
+
+	// Different arguments miss
+	_, err = client.CallTool(context.Background(), "fetch", map[string]interface{}{"url": "https://other.example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), transport.calls.Load())
+}
+
+func TestCallTool_CacheExpires(t *testing.T) {
+	client, transport := newCacheTestClient(textResult("short-lived"))
+	client.EnableResultCache(50 * time.Millisecond)
+
+	args := map[string]interface{}{"n": 1}
+
+	_, err := client.CallTool(context.Background(), "compute", args)
+	require.NoError(t, err)
+
+	time.Sleep(80 * time.Millisecond)
+
+	_, err = client.CallTool(context.Background(), "compute", args)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), transport.calls.Load())
+}
+
+func TestCallTool_CacheDisabledByDefault(t *testing.T) {
+	client, transport := newCacheTestClient(textResult("uncached"))
+
+	args := map[string]interface{}{"n": 1}
+
+	_, err := client.CallTool(context.Background(), "compute", args)
+	require.NoError(t, err)
+	_, err = client.CallTool(context.Background(), "compute", args)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), transport.calls.Load())
+}
+
+func TestCallTool_ErrorResultsNotCached(t *testing.T) {
+	client, transport := newCacheTestClient(&ToolResult{
+		Content: []ResultContent{{Type: "text", Text: "boom"}},
+		IsError: true,
+	})
+	client.EnableResultCache(time.Minute)
+
+	args := map[string]interface{}{"n": 1}
+
+	_, err := client.CallTool(context.Background(), "flaky", args)
+	require.NoError(t, err)
+	_, err = client.CallTool(context.Background(), "flaky", args)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), transport.calls.Load())
+}
+
+func TestCallTool_SchemaOptOut(t *testing.T) {
+	// Transport answers both tools/list and tools/call
+	transport := &fakeTransport{}
+	transport.handler = func(req *JSONRPCRequest) (*JSONRPCResponse, error) {
+		var result interface{}
+		switch req.Method {
+		case "tools/list":
+			result = ListToolsResult{
+				Tools: []Tool{
+					{
+						Name:        "now",
+						Description: "current time, never cache",
+						InputSchema: map[string]interface{}{"type": "object", "x-no-cache": true},
+					},
+				},
+			}
+		default:
+			result = textResult("result")
+		}
+		return &JSONRPCResponse{JSONRPC: "2.0", ID: req.ID, Result: result}, nil
+	}
+
+	client := &Client{
+		server:    &Server{Name: "fake", Enabled: true},
+		transport: transport,
+	}
+	client.EnableResultCache(time.Minute)
+
+	_, err := client.ListTools(context.Background())
+	require.NoError(t, err)
+
+	args := map[string]interface{}{}
+	_, err = client.CallTool(context.Background(), "now", args)
+	require.NoError(t, err)
+	_, err = client.CallTool(context.Background(), "now", args)
+	require.NoError(t, err)
+
+	// One list call plus two tool calls: nothing was cached
+	assert.Equal(t, int64(3), transport.calls.Load())
+}
+
+func TestCallToolWithTimeout(t *testing.T) {
+	transport := &fakeTransport{
+		handler: func(req *JSONRPCRequest) (*JSONRPCResponse, error) {
+			return &JSONRPCResponse{JSONRPC: "2.0", ID: req.ID, Result: textResult("slow")}, nil
+		},
+	}
+	client := &Client{
+		server:    &Server{Name: "fake", Enabled: true},
+		transport: transport,
+	}
+
+	t.Run("completes within the timeout", func(t *testing.T) {
+		result, err := client.CallToolWithTimeout(context.Background(), "fast", nil, time.Second)
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("expires for a slow tool", func(t *testing.T) {
+		slow := &fakeTransport{
+			handler: func(req *JSONRPCRequest) (*JSONRPCResponse, error) {
+				time.Sleep(200 * time.Millisecond)
+				return nil, context.DeadlineExceeded
+			},
+		}
+		slowClient := &Client{
+			server:    &Server{Name: "fake", Enabled: true},
+			transport: slow,
+		}
+
+		_, err := slowClient.CallToolWithTimeout(context.Background(), "slow", nil, 50*time.Millisecond)
+		assert.Error(t, err)
+	})
+}
@@ -7,18 +7,52 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sync"
 	"sync/atomic"
 	"time"
 )
 
+// Transport delivers JSON-RPC requests to an MCP server and returns its
+// responses. Implementations exist for HTTP servers and stdio subprocesses.
+type Transport interface {
+	// RoundTrip sends a single JSON-RPC request and waits for its response.
+	RoundTrip(ctx context.Context, req *JSONRPCRequest) (*JSONRPCResponse, error)
+
+	// Close releases any resources held by the transport, such as a child
+	// process for stdio transports.
+	Close() error
+}
+
 // Client represents an MCP protocol client.
 type Client struct {
-	server     *Server
-	httpClient *http.Client
-	requestID  atomic.Uint64
+	server    *Server
+	transport Transport
+	requestID atomic.Uint64
+
+	// Result cache, enabled via EnableResultCache
+	cacheMu  sync.Mutex
+	cacheTTL time.Duration
+	cache    map[string]*cacheEntry
+	noCache  map[string]bool
+
+	// Health state, maintained by StartHealthMonitor
+	healthMu      sync.Mutex
+	healthy       bool
+	healthChecked bool
+
+	// Parsed tool schemas, populated by ListTools and consulted by CallTool
+	// for client-side argument validation
+	schemaMu sync.Mutex
+	schemas  map[string]*toolSchema
+}
+
+// cacheEntry is a cached tool result with its expiry.
+type cacheEntry struct {
+	result    *ToolResult
+	expiresAt time.Time
 }
 
-// NewClient creates a new MCP client for the given server.
+// NewClient creates a new MCP client for the given server over HTTP.
 func NewClient(server *Server) *Client {
 	timeout := server.Timeout
 	if timeout == 0 {
@@ -27,12 +61,43 @@ func NewClient(server *Server) *Client {
 
 	return &Client{
 		server: server,
-		httpClient: &http.Client{
-			Timeout: timeout,
+		transport: &httpTransport{
+			server: server,
+			httpClient: &http.Client{
+				Timeout: timeout,
+			},
 		},
 	}
 }
 
+// NewStdioClient creates an MCP client that launches the server as a
+// subprocess and speaks JSON-RPC over its stdin/stdout. The process is
+// started lazily on first use and restarted if it exits.
+func NewStdioClient(command string, args ...string) *Client {
+	return &Client{
+		server: &Server{
+			Name:    command,
+			Enabled: true,
+		},
+		transport: newStdioTransport(command, args...),
+	}
+}
+
+// EnableResultCache turns on caching of tool results for the given TTL.
+// Results are keyed by tool name and canonicalized arguments, so an
+// identical call within the TTL skips the round trip entirely. Tools whose
+// input schema sets "x-no-cache": true (as observed by ListTools) are never
+// cached. A TTL of zero or less disables the cache.
+func (c *Client) EnableResultCache(ttl time.Duration) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	c.cacheTTL = ttl
+	if ttl > 0 && c.cache == nil {
+		c.cache = make(map[string]*cacheEntry)
+	}
+}
+
 // ListTools retrieves all available tools from the MCP server.
 func (c *Client) ListTools(ctx context.Context) ([]Tool, error) {
 	var allTools []Tool
@@ -57,11 +122,49 @@ func (c *Client) ListTools(ctx context.Context) ([]Tool, error) {
 		cursor = result.NextCursor
 	}
 
+	// Record which tools opted out of result caching
+	c.cacheMu.Lock()
+	for _, tool := range allTools {
+		if optOut, ok := tool.InputSchema["x-no-cache"].(bool); ok && optOut {
+			if c.noCache == nil {
+				c.noCache = make(map[string]bool)
+			}
+			c.noCache[tool.Name] = true
+		}
+	}
+	c.cacheMu.Unlock()
+
+	// Parse and cache each tool's input schema for argument validation
+	c.schemaMu.Lock()
+	for _, tool := range allTools {
+		if schema := parseToolSchema(tool.InputSchema); schema != nil {
+			if c.schemas == nil {
+				c.schemas = make(map[string]*toolSchema)
+			}
+			c.schemas[tool.Name] = schema
+		}
+	}
+	c.schemaMu.Unlock()
+
 	return allTools, nil
 }
 
-// CallTool executes a tool on the MCP server.
+// CallTool executes a tool on the MCP server, consulting the result cache
+// when one is enabled. When the tool's input schema is known from a prior
+// ListTools, the arguments are validated locally first so a malformed call
+// fails with ErrInvalidArguments before any transport round trip.
 func (c *Client) CallTool(ctx context.Context, name string, arguments map[string]interface{}) (*ToolResult, error) {
+	if err := c.validateArguments(name, arguments); err != nil {
+		return nil, err
+	}
+
+	cacheKey, cacheable := c.cacheKey(name, arguments)
+	if cacheable {
+		if result := c.cachedResult(cacheKey); result != nil {
+			return result, nil
+		}
+	}
+
 	params := CallToolParams{
 		Name:      name,
 		Arguments: arguments,
@@ -72,9 +175,91 @@ func (c *Client) CallTool(ctx context.Context, name string, arguments map[string
 		return nil, fmt.Errorf("failed to call tool %s: %w", name, err)
 	}
 
+	// Only successful results are worth replaying
+	if cacheable && !result.IsError {
+		c.storeResult(cacheKey, &result)
+	}
+
 	return &result, nil
 }
 
+// CallToolWithTimeout executes a tool with a per-call timeout so a slow tool
+// cannot hang the caller indefinitely.
+func (c *Client) CallToolWithTimeout(ctx context.Context, name string, arguments map[string]interface{}, timeout time.Duration) (*ToolResult, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	return c.CallTool(ctx, name, arguments)
+}
+
+// validateArguments checks the arguments against the tool's cached schema.
+// Tools without a known schema are not validated.
+func (c *Client) validateArguments(name string, arguments map[string]interface{}) error {
+	c.schemaMu.Lock()
+	schema := c.schemas[name]
+	c.schemaMu.Unlock()
+
+	if schema == nil {
+		return nil
+	}
+
+	return schema.validate(name, arguments)
+}
+
+// cacheKey builds the cache key for a call and reports whether the call is
+// cacheable at all.
+func (c *Client) cacheKey(name string, arguments map[string]interface{}) (string, bool) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	if c.cacheTTL <= 0 || c.noCache[name] {
+		return "", false
+	}
+
+	// json.Marshal sorts map keys, giving a canonical form for the arguments
+	argsJSON, err := json.Marshal(arguments)
+	if err != nil {
+		return "", false
+	}
+
+	return name + ":" + string(argsJSON), true
+}
+
+// cachedResult returns a live cached result for the key, or nil.
+func (c *Client) cachedResult(key string) *ToolResult {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	entry, ok := c.cache[key]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.cache, key)
+		return nil
+	}
+
+	return entry.result
+}
+
+// storeResult caches a result under the key for the configured TTL.
+func (c *Client) storeResult(key string, result *ToolResult) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	if c.cacheTTL <= 0 {
+		return
+	}
+
+	c.cache[key] = &cacheEntry{
+		result:    result,
+		expiresAt: time.Now().Add(c.cacheTTL),
+	}
+}
+
 // Ping checks if the MCP server is reachable.
 func (c *Client) Ping(ctx context.Context) error {
 	var result interface{}
@@ -104,7 +289,8 @@ func (c *Client) CheckHealth(ctx context.Context) *HealthStatus {
 	return status
 }
 
-// call performs a JSON-RPC call to the MCP server.
+// call performs a JSON-RPC call to the MCP server over the configured
+// transport.
 func (c *Client) call(ctx context.Context, method string, params interface{}, result interface{}) error {
 	// Generate unique request ID
 	requestID := c.requestID.Add(1)
@@ -117,69 +303,96 @@ func (c *Client) call(ctx context.Context, method string, params interface{}, re
 		Params:  params,
 	}
 
+	rpcResp, err := c.transport.RoundTrip(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	// Check for JSON-RPC error
+	if rpcResp.Error != nil {
+		return rpcResp.Error
+	}
+
+	// Unmarshal result into target
+	if result != nil && rpcResp.Result != nil {
+		resultBytes, err := json.Marshal(rpcResp.Result)
+		if err != nil {
+			return fmt.Errorf("failed to marshal result: %w", err)
+		}
+
+		if err := json.Unmarshal(resultBytes, result); err != nil {
+			return fmt.Errorf("failed to unmarshal result: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Close releases the client's transport. For stdio clients this terminates
+// the child process; for HTTP clients it is a no-op.
+func (c *Client) Close() error {
+	return c.transport.Close()
+}
+
+// GetServer returns the server configuration.
+func (c *Client) GetServer() *Server {
+	return c.server
+}
+
+// httpTransport speaks JSON-RPC over HTTP POST requests.
+type httpTransport struct {
+	server     *Server
+	httpClient *http.Client
+}
+
+// RoundTrip sends the request to the server's URL and parses the response.
+func (t *httpTransport) RoundTrip(ctx context.Context, req *JSONRPCRequest) (*JSONRPCResponse, error) {
 	// Marshal request
 	reqBody, err := json.Marshal(req)
 	if err != nil {
-		return fmt.Errorf("failed to marshal request: %w", err)
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
 	// Create HTTP request
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.server.URL, bytes.NewReader(reqBody))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", t.server.URL, bytes.NewReader(reqBody))
 	if err != nil {
-		return fmt.Errorf("failed to create HTTP request: %w", err)
+		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
 
 	// Set headers
 	httpReq.Header.Set("Content-Type", "application/json")
-	for key, value := range c.server.Headers {
+	for key, value := range t.server.Headers {
 		httpReq.Header.Set(key, value)
 	}
 
 	// Send request
-	resp, err := c.httpClient.Do(httpReq)
+	resp, err := t.httpClient.Do(httpReq)
 	if err != nil {
-		return fmt.Errorf("failed to send request: %w", err)
+		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Read response body
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
+		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
 	// Check HTTP status
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("HTTP error: %d %s", resp.StatusCode, string(respBody))
+		return nil, fmt.Errorf("HTTP error: %d %s", resp.StatusCode, string(respBody))
 	}
 
 	// Parse JSON-RPC response
 	var rpcResp JSONRPCResponse
 	if err := json.Unmarshal(respBody, &rpcResp); err != nil {
-		return fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	// Check for JSON-RPC error
-	if rpcResp.Error != nil {
-		return rpcResp.Error
-	}
-
-	// Unmarshal result into target
-	if result != nil && rpcResp.Result != nil {
-		resultBytes, err := json.Marshal(rpcResp.Result)
-		if err != nil {
-			return fmt.Errorf("failed to marshal result: %w", err)
-		}
-
-		if err := json.Unmarshal(resultBytes, result); err != nil {
-			return fmt.Errorf("failed to unmarshal result: %w", err)
-		}
+		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
-	return nil
+	return &rpcResp, nil
 }
 
-// GetServer returns the server configuration.
-func (c *Client) GetServer() *Server {
-	return c.server
+// Close is a no-op for HTTP transports.
+func (t *httpTransport) Close() error {
+	return nil
 }
@@ -23,7 +23,7 @@ func TestNewClient(t *testing.T) {
 	client := NewClient(server)
 	assert.NotNil(t, client)
 	assert.Equal(t, server, client.server)
-	assert.NotNil(t, client.httpClient)
+	assert.NotNil(t, client.transport.(*httpTransport).httpClient)
 }
 
 func TestNewClient_DefaultTimeout(t *testing.T) {
@@ -34,7 +34,7 @@ func TestNewClient_DefaultTimeout(t *testing.T) {
 	}
 
 	client := NewClient(server)
-	assert.Equal(t, 30*time.Second, client.httpClient.Timeout)
+	assert.Equal(t, 30*time.Second, client.transport.(*httpTransport).httpClient.Timeout)
 }
 
 func TestListTools(t *testing.T) {
@@ -0,0 +1,122 @@
+package mcp
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// healthEventBuffer is the capacity of the health event channel; slow
+	// consumers drop events rather than stalling the monitor
+	healthEventBuffer = 16
+
+	// healthPingRetries is how many times a failed ping is retried with
+	// backoff before the server is declared down
+	healthPingRetries = 2
+)
+
+// HealthEvent signals an up/down transition of a monitored MCP server.
+type HealthEvent struct {
+	Server  string    `json:"server"`
+	Healthy bool      `json:"healthy"`
+	Error   string    `json:"error,omitempty"`
+	Time    time.Time `json:"time"`
+}
+
+// StartHealthMonitor starts a background loop that pings the server every
+// interval and returns a channel of up/down transitions, suitable for
+// surfacing in the TUI (e.g. as a toast). The monitor stops when the context
+// is cancelled.
+//
+// On failure the ping is retried with backoff before declaring the server
+// down. For stdio transports a failed request already resets the child
+// process, so the retry relaunches it; for HTTP transports the retry is the
+// reconnection attempt.
+func (c *Client) StartHealthMonitor(ctx context.Context, interval time.Duration) <-chan HealthEvent {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	events := make(chan HealthEvent, healthEventBuffer)
+
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		// Report the initial state immediately rather than waiting a full
+		// interval
+		c.checkOnce(ctx, events)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.checkOnce(ctx, events)
+			}
+		}
+	}()
+
+	return events
+}
+
+// IsHealthy reports the result of the most recent health check. It returns
+// false until the first check completes.
+func (c *Client) IsHealthy() bool {
+	c.healthMu.Lock()
+	defer c.healthMu.Unlock()
+	return c.healthy
+}
+
+// checkOnce pings the server with retries and records any state transition.
+func (c *Client) checkOnce(ctx context.Context, events chan<- HealthEvent) {
+	err := c.pingWithRetry(ctx)
+
+	c.healthMu.Lock()
+	healthy := err == nil
+	changed := !c.healthChecked || c.healthy != healthy
+	c.healthChecked = true
+	c.healthy = healthy
+	c.healthMu.Unlock()
+
+	if !changed {
+		return
+	}
+
+	event := HealthEvent{
+		Server:  c.server.Name,
+		Healthy: healthy,
+		Time:    time.Now(),
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	// Never block the monitor on a slow consumer
+	select {
+	case events <- event:
+	default:
+	}
+}
+
+// pingWithRetry pings the server, retrying with growing backoff on failure.
+func (c *Client) pingWithRetry(ctx context.Context) error {
+	var err error
+	for attempt := 0; attempt <= healthPingRetries; attempt++ {
+		if attempt > 0 {
+			backoff := time.Duration(attempt) * 250 * time.Millisecond
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+		}
+
+		if err = c.Ping(ctx); err == nil {
+			return nil
+		}
+	}
+	return err
+}
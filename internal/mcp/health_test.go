@@ -0,0 +1,149 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flippableTransport fails or succeeds on demand.
+type flippableTransport struct {
+	fail chan bool
+	mode bool
+}
+
+func newFlippableTransport(failing bool) *flippableTransport {
+	return &flippableTransport{fail: make(chan bool, 1), mode: failing}
+}
+
+func (t *flippableTransport) setFailing(failing bool) {
+	t.fail <- failing
+}
+
+func (t *flippableTransport) RoundTrip(ctx context.Context, req *JSONRPCRequest) (*JSONRPCResponse, error) {
+	select {
+	case mode := <-t.fail:
+		t.mode = mode
+	default:
+	}
+
+	if t.mode {
+		return nil, fmt.Errorf("server unavailable")
+	}
+	return &JSONRPCResponse{JSONRPC: "2.0", ID: req.ID}, nil
+}
+
+func (t *flippableTransport) Close() error {
+	return nil
+}
+
+// waitForEvent reads a health event or fails the test after a timeout.
+func waitForEvent(t *testing.T, events <-chan HealthEvent) HealthEvent {
+	t.Helper()
+
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for health event")
+		return HealthEvent{}
+	}
+}
+
+func TestStartHealthMonitor_TransitionEvents(t *testing.T) {
+	transport := newFlippableTransport(false)
+	client := &Client{
+		server:    &Server{Name: "monitored", Enabled: true},
+		transport: transport,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := client.StartHealthMonitor(ctx, 50*time.Millisecond)
+
+	// The initial check reports the server up
+	event := waitForEvent(t, events)
+	assert.Equal(t, "monitored", event.Server)
+	assert.True(t, event.Healthy)
+	assert.True(t, client.IsHealthy())
+
+	// Take the server down: the monitor reports the transition
+	transport.setFailing(true)
+	event = waitForEvent(t, events)
+	assert.False(t, event.Healthy)
+	assert.NotEmpty(t, event.Error)
+	assert.False(t, client.IsHealthy())
+
+	// Bring it back: one more transition
+	transport.setFailing(false)
+	event = waitForEvent(t, events)
+	assert.True(t, event.Healthy)
+	assert.True(t, client.IsHealthy())
+}
+
+func TestStartHealthMonitor_StopsOnCancel(t *testing.T) {
+	transport := newFlippableTransport(false)
+	client := &Client{
+		server:    &Server{Name: "monitored", Enabled: true},
+		transport: transport,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := client.StartHealthMonitor(ctx, 20*time.Millisecond)
+
+	waitForEvent(t, events)
+	cancel()
+
+	// The channel closes once the monitor exits
+	deadline := time.After(5 * time.Second)
+	for {
+		select {
+		case _, ok := <-events:
+			if !ok {
+				return
+			}
+		case <-deadline:
+			t.Fatal("health event channel never closed")
+		}
+	}
+}
+
+func TestIsHealthy_FalseBeforeFirstCheck(t *testing.T) {
+	client := &Client{
+		server:    &Server{Name: "unchecked", Enabled: true},
+		transport: newFlippableTransport(false),
+	}
+
+	assert.False(t, client.IsHealthy())
+}
+
+func TestStartHealthMonitor_StdioRelaunch(t *testing.T) {
+	skipWithoutUnixTools(t)
+
+	client := NewStdioClient("cat")
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := client.StartHealthMonitor(ctx, 50*time.Millisecond)
+
+	event := waitForEvent(t, events)
+	require.True(t, event.Healthy)
+
+	// Kill the child; the retry inside the next check relaunches it, so the
+	// server stays healthy (or recovers after at most one down transition)
+	transport := client.transport.(*stdioTransport)
+	transport.mu.Lock()
+	transport.cmd.Process.Kill()
+	transport.mu.Unlock()
+
+	require.Eventually(t, func() bool {
+		return client.IsHealthy()
+	}, 5*time.Second, 50*time.Millisecond)
+}
@@ -0,0 +1,60 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/AINative-studio/ainative-code/internal/logger"
+	"github.com/AINative-studio/ainative-code/internal/provider"
+)
+
+// ProviderTools fetches the server's tools via ListTools and converts them
+// into provider tool definitions so they can be offered to the LLM as
+// functions. When the model requests one of these tools, route the call back
+// through CallTool with the model-supplied arguments.
+//
+// The mapping is lossy: vendor extension keys ("x-*") in a tool's input
+// schema have no provider representation and are stripped with a warning.
+// Everything else passes through as the tool's parameter schema.
+func (c *Client) ProviderTools(ctx context.Context) ([]provider.ToolDefinition, error) {
+	tools, err := c.ListTools(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover tools: %w", err)
+	}
+
+	definitions := make([]provider.ToolDefinition, 0, len(tools))
+	for _, tool := range tools {
+		definitions = append(definitions, provider.ToolDefinition{
+			Name:        tool.Name,
+			Description: tool.Description,
+			Parameters:  providerSchema(c.server.Name, tool),
+		})
+	}
+
+	return definitions, nil
+}
+
+// providerSchema converts a tool's input schema to the provider parameter
+// shape, dropping fields the provider cannot represent.
+func providerSchema(serverName string, tool Tool) map[string]interface{} {
+	if tool.InputSchema == nil {
+		// Providers expect at least an empty object schema
+		return map[string]interface{}{"type": "object"}
+	}
+
+	schema := make(map[string]interface{}, len(tool.InputSchema))
+	for key, value := range tool.InputSchema {
+		if strings.HasPrefix(key, "x-") {
+			logger.WarnEvent().
+				Str("server", serverName).
+				Str("tool", tool.Name).
+				Str("field", key).
+				Msg("Dropping schema extension with no provider representation")
+			continue
+		}
+		schema[key] = value
+	}
+
+	return schema
+}
@@ -0,0 +1,84 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderTools(t *testing.T) {
+	transport := &fakeTransport{}
+	transport.handler = func(req *JSONRPCRequest) (*JSONRPCResponse, error) {
+		return &JSONRPCResponse{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Result: ListToolsResult{
+				Tools: []Tool{
+					{
+						Name:        "fetch",
+						Description: "Fetch a URL",
+						InputSchema: map[string]interface{}{
+							"type": "object",
+							"properties": map[string]interface{}{
+								"url": map[string]interface{}{"type": "string"},
+							},
+							"required":   []interface{}{"url"},
+							"x-no-cache": true,
+						},
+					},
+					{
+						Name:        "noop",
+						Description: "Does nothing",
+					},
+				},
+			},
+		}, nil
+	}
+
+	client := &Client{
+		server:    &Server{Name: "fake", Enabled: true},
+		transport: transport,
+	}
+
+	definitions, err := client.ProviderTools(context.Background())
+	require.NoError(t, err)
+	require.Len(t, definitions, 2)
+
+	fetch := definitions[0]
+	assert.Equal(t, "fetch", fetch.Name)
+	assert.Equal(t, "Fetch a URL", fetch.Description)
+	assert.Equal(t, "object", fetch.Parameters["type"])
+	assert.Contains(t, fetch.Parameters, "properties")
+	assert.Contains(t, fetch.Parameters, "required")
+
+	// Vendor extensions have no provider representation and are dropped
+	assert.NotContains(t, fetch.Parameters, "x-no-cache")
+
+	// Tools without a schema get an empty object schema
+	noop := definitions[1]
+	assert.Equal(t, "noop", noop.Name)
+	assert.Equal(t, map[string]interface{}{"type": "object"}, noop.Parameters)
+}
+
+func TestProviderTools_ListError(t *testing.T) {
+	transport := &fakeTransport{
+		handler: func(req *JSONRPCRequest) (*JSONRPCResponse, error) {
+			return &JSONRPCResponse{
+				JSONRPC: "2.0",
+				ID:      req.ID,
+				Error:   &RPCError{Code: -32000, Message: "server unavailable"},
+			}, nil
+		},
+	}
+
+	client := &Client{
+		server:    &Server{Name: "fake", Enabled: true},
+		transport: transport,
+	}
+
+	definitions, err := client.ProviderTools(context.Background())
+	assert.Error(t, err)
+	assert.Nil(t, definitions)
+}
@@ -132,6 +132,37 @@ func (r *Registry) AddServer(server *Server) error {
 	return nil
 }
 
+// Register adds an already-constructed client under the given name, without
+// persisting it to the config file. This is how stdio clients (and any other
+// non-HTTP transports) join the registry alongside configured HTTP servers.
+func (r *Registry) Register(name string, client *Client) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.servers[name]; exists {
+		return fmt.Errorf("server %s already registered", name)
+	}
+
+	r.servers[name] = client
+	return nil
+}
+
+// Close closes all registered clients, terminating any stdio child
+// processes. The first error encountered is returned.
+func (r *Registry) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var firstErr error
+	for name, client := range r.servers {
+		if err := client.Close(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("failed to close server %s: %w", name, err)
+		}
+	}
+
+	return firstErr
+}
+
 // RemoveServer removes an MCP server from the registry.
 func (r *Registry) RemoveServer(name string) error {
 	r.mu.Lock()
@@ -69,6 +69,115 @@ func TestAddServer_Duplicate(t *testing.T) {
 	assert.Contains(t, err.Error(), "already registered")
 }
 
+// toolServerTransport answers tools/list with the given tools and tools/call
+// with a text result naming the server.
+func toolServerTransport(serverName string, tools ...Tool) *fakeTransport {
+	transport := &fakeTransport{}
+	transport.handler = func(req *JSONRPCRequest) (*JSONRPCResponse, error) {
+		var result interface{}
+		switch req.Method {
+		case "tools/list":
+			result = ListToolsResult{Tools: tools}
+		default:
+			result = &ToolResult{
+				Content: []ResultContent{{Type: "text", Text: "handled by " + serverName}},
+			}
+		}
+		return &JSONRPCResponse{JSONRPC: "2.0", ID: req.ID, Result: result}, nil
+	}
+	return transport
+}
+
+func TestRegister(t *testing.T) {
+	registry := setupTestRegistry(t)
+
+	client := &Client{
+		server:    &Server{Name: "stdio-server", Enabled: true},
+		transport: toolServerTransport("stdio-server"),
+	}
+
+	err := registry.Register("stdio-server", client)
+	assert.NoError(t, err)
+	assert.Len(t, registry.servers, 1)
+
+	// Registered clients are not persisted to the config file
+	config, err := registry.configManager.LoadConfig()
+	if err == nil {
+		assert.NotContains(t, config.MCPServers, "stdio-server")
+	}
+
+	err = registry.Register("stdio-server", client)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "already registered")
+}
+
+func TestRegistry_SameToolNameOnTwoServers(t *testing.T) {
+	registry := setupTestRegistry(t)
+
+	readTool := Tool{Name: "read_file", Description: "Read a file"}
+
+	for _, name := range []string{"filesystem", "git"} {
+		client := &Client{
+			server:    &Server{Name: name, Enabled: true},
+			transport: toolServerTransport(name, readTool),
+		}
+		require.NoError(t, registry.Register(name, client))
+	}
+
+	require.NoError(t, registry.DiscoverTools(context.Background()))
+
+	// Both tools survive under their server-prefixed names
+	tools := registry.ListTools()
+	assert.Contains(t, tools, "filesystem.read_file")
+	assert.Contains(t, tools, "git.read_file")
+
+	// Calls route to the right server
+	result, err := registry.CallTool(context.Background(), "filesystem.read_file", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "handled by filesystem", result.Content[0].Text)
+
+	result, err = registry.CallTool(context.Background(), "git.read_file", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "handled by git", result.Content[0].Text)
+}
+
+func TestRegistry_Close(t *testing.T) {
+	registry := setupTestRegistry(t)
+
+	closed := make(map[string]bool)
+	for _, name := range []string{"one", "two"} {
+		name := name
+		transport := &fakeTransport{
+			handler: func(req *JSONRPCRequest) (*JSONRPCResponse, error) {
+				return &JSONRPCResponse{JSONRPC: "2.0", ID: req.ID}, nil
+			},
+		}
+		client := &Client{
+			server: &Server{Name: name, Enabled: true},
+			transport: &closeTrackingTransport{
+				Transport: transport,
+				onClose:   func() { closed[name] = true },
+			},
+		}
+		require.NoError(t, registry.Register(name, client))
+	}
+
+	require.NoError(t, registry.Close())
+	assert.True(t, closed["one"])
+	assert.True(t, closed["two"])
+}
+
+// closeTrackingTransport wraps a transport to observe Close calls.
+type closeTrackingTransport struct {
+	Transport
+	onClose func()
+}
+
+func (t *closeTrackingTransport) Close() error {
+	t.onClose()
+	return t.Transport.Close()
+}
+
 func TestRemoveServer(t *testing.T) {
 	registry := setupTestRegistry(t)
 
@@ -0,0 +1,184 @@
+package mcp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidArguments is returned by CallTool when the provided arguments
+// fail validation against the tool's declared input schema, before any
+// transport round trip is made.
+var ErrInvalidArguments = errors.New("invalid tool arguments")
+
+// toolSchema is the parsed, validation-ready form of a tool's JSON input
+// schema. Only the constraints the client enforces are retained: required
+// fields, property types, and enum values.
+type toolSchema struct {
+	required   []string
+	properties map[string]*propertySchema
+}
+
+// propertySchema holds the validated constraints for a single property.
+type propertySchema struct {
+	types []string
+	enum  []interface{}
+}
+
+// parseToolSchema extracts the client-enforced constraints from a tool's raw
+// JSON schema. Returns nil when the schema declares nothing to validate.
+func parseToolSchema(inputSchema map[string]interface{}) *toolSchema {
+	if inputSchema == nil {
+		return nil
+	}
+
+	schema := &toolSchema{
+		properties: make(map[string]*propertySchema),
+	}
+
+	if required, ok := inputSchema["required"].([]interface{}); ok {
+		for _, field := range required {
+			if name, ok := field.(string); ok {
+				schema.required = append(schema.required, name)
+			}
+		}
+	}
+
+	if properties, ok := inputSchema["properties"].(map[string]interface{}); ok {
+		for name, raw := range properties {
+			propMap, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			prop := &propertySchema{}
+
+			// "type" may be a single string or an array of strings
+			switch typed := propMap["type"].(type) {
+			case string:
+				prop.types = []string{typed}
+			case []interface{}:
+				for _, entry := range typed {
+					if typeName, ok := entry.(string); ok {
+						prop.types = append(prop.types, typeName)
+					}
+				}
+			}
+
+			if enum, ok := propMap["enum"].([]interface{}); ok {
+				prop.enum = enum
+			}
+
+			if len(prop.types) > 0 || len(prop.enum) > 0 {
+				schema.properties[name] = prop
+			}
+		}
+	}
+
+	if len(schema.required) == 0 && len(schema.properties) == 0 {
+		return nil
+	}
+
+	return schema
+}
+
+// validate checks arguments against the schema and returns an error wrapping
+// ErrInvalidArguments naming the offending field, or nil.
+func (s *toolSchema) validate(toolName string, arguments map[string]interface{}) error {
+	for _, field := range s.required {
+		if _, present := arguments[field]; !present {
+			return fmt.Errorf("%w: tool %q: missing required field %q", ErrInvalidArguments, toolName, field)
+		}
+	}
+
+	for field, value := range arguments {
+		prop, declared := s.properties[field]
+		if !declared {
+			// Undeclared fields are forwarded untouched; servers may accept
+			// more than their schema advertises
+			continue
+		}
+
+		if len(prop.types) > 0 && !matchesType(value, prop.types) {
+			return fmt.Errorf("%w: tool %q: field %q must be of type %s", ErrInvalidArguments, toolName, field, strings.Join(prop.types, " or "))
+		}
+
+		if len(prop.enum) > 0 && !matchesEnum(value, prop.enum) {
+			return fmt.Errorf("%w: tool %q: field %q must be one of %v", ErrInvalidArguments, toolName, field, prop.enum)
+		}
+	}
+
+	return nil
+}
+
+// matchesType reports whether value satisfies any of the JSON schema types.
+func matchesType(value interface{}, types []string) bool {
+	for _, typeName := range types {
+		if matchesSingleType(value, typeName) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesSingleType checks value against one JSON schema type, accepting both
+// json.Unmarshal shapes and the Go types callers construct by hand.
+func matchesSingleType(value interface{}, typeName string) bool {
+	switch typeName {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		return asFloat(value) != nil
+	case "integer":
+		f := asFloat(value)
+		return f != nil && *f == float64(int64(*f))
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "null":
+		return value == nil
+	default:
+		// Unknown type names are not enforced
+		return true
+	}
+}
+
+// asFloat normalizes the numeric types arguments arrive as, or returns nil.
+func asFloat(value interface{}) *float64 {
+	var f float64
+	switch number := value.(type) {
+	case float64:
+		f = number
+	case float32:
+		f = float64(number)
+	case int:
+		f = float64(number)
+	case int64:
+		f = float64(number)
+	default:
+		return nil
+	}
+	return &f
+}
+
+// matchesEnum reports whether value equals one of the enum entries, comparing
+// numbers by value so 2 matches 2.0.
+func matchesEnum(value interface{}, enum []interface{}) bool {
+	for _, candidate := range enum {
+		if value == candidate {
+			return true
+		}
+		valueNum, candidateNum := asFloat(value), asFloat(candidate)
+		if valueNum != nil && candidateNum != nil && *valueNum == *candidateNum {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSchemaTestClient builds a client over a fake transport that answers
+// tools/list with the given tools and tools/call with a fixed result.
+func newSchemaTestClient(tools []Tool) (*Client, *fakeTransport) {
+	transport := &fakeTransport{
+		handler: func(req *JSONRPCRequest) (*JSONRPCResponse, error) {
+			var result interface{}
+			switch req.Method {
+			case "tools/list":
+				result = ListToolsResult{Tools: tools}
+			default:
+				result = textResult("ok")
+			}
+			return &JSONRPCResponse{
+				JSONRPC: "2.0",
+				ID:      req.ID,
+				Result:  result,
+			}, nil
+		},
+	}
+
+	client := &Client{
+		server:    &Server{Name: "fake", Enabled: true},
+		transport: transport,
+	}
+
+	return client, transport
+}
+
+// fetchTool declares required fields, typed properties, and an enum.
+func fetchTool() Tool {
+	return Tool{
+		Name: "fetch",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"url":    map[string]interface{}{"type": "string"},
+				"depth":  map[string]interface{}{"type": "integer"},
+				"format": map[string]interface{}{"enum": []interface{}{"json", "text"}},
+			},
+			"required": []interface{}{"url"},
+		},
+	}
+}
+
+func TestCallTool_ValidatesAgainstSchema(t *testing.T) {
+	client, transport := newSchemaTestClient([]Tool{fetchTool()})
+
+	_, err := client.ListTools(context.Background())
+	require.NoError(t, err)
+	callsAfterList := transport.calls.Load()
+
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "valid arguments",
+			args: map[string]interface{}{"url": "https://example.com", "depth": 2, "format": "json"},
+		},
+		{
+			name:    "missing required field",
+			args:    map[string]interface{}{"depth": 2},
+			wantErr: `missing required field "url"`,
+		},
+		{
+			name:    "wrong type",
+			args:    map[string]interface{}{"url": 42},
+			wantErr: `field "url" must be of type string`,
+		},
+		{
+			name:    "non-integral number for integer",
+			args:    map[string]interface{}{"url": "https://example.com", "depth": 1.5},
+			wantErr: `field "depth" must be of type integer`,
+		},
+		{
+			name:    "enum violation",
+			args:    map[string]interface{}{"url": "https://example.com", "format": "xml"},
+			wantErr: `field "format" must be one of`,
+		},
+		{
+			name: "undeclared fields pass through",
+			args: map[string]interface{}{"url": "https://example.com", "extra": true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			callsBefore := transport.calls.Load()
+			_, err := client.CallTool(context.Background(), "fetch", tt.args)
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, callsBefore+1, transport.calls.Load())
+				return
+			}
+
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrInvalidArguments)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			// Validation failures must not reach the transport
+			assert.Equal(t, callsBefore, transport.calls.Load())
+		})
+	}
+
+	assert.Greater(t, transport.calls.Load(), callsAfterList)
+}
+
+func TestCallTool_UnknownToolSkipsValidation(t *testing.T) {
+	client, transport := newSchemaTestClient([]Tool{fetchTool()})
+
+	// Without ListTools no schemas are cached; arbitrary args go through
+	_, err := client.CallTool(context.Background(), "fetch", map[string]interface{}{"bogus": 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), transport.calls.Load())
+
+	// After ListTools, tools the server never listed are still unvalidated
+	_, err = client.ListTools(context.Background())
+	require.NoError(t, err)
+
+	_, err = client.CallTool(context.Background(), "unlisted", map[string]interface{}{"anything": "goes"})
+	assert.NoError(t, err)
+}
+
+func TestParseToolSchema(t *testing.T) {
+	t.Run("nil schema", func(t *testing.T) {
+		assert.Nil(t, parseToolSchema(nil))
+	})
+
+	t.Run("no enforceable constraints", func(t *testing.T) {
+		assert.Nil(t, parseToolSchema(map[string]interface{}{"type": "object"}))
+	})
+
+	t.Run("type as array of strings", func(t *testing.T) {
+		schema := parseToolSchema(map[string]interface{}{
+			"properties": map[string]interface{}{
+				"value": map[string]interface{}{"type": []interface{}{"string", "null"}},
+			},
+		})
+		require.NotNil(t, schema)
+
+		assert.NoError(t, schema.validate("tool", map[string]interface{}{"value": "ok"}))
+		assert.NoError(t, schema.validate("tool", map[string]interface{}{"value": nil}))
+		assert.ErrorIs(t, schema.validate("tool", map[string]interface{}{"value": 3}), ErrInvalidArguments)
+	})
+
+	t.Run("numeric enum matches across representations", func(t *testing.T) {
+		schema := parseToolSchema(map[string]interface{}{
+			"properties": map[string]interface{}{
+				"level": map[string]interface{}{"enum": []interface{}{float64(1), float64(2)}},
+			},
+		})
+		require.NotNil(t, schema)
+
+		// Hand-constructed int arguments match JSON-decoded float enums
+		assert.NoError(t, schema.validate("tool", map[string]interface{}{"level": 2}))
+		assert.ErrorIs(t, schema.validate("tool", map[string]interface{}{"level": 3}), ErrInvalidArguments)
+	})
+}
+
+func TestMatchesSingleType(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		typeName string
+		want     bool
+	}{
+		{"string", "hello", "string", true},
+		{"not string", 5, "string", false},
+		{"boolean", true, "boolean", true},
+		{"number from float", 1.5, "number", true},
+		{"number from int", 3, "number", true},
+		{"integer from float64", float64(4), "integer", true},
+		{"integer rejects fraction", 4.2, "integer", false},
+		{"array", []interface{}{1, 2}, "array", true},
+		{"object", map[string]interface{}{}, "object", true},
+		{"null", nil, "null", true},
+		{"unknown type unenforced", "anything", "custom", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchesSingleType(tt.value, tt.typeName))
+		})
+	}
+}
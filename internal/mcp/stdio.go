@@ -0,0 +1,170 @@
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+)
+
+// stdioTransport speaks JSON-RPC to an MCP server running as a subprocess,
+// framing messages as newline-delimited JSON over stdin/stdout. The process
+// is started lazily and restarted if it exits.
+type stdioTransport struct {
+	command string
+	args    []string
+
+	mu     sync.Mutex
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Reader
+}
+
+// newStdioTransport creates a transport for the given server command. The
+// process is not started until the first request.
+func newStdioTransport(command string, args ...string) *stdioTransport {
+	return &stdioTransport{
+		command: command,
+		args:    args,
+	}
+}
+
+// RoundTrip sends a request over the child's stdin and reads responses from
+// its stdout until one matches the request ID. Notifications and unrelated
+// messages are skipped. If the process has exited, it is restarted first.
+func (t *stdioTransport) RoundTrip(ctx context.Context, req *JSONRPCRequest) (*JSONRPCResponse, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.ensureStarted(); err != nil {
+		return nil, err
+	}
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	reqBody = append(reqBody, '\n')
+
+	if _, err := t.stdin.Write(reqBody); err != nil {
+		t.reset()
+		return nil, fmt.Errorf("failed to write to MCP server: %w", err)
+	}
+
+	type readResult struct {
+		resp *JSONRPCResponse
+		err  error
+	}
+	resultChan := make(chan readResult, 1)
+
+	// Capture the reader so an abandoned goroutine never touches state that
+	// reset() has replaced
+	stdout := t.stdout
+	go func() {
+		resp, err := readResponse(stdout, req.ID)
+		resultChan <- readResult{resp: resp, err: err}
+	}()
+
+	select {
+	case res := <-resultChan:
+		if res.err != nil {
+			// A read failure usually means the process died; reset so the
+			// next call restarts it
+			t.reset()
+			return nil, res.err
+		}
+		return res.resp, nil
+	case <-ctx.Done():
+		// Abandon the process: the pending reader goroutine holds its
+		// stdout, so a fresh process is the only safe way forward
+		t.reset()
+		return nil, ctx.Err()
+	}
+}
+
+// readResponse reads newline-framed messages until one carries the given
+// request ID.
+func readResponse(stdout *bufio.Reader, id interface{}) (*JSONRPCResponse, error) {
+	wantID := fmt.Sprintf("%v", id)
+
+	for {
+		line, err := stdout.ReadBytes('\n')
+		if err != nil {
+			return nil, fmt.Errorf("failed to read from MCP server: %w", err)
+		}
+
+		var resp JSONRPCResponse
+		if err := json.Unmarshal(line, &resp); err != nil {
+			// Skip lines that are not JSON-RPC messages (e.g. stray logging)
+			continue
+		}
+
+		// Skip notifications and responses to other requests
+		if resp.ID == nil || fmt.Sprintf("%v", resp.ID) != wantID {
+			continue
+		}
+
+		return &resp, nil
+	}
+}
+
+// ensureStarted launches the child process if it is not running.
+func (t *stdioTransport) ensureStarted() error {
+	if t.cmd != nil {
+		return nil
+	}
+
+	cmd := exec.Command(t.command, t.args...)
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return fmt.Errorf("failed to open stdin pipe: %w", err)
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		stdin.Close()
+		return fmt.Errorf("failed to open stdout pipe: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		stdin.Close()
+		return fmt.Errorf("failed to start MCP server %s: %w", t.command, err)
+	}
+
+	t.cmd = cmd
+	t.stdin = stdin
+	t.stdout = bufio.NewReader(stdout)
+
+	// Reap the process when it exits so it does not become a zombie
+	go func() {
+		cmd.Wait()
+	}()
+
+	return nil
+}
+
+// reset tears down the current process state so the next request starts a
+// fresh one.
+func (t *stdioTransport) reset() {
+	if t.stdin != nil {
+		t.stdin.Close()
+	}
+	if t.cmd != nil && t.cmd.Process != nil {
+		t.cmd.Process.Kill()
+	}
+	t.cmd = nil
+	t.stdin = nil
+	t.stdout = nil
+}
+
+// Close terminates the child process if it is running.
+func (t *stdioTransport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.reset()
+	return nil
+}
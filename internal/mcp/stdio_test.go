@@ -0,0 +1,129 @@
+package mcp
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// skipWithoutUnixTools skips tests that rely on cat/sh being present.
+func skipWithoutUnixTools(t *testing.T) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("stdio transport tests require unix shell tools")
+	}
+}
+
+func TestNewStdioClient(t *testing.T) {
+	client := NewStdioClient("cat")
+	assert.NotNil(t, client)
+	assert.Equal(t, "cat", client.GetServer().Name)
+	assert.IsType(t, &stdioTransport{}, client.transport)
+}
+
+func TestStdioTransport_RoundTrip(t *testing.T) {
+	skipWithoutUnixTools(t)
+
+	// cat echoes each request line back; the echoed message carries the
+	// request's ID, so it parses as that request's response
+	client := NewStdioClient("cat")
+	defer client.Close()
+
+	err := client.Ping(context.Background())
+	assert.NoError(t, err)
+
+	// IDs keep matching across sequential requests
+	err = client.Ping(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestStdioTransport_SkipsNoiseAndNotifications(t *testing.T) {
+	skipWithoutUnixTools(t)
+
+	// The server emits a non-JSON line and a notification before each real
+	// response; both must be skipped
+	script := `while IFS= read -r line; do
+		echo "starting up..."
+		echo '{"jsonrpc":"2.0","method":"log/message"}'
+		echo "$line"
+	done`
+
+	client := NewStdioClient("sh", "-c", script)
+	defer client.Close()
+
+	err := client.Ping(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestStdioTransport_RestartsAfterExit(t *testing.T) {
+	skipWithoutUnixTools(t)
+
+	client := NewStdioClient("cat")
+	defer client.Close()
+
+	require.NoError(t, client.Ping(context.Background()))
+
+	// Kill the child out from under the transport
+	transport := client.transport.(*stdioTransport)
+	transport.mu.Lock()
+	require.NotNil(t, transport.cmd)
+	transport.cmd.Process.Kill()
+	transport.mu.Unlock()
+
+	// The first call after the crash fails and resets the transport
+	var err error
+	for i := 0; i < 20; i++ {
+		if err = client.Ping(context.Background()); err != nil {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	require.Error(t, err)
+
+	// The next call starts a fresh process
+	assert.NoError(t, client.Ping(context.Background()))
+}
+
+func TestStdioTransport_CloseTerminatesProcess(t *testing.T) {
+	skipWithoutUnixTools(t)
+
+	client := NewStdioClient("cat")
+	require.NoError(t, client.Ping(context.Background()))
+
+	transport := client.transport.(*stdioTransport)
+	require.NoError(t, client.Close())
+
+	transport.mu.Lock()
+	assert.Nil(t, transport.cmd)
+	transport.mu.Unlock()
+
+	// A call after Close simply starts a new process
+	assert.NoError(t, client.Ping(context.Background()))
+	assert.NoError(t, client.Close())
+}
+
+func TestStdioTransport_StartFailure(t *testing.T) {
+	client := NewStdioClient("/nonexistent/mcp-server-binary")
+	defer client.Close()
+
+	err := client.Ping(context.Background())
+	assert.Error(t, err)
+}
+
+func TestStdioTransport_ContextCancellation(t *testing.T) {
+	skipWithoutUnixTools(t)
+
+	// A server that never responds
+	client := NewStdioClient("sh", "-c", "while IFS= read -r line; do :; done")
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	err := client.Ping(ctx)
+	assert.Error(t, err)
+}
@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
+	"github.com/AINative-studio/ainative-code/internal/cache"
 	"github.com/AINative-studio/ainative-code/internal/logger"
 	"github.com/AINative-studio/ainative-code/internal/provider"
 )
@@ -47,6 +49,7 @@ type AnthropicProvider struct {
 	*provider.BaseProvider
 	apiKey  string
 	baseURL string
+	cache   *cache.Manager
 }
 
 // Config contains configuration for the Anthropic provider
@@ -55,6 +58,7 @@ type Config struct {
 	BaseURL    string
 	HTTPClient *http.Client
 	Logger     logger.LoggerInterface
+	Cache      *cache.Manager // Optional prompt cache manager
 }
 
 // NewAnthropicProvider creates a new Anthropic provider instance
@@ -79,6 +83,7 @@ func NewAnthropicProvider(config Config) (*AnthropicProvider, error) {
 		BaseProvider: baseProvider,
 		apiKey:       config.APIKey,
 		baseURL:      baseURL,
+		cache:        config.Cache,
 	}, nil
 }
 
@@ -96,10 +101,20 @@ func (a *AnthropicProvider) Models() []string {
 
 // Chat sends a chat request to the Anthropic API
 func (a *AnthropicProvider) Chat(ctx context.Context, messages []provider.Message, opts ...provider.ChatOption) (provider.Response, error) {
+	// Image parts are not supported by this provider
+	if provider.MessagesContainImages(messages) {
+		return provider.Response{}, provider.ErrMultimodalUnsupported
+	}
+
 	// Apply options
 	options := provider.DefaultChatOptions()
 	provider.ApplyChatOptions(options, opts...)
 
+	// Seeds and structured response formats are not supported by this provider
+	if provider.OptionsRequireStructuredSupport(options) {
+		return provider.Response{}, provider.ErrUnsupportedOption
+	}
+
 	// Validate model
 	if err := a.ValidateModel(options.Model, supportedModels); err != nil {
 		return provider.Response{}, err
@@ -135,10 +150,20 @@ func (a *AnthropicProvider) Chat(ctx context.Context, messages []provider.Messag
 
 // Stream sends a streaming chat request to the Anthropic API
 func (a *AnthropicProvider) Stream(ctx context.Context, messages []provider.Message, opts ...provider.StreamOption) (<-chan provider.Event, error) {
+	// Image parts are not supported by this provider
+	if provider.MessagesContainImages(messages) {
+		return nil, provider.ErrMultimodalUnsupported
+	}
+
 	// Apply options
 	options := provider.DefaultChatOptions()
 	provider.ApplyStreamOptions(options, opts...)
 
+	// Seeds and structured response formats are not supported by this provider
+	if provider.OptionsRequireStructuredSupport(options) {
+		return nil, provider.ErrUnsupportedOption
+	}
+
 	// Validate model
 	if err := a.ValidateModel(options.Model, supportedModels); err != nil {
 		return nil, err
@@ -192,7 +217,7 @@ func (a *AnthropicProvider) buildRequest(ctx context.Context, messages []provide
 
 	// Add optional fields
 	if systemPrompt != "" {
-		reqBody.System = systemPrompt
+		reqBody.System = a.buildSystemPrompt(systemPrompt, options.Model)
 	}
 	if options.Temperature > 0 {
 		reqBody.Temperature = &options.Temperature
@@ -204,6 +229,16 @@ func (a *AnthropicProvider) buildRequest(ctx context.Context, messages []provide
 		reqBody.StopSequences = options.StopSequences
 	}
 
+	// Extended thinking is enabled via metadata (provider-specific option)
+	if budgetStr, ok := options.Metadata["thinking_budget"]; ok {
+		if budget, err := strconv.Atoi(budgetStr); err == nil && budget > 0 {
+			reqBody.Thinking = &thinkingConfig{
+				Type:         "enabled",
+				BudgetTokens: budget,
+			}
+		}
+	}
+
 	// Marshal request body
 	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
@@ -225,6 +260,40 @@ func (a *AnthropicProvider) buildRequest(ctx context.Context, messages []provide
 	return req, nil
 }
 
+// buildSystemPrompt returns the system prompt in the form expected by the
+// API. When a cache manager is configured and the prompt qualifies, the
+// prompt is sent as a content block marked with an ephemeral cache_control
+// breakpoint so Anthropic's prompt caching can reuse it across requests.
+func (a *AnthropicProvider) buildSystemPrompt(systemPrompt, model string) interface{} {
+	if a.cache == nil {
+		return systemPrompt
+	}
+
+	control := a.cache.ShouldCache(&cache.CacheableContent{
+		Type:    "system",
+		Content: systemPrompt,
+		Length:  len(systemPrompt),
+	})
+	if control == nil {
+		return systemPrompt
+	}
+
+	if a.cache.IsCached(control.CacheKey) {
+		a.cache.RecordCacheHitFor(control.CacheKey, cache.CategorySystemPrompt, model)
+	} else {
+		a.cache.RecordCacheMissFor(control.CacheKey, cache.CategorySystemPrompt, model)
+		a.cache.RecordCached(control.CacheKey, int64(len(systemPrompt)))
+	}
+
+	return []anthropicContent{
+		{
+			Type:         "text",
+			Text:         systemPrompt,
+			CacheControl: &cacheControl{Type: "ephemeral"},
+		},
+	}
+}
+
 // convertMessages converts provider messages to Anthropic API format
 func (a *AnthropicProvider) convertMessages(messages []provider.Message, systemPrompt string) ([]anthropicMessage, string) {
 	var apiMessages []anthropicMessage
@@ -340,6 +409,12 @@ func (a *AnthropicProvider) streamResponse(ctx context.Context, body io.ReadClos
 			return
 		}
 
+		// Handle thinking events (extended thinking models)
+		if isThinkingEvent(event.eventType) {
+			a.handleThinkingEvent(event, eventChan)
+			continue
+		}
+
 		// Handle different event types
 		switch event.eventType {
 		case "message_start":
@@ -358,6 +433,13 @@ func (a *AnthropicProvider) streamResponse(ctx context.Context, body io.ReadClos
 					Type:    provider.EventTypeContentDelta,
 					Content: delta.Delta.Text,
 				}
+			} else if delta.Delta.Type == "thinking_delta" {
+				if block, err := parseThinkingBlockDelta(event.data); err == nil && block != nil {
+					eventChan <- provider.Event{
+						Type:    provider.EventTypeThinking,
+						Content: block.Content,
+					}
+				}
 			}
 
 		case "message_delta":
@@ -390,6 +472,31 @@ func (a *AnthropicProvider) streamResponse(ctx context.Context, body io.ReadClos
 	}
 }
 
+// handleThinkingEvent parses a thinking-related SSE event and forwards it on
+// the event channel as an EventTypeThinking event
+func (a *AnthropicProvider) handleThinkingEvent(event *sseEvent, eventChan chan<- provider.Event) {
+	var block *provider.ThinkingBlock
+	var err error
+
+	switch event.eventType {
+	case "thinking_block_start":
+		block, err = parseThinkingBlockStart(event.data)
+	case "thinking_block_delta":
+		block, err = parseThinkingBlockDelta(event.data)
+	case "thinking_block_stop":
+		block, err = parseThinkingBlockStop(event.data)
+	}
+
+	if err != nil || block == nil || block.Content == "" {
+		return
+	}
+
+	eventChan <- provider.Event{
+		Type:    provider.EventTypeThinking,
+		Content: block.Content,
+	}
+}
+
 // handleAPIError converts Anthropic API errors to provider errors
 func (a *AnthropicProvider) handleAPIError(resp *http.Response, body []byte, model string) error {
 	var apiErr anthropicError
@@ -428,6 +535,10 @@ func (a *AnthropicProvider) convertAPIError(apiErr *anthropicError, model string
 		}
 		return provider.NewProviderError("anthropic", model, fmt.Errorf("invalid request: %s", apiErr.Error.Message))
 
+	case "api_error", "overloaded_error":
+		// Server-side failures; the API reports these by type without a status
+		return provider.NewServerError("anthropic", 0, apiErr.Error.Message)
+
 	default:
 		return provider.NewProviderError("anthropic", model, fmt.Errorf("%s: %s", apiErr.Error.Type, apiErr.Error.Message))
 	}
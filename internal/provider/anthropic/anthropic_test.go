@@ -673,6 +673,34 @@ func TestAnthropicProvider_ConvertAPIError(t *testing.T) {
 			},
 			errorType: "context_length",
 		},
+		{
+			name: "api error - server side failure",
+			apiErr: &anthropicError{
+				Type: "error",
+				Error: struct {
+					Type    string `json:"type"`
+					Message string `json:"message"`
+				}{
+					Type:    "api_error",
+					Message: "An unexpected error has occurred internal to Anthropic's systems",
+				},
+			},
+			errorType: "server",
+		},
+		{
+			name: "overloaded error - server side failure",
+			apiErr: &anthropicError{
+				Type: "error",
+				Error: struct {
+					Type    string `json:"type"`
+					Message string `json:"message"`
+				}{
+					Type:    "overloaded_error",
+					Message: "Overloaded",
+				},
+			},
+			errorType: "server",
+		},
 		{
 			name: "generic invalid request",
 			apiErr: &anthropicError{
@@ -721,6 +749,10 @@ func TestAnthropicProvider_ConvertAPIError(t *testing.T) {
 			case "context_length":
 				var contextErr *provider.ContextLengthError
 				assert.ErrorAs(t, err, &contextErr)
+			case "server":
+				var serverErr *provider.ServerError
+				assert.ErrorAs(t, err, &serverErr)
+				assert.ErrorIs(t, err, provider.ErrServerError)
 			case "provider":
 				var providerErr *provider.ProviderError
 				assert.ErrorAs(t, err, &providerErr)
@@ -848,3 +880,20 @@ func TestAnthropicProvider_BuildRequest(t *testing.T) {
 	assert.Equal(t, 0.9, *reqBody.TopP)
 	assert.Equal(t, []string{"STOP"}, reqBody.StopSequences)
 }
+
+func TestAnthropicProvider_UnsupportedStructuredOptions(t *testing.T) {
+	p, err := NewAnthropicProvider(Config{APIKey: "test-key"})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	messages := []provider.Message{{Role: "user", Content: "extract"}}
+
+	_, err = p.Chat(ctx, messages, provider.WithSeed(42))
+	assert.ErrorIs(t, err, provider.ErrUnsupportedOption)
+
+	_, err = p.Chat(ctx, messages, provider.WithResponseFormat(provider.ResponseFormatJSON))
+	assert.ErrorIs(t, err, provider.ErrUnsupportedOption)
+
+	_, err = p.Stream(ctx, messages, provider.StreamWithSeed(42))
+	assert.ErrorIs(t, err, provider.ErrUnsupportedOption)
+}
@@ -5,12 +5,24 @@ type anthropicRequest struct {
 	Model         string             `json:"model"`
 	Messages      []anthropicMessage `json:"messages"`
 	MaxTokens     int                `json:"max_tokens"`
-	System        string             `json:"system,omitempty"`
+	System        interface{}        `json:"system,omitempty"` // string or []anthropicContent with cache_control
 	Temperature   *float64           `json:"temperature,omitempty"`
 	TopP          *float64           `json:"top_p,omitempty"`
 	StopSequences []string           `json:"stop_sequences,omitempty"`
 	Stream        bool               `json:"stream"`
 	Metadata      map[string]string  `json:"metadata,omitempty"`
+	Thinking      *thinkingConfig    `json:"thinking,omitempty"`
+}
+
+// thinkingConfig enables extended thinking on supported Claude models
+type thinkingConfig struct {
+	Type         string `json:"type"` // "enabled"
+	BudgetTokens int    `json:"budget_tokens,omitempty"`
+}
+
+// cacheControl marks a content block for Anthropic prompt caching
+type cacheControl struct {
+	Type string `json:"type"` // "ephemeral"
 }
 
 // anthropicMessage represents a message in the Anthropic API format
@@ -21,8 +33,9 @@ type anthropicMessage struct {
 
 // anthropicContent represents content within a message
 type anthropicContent struct {
-	Type string `json:"type"`
-	Text string `json:"text,omitempty"`
+	Type         string        `json:"type"`
+	Text         string        `json:"text,omitempty"`
+	CacheControl *cacheControl `json:"cache_control,omitempty"`
 }
 
 // anthropicResponse represents a response from the Anthropic Messages API
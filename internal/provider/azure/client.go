@@ -59,10 +59,20 @@ func (a *AzureProvider) Models() []string {
 
 // Chat sends a chat request to Azure OpenAI
 func (a *AzureProvider) Chat(ctx context.Context, messages []provider.Message, opts ...provider.ChatOption) (provider.Response, error) {
+	// Image parts are not supported by this provider
+	if provider.MessagesContainImages(messages) {
+		return provider.Response{}, provider.ErrMultimodalUnsupported
+	}
+
 	// Apply options
 	options := provider.DefaultChatOptions()
 	provider.ApplyChatOptions(options, opts...)
 
+	// Seeds and structured response formats are not supported by this provider
+	if provider.OptionsRequireStructuredSupport(options) {
+		return provider.Response{}, provider.ErrUnsupportedOption
+	}
+
 	// Build request
 	req, err := a.buildRequest(ctx, messages, options, false)
 	if err != nil {
@@ -94,10 +104,20 @@ func (a *AzureProvider) Chat(ctx context.Context, messages []provider.Message, o
 
 // Stream sends a streaming chat request to Azure OpenAI
 func (a *AzureProvider) Stream(ctx context.Context, messages []provider.Message, opts ...provider.StreamOption) (<-chan provider.Event, error) {
+	// Image parts are not supported by this provider
+	if provider.MessagesContainImages(messages) {
+		return nil, provider.ErrMultimodalUnsupported
+	}
+
 	// Apply stream options
 	options := provider.DefaultChatOptions()
 	provider.ApplyStreamOptions(options, opts...)
 
+	// Seeds and structured response formats are not supported by this provider
+	if provider.OptionsRequireStructuredSupport(options) {
+		return nil, provider.ErrUnsupportedOption
+	}
+
 	// Build streaming request
 	req, err := a.buildRequest(ctx, messages, options, true)
 	if err != nil {
@@ -293,6 +313,9 @@ func (a *AzureProvider) handleError(resp *http.Response, body []byte) error {
 	case 400:
 		return provider.NewProviderError("azure", a.config.Deployment, fmt.Errorf("validation error: %s", message))
 	default:
+		if resp.StatusCode >= 500 {
+			return provider.NewServerError("azure", resp.StatusCode, message)
+		}
 		return provider.NewProviderError("azure", a.config.Deployment, fmt.Errorf("HTTP %d: %s", resp.StatusCode, message))
 	}
 }
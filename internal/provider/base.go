@@ -261,6 +261,9 @@ func (b *BaseProvider) HandleHTTPError(resp *http.Response, body []byte) error {
 		return NewProviderError(b.name, "", fmt.Errorf("bad request: %s", string(body)))
 
 	default:
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return NewServerError(b.name, resp.StatusCode, string(body))
+		}
 		return NewProviderError(b.name, "", fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body)))
 	}
 }
@@ -545,7 +545,7 @@ func TestHandleHTTPError(t *testing.T) {
 			statusCode:    500,
 			body:          "Server error",
 			expectedError: "HTTP 500",
-			errorType:     &ProviderError{},
+			errorType:     &ServerError{},
 		},
 	}
 
@@ -581,6 +581,11 @@ func TestHandleHTTPError(t *testing.T) {
 				if !errors.As(err, &rateLimitErr) {
 					t.Errorf("expected error type *RateLimitError, got %T", err)
 				}
+			case *ServerError:
+				var serverErr *ServerError
+				if !errors.As(err, &serverErr) {
+					t.Errorf("expected error type *ServerError, got %T", err)
+				}
 			case *ProviderError:
 				var provErr *ProviderError
 				if !errors.As(err, &provErr) {
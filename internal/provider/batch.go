@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ChatRequest bundles the arguments of a single Chat call so that
+// independent prompts can be submitted together as a batch.
+type ChatRequest struct {
+	// Messages is the conversation to send, as for Provider.Chat.
+	Messages []Message
+
+	// Opts are the per-request chat options, as for Provider.Chat.
+	Opts []ChatOption
+}
+
+// BatchResult holds the outcome of one request in a batch. Err is nil when
+// the request succeeded; a failed request does not fail the whole batch.
+type BatchResult struct {
+	// Index is the position of the originating request in the input slice.
+	Index int
+
+	// Response is the provider response. Only meaningful when Err is nil.
+	Response Response
+
+	// Err is the error returned by the provider for this request, if any.
+	Err error
+}
+
+// BatchChat runs independent chat requests against a provider with up to
+// concurrency calls in flight at once. Results are returned in input order
+// regardless of completion order, and per-request failures are captured in
+// BatchResult.Err so one bad prompt doesn't sink the rest of the batch.
+//
+// The concurrency bound is the batch-level throttle: keep it at or below the
+// provider's rate limit to avoid 429s. Providers built on BaseProvider still
+// apply their own retry-with-backoff on rate-limit responses, so transient
+// 429s inside the batch are retried per request as usual.
+//
+// When the context is cancelled, no new requests are launched; requests that
+// never ran have their Err set to the context error. BatchChat returns an
+// error only when it cannot run at all (nil provider); partial failures are
+// reported through the results.
+func BatchChat(ctx context.Context, p Provider, requests []ChatRequest, concurrency int) ([]BatchResult, error) {
+	if p == nil {
+		return nil, errors.New("batch chat: provider must not be nil")
+	}
+	if len(requests) == 0 {
+		return nil, nil
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if concurrency > len(requests) {
+		concurrency = len(requests)
+	}
+
+	results := make([]BatchResult, len(requests))
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	launched := 0
+	for i, req := range requests {
+		// Stop launching new requests once the context is done; the
+		// remaining slots are filled in with the context error below.
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, req ChatRequest) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := p.Chat(ctx, req.Messages, req.Opts...)
+			results[i] = BatchResult{Index: i, Response: resp, Err: err}
+		}(i, req)
+		launched = i + 1
+	}
+	wg.Wait()
+
+	// Requests launched before cancellation are filled in by their workers
+	// (typically with the context error from Chat); mark the rest here.
+	for i := launched; i < len(requests); i++ {
+		results[i] = BatchResult{Index: i, Err: ctx.Err()}
+	}
+
+	return results, nil
+}
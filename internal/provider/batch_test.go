@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// batchProvider is a Provider stub whose Chat echoes the prompt and records
+// how many calls are in flight at once.
+type batchProvider struct {
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+	delay       time.Duration
+	failOn      string
+}
+
+func (b *batchProvider) Chat(ctx context.Context, messages []Message, opts ...ChatOption) (Response, error) {
+	b.mu.Lock()
+	b.inFlight++
+	if b.inFlight > b.maxInFlight {
+		b.maxInFlight = b.inFlight
+	}
+	b.mu.Unlock()
+
+	defer func() {
+		b.mu.Lock()
+		b.inFlight--
+		b.mu.Unlock()
+	}()
+
+	if b.delay > 0 {
+		select {
+		case <-time.After(b.delay):
+		case <-ctx.Done():
+			return Response{}, ctx.Err()
+		}
+	}
+
+	prompt := ""
+	if len(messages) > 0 {
+		prompt = messages[len(messages)-1].Content
+	}
+	if b.failOn != "" && prompt == b.failOn {
+		return Response{}, errors.New("simulated failure")
+	}
+
+	return Response{Content: "echo: " + prompt, Model: "batch-model"}, nil
+}
+
+func (b *batchProvider) Stream(ctx context.Context, messages []Message, opts ...StreamOption) (<-chan Event, error) {
+	return nil, NewStreamingNotSupportedError("batch", "batch-model", "not needed for batch tests")
+}
+
+func (b *batchProvider) Name() string     { return "batch" }
+func (b *batchProvider) Models() []string { return []string{"batch-model"} }
+func (b *batchProvider) Close() error     { return nil }
+
+func batchRequests(n int) []ChatRequest {
+	requests := make([]ChatRequest, n)
+	for i := range requests {
+		requests[i] = ChatRequest{
+			Messages: []Message{{Role: "user", Content: fmt.Sprintf("prompt-%d", i)}},
+		}
+	}
+	return requests
+}
+
+func TestBatchChatPreservesOrder(t *testing.T) {
+	p := &batchProvider{delay: time.Millisecond}
+	requests := batchRequests(10)
+
+	results, err := BatchChat(context.Background(), p, requests, 4)
+	if err != nil {
+		t.Fatalf("BatchChat() error = %v", err)
+	}
+	if len(results) != len(requests) {
+		t.Fatalf("len(results) = %d, want %d", len(results), len(requests))
+	}
+
+	for i, result := range results {
+		if result.Index != i {
+			t.Errorf("results[%d].Index = %d, want %d", i, result.Index, i)
+		}
+		if result.Err != nil {
+			t.Errorf("results[%d].Err = %v, want nil", i, result.Err)
+		}
+		want := fmt.Sprintf("echo: prompt-%d", i)
+		if result.Response.Content != want {
+			t.Errorf("results[%d].Response.Content = %q, want %q", i, result.Response.Content, want)
+		}
+	}
+}
+
+func TestBatchChatRespectsConcurrencyBound(t *testing.T) {
+	p := &batchProvider{delay: 10 * time.Millisecond}
+	requests := batchRequests(12)
+
+	if _, err := BatchChat(context.Background(), p, requests, 3); err != nil {
+		t.Fatalf("BatchChat() error = %v", err)
+	}
+
+	if p.maxInFlight > 3 {
+		t.Errorf("maxInFlight = %d, want at most 3", p.maxInFlight)
+	}
+}
+
+func TestBatchChatCapturesPerRequestErrors(t *testing.T) {
+	p := &batchProvider{failOn: "prompt-2"}
+	requests := batchRequests(5)
+
+	results, err := BatchChat(context.Background(), p, requests, 2)
+	if err != nil {
+		t.Fatalf("BatchChat() error = %v", err)
+	}
+
+	for i, result := range results {
+		if i == 2 {
+			if result.Err == nil {
+				t.Errorf("results[2].Err = nil, want simulated failure")
+			}
+			continue
+		}
+		if result.Err != nil {
+			t.Errorf("results[%d].Err = %v, want nil", i, result.Err)
+		}
+	}
+}
+
+func TestBatchChatContextCancellation(t *testing.T) {
+	p := &batchProvider{delay: 50 * time.Millisecond}
+	requests := batchRequests(20)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var cancelled atomic.Bool
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancelled.Store(true)
+		cancel()
+	}()
+
+	results, err := BatchChat(ctx, p, requests, 2)
+	if err != nil {
+		t.Fatalf("BatchChat() error = %v", err)
+	}
+	if !cancelled.Load() {
+		t.Fatal("batch finished before cancellation fired; increase the delay")
+	}
+
+	// Later requests must not have run at all and should carry the
+	// context error.
+	last := results[len(results)-1]
+	if !errors.Is(last.Err, context.Canceled) {
+		t.Errorf("last result Err = %v, want context.Canceled", last.Err)
+	}
+}
+
+func TestBatchChatValidation(t *testing.T) {
+	if _, err := BatchChat(context.Background(), nil, batchRequests(1), 1); err == nil {
+		t.Error("BatchChat() with nil provider should return an error")
+	}
+
+	results, err := BatchChat(context.Background(), &batchProvider{}, nil, 1)
+	if err != nil {
+		t.Errorf("BatchChat() with no requests error = %v, want nil", err)
+	}
+	if results != nil {
+		t.Errorf("BatchChat() with no requests = %v, want nil results", results)
+	}
+
+	// Zero and negative concurrency are clamped rather than rejected.
+	if _, err := BatchChat(context.Background(), &batchProvider{}, batchRequests(2), 0); err != nil {
+		t.Errorf("BatchChat() with zero concurrency error = %v, want nil", err)
+	}
+}
@@ -81,10 +81,20 @@ func (b *BedrockProvider) Models() []string {
 
 // Chat sends a chat request to the Bedrock API
 func (b *BedrockProvider) Chat(ctx context.Context, messages []provider.Message, opts ...provider.ChatOption) (provider.Response, error) {
+	// Image parts are not supported by this provider
+	if provider.MessagesContainImages(messages) {
+		return provider.Response{}, provider.ErrMultimodalUnsupported
+	}
+
 	// Apply options
 	options := provider.DefaultChatOptions()
 	provider.ApplyChatOptions(options, opts...)
 
+	// Seeds and structured response formats are not supported by this provider
+	if provider.OptionsRequireStructuredSupport(options) {
+		return provider.Response{}, provider.ErrUnsupportedOption
+	}
+
 	// Validate model
 	if err := b.ValidateModel(options.Model, supportedModels); err != nil {
 		return provider.Response{}, err
@@ -120,10 +130,20 @@ func (b *BedrockProvider) Chat(ctx context.Context, messages []provider.Message,
 
 // Stream sends a streaming chat request to the Bedrock API
 func (b *BedrockProvider) Stream(ctx context.Context, messages []provider.Message, opts ...provider.StreamOption) (<-chan provider.Event, error) {
+	// Image parts are not supported by this provider
+	if provider.MessagesContainImages(messages) {
+		return nil, provider.ErrMultimodalUnsupported
+	}
+
 	// Apply options
 	options := provider.DefaultChatOptions()
 	provider.ApplyStreamOptions(options, opts...)
 
+	// Seeds and structured response formats are not supported by this provider
+	if provider.OptionsRequireStructuredSupport(options) {
+		return nil, provider.ErrUnsupportedOption
+	}
+
 	// Validate model
 	if err := b.ValidateModel(options.Model, supportedModels); err != nil {
 		return nil, err
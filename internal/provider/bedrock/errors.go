@@ -54,6 +54,11 @@ func parseBedrockError(statusCode int, body []byte, model string) error {
 		return provider.NewProviderError("bedrock", model, fmt.Errorf("model not found: %s", message))
 	}
 
+	// Check for server-side failures
+	if statusCode >= http.StatusInternalServerError {
+		return provider.NewServerError("bedrock", statusCode, message)
+	}
+
 	// Generic error
 	return provider.NewProviderError("bedrock", model, fmt.Errorf("%s", message))
 }
@@ -92,7 +92,10 @@ func TestParseBedrockError(t *testing.T) {
 			case "context_length":
 				var contextErr *provider.ContextLengthError
 				assert.ErrorAs(t, err, &contextErr)
-			case "validation", "model_not_found", "service_unavailable", "internal":
+			case "service_unavailable", "internal":
+				var serverErr *provider.ServerError
+				assert.ErrorAs(t, err, &serverErr)
+			case "validation", "model_not_found":
 				var providerErr *provider.ProviderError
 				assert.ErrorAs(t, err, &providerErr)
 			}
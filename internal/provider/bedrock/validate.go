@@ -0,0 +1,60 @@
+package bedrock
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	// BedrockControlPlaneEndpoint is the control-plane endpoint template
+	// used for lightweight credential checks
+	BedrockControlPlaneEndpoint = "https://bedrock.%s.amazonaws.com"
+)
+
+// ValidateCredentials verifies AWS credentials and region by calling the
+// Bedrock list-foundation-models API, which is free and read-only. It
+// returns a descriptive error when authentication fails or the region is
+// unreachable.
+func ValidateCredentials(ctx context.Context, config Config) error {
+	if err := config.Validate(); err != nil {
+		return fmt.Errorf("invalid Bedrock configuration: %w", err)
+	}
+
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf(BedrockControlPlaneEndpoint, config.Region)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint+"/foundation-models", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	signer := newAWSSigner(config.Region, config.AccessKey, config.SecretKey, config.SessionToken)
+	if err := signer.signRequest(req, nil, time.Now()); err != nil {
+		return fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach Bedrock in region '%s': %w", config.Region, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("Bedrock authentication failed: invalid AWS credentials or missing Bedrock permissions (status %d)", resp.StatusCode)
+	default:
+		return fmt.Errorf("Bedrock returned unexpected status %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,92 @@
+package bedrock
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCredentials(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		wantErr    bool
+		errMsg     string
+	}{
+		{
+			name:       "valid credentials",
+			statusCode: http.StatusOK,
+			wantErr:    false,
+		},
+		{
+			name:       "forbidden",
+			statusCode: http.StatusForbidden,
+			wantErr:    true,
+			errMsg:     "Bedrock authentication failed",
+		},
+		{
+			name:       "unauthorized",
+			statusCode: http.StatusUnauthorized,
+			wantErr:    true,
+			errMsg:     "Bedrock authentication failed",
+		},
+		{
+			name:       "unexpected status",
+			statusCode: http.StatusInternalServerError,
+			wantErr:    true,
+			errMsg:     "unexpected status 500",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotAuth string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/foundation-models", r.URL.Path)
+				gotAuth = r.Header.Get("Authorization")
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			err := ValidateCredentials(context.Background(), Config{
+				Region:    "us-east-1",
+				AccessKey: "AKIATEST",
+				SecretKey: "secret",
+				Endpoint:  server.URL,
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+
+			// Every request must carry a SigV4 signature
+			assert.True(t, strings.HasPrefix(gotAuth, "AWS4-HMAC-SHA256"),
+				"request not signed: Authorization = %q", gotAuth)
+		})
+	}
+}
+
+func TestValidateCredentialsMissingKeys(t *testing.T) {
+	err := ValidateCredentials(context.Background(), Config{Region: "us-east-1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid Bedrock configuration")
+}
+
+func TestValidateCredentialsUnreachable(t *testing.T) {
+	err := ValidateCredentials(context.Background(), Config{
+		Region:    "us-east-1",
+		AccessKey: "AKIATEST",
+		SecretKey: "secret",
+		Endpoint:  "http://127.0.0.1:1",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to reach Bedrock in region 'us-east-1'")
+}
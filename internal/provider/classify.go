@@ -0,0 +1,43 @@
+package provider
+
+import "errors"
+
+// Provider-agnostic sentinel errors for classifying API failures.
+//
+// Every provider converts its API error shapes into the typed errors in
+// errors.go, and those types match these sentinels through errors.Is. Retry
+// and fallback logic should branch on the sentinels (or the predicates
+// below) instead of comparing error strings, which differ per provider.
+var (
+	// ErrRateLimited matches rate-limit (HTTP 429 / quota) errors
+	ErrRateLimited = errors.New("provider rate limited")
+
+	// ErrAuthentication matches authentication and permission errors
+	ErrAuthentication = errors.New("provider authentication failed")
+
+	// ErrContextLength matches context-window-exceeded errors
+	ErrContextLength = errors.New("provider context length exceeded")
+
+	// ErrServerError matches provider-side (HTTP 5xx / overloaded) errors
+	ErrServerError = errors.New("provider server error")
+)
+
+// IsRateLimited reports whether err is a rate-limit error from any provider
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsAuthentication reports whether err is an authentication error from any provider
+func IsAuthentication(err error) bool {
+	return errors.Is(err, ErrAuthentication)
+}
+
+// IsContextLength reports whether err is a context-length error from any provider
+func IsContextLength(err error) bool {
+	return errors.Is(err, ErrContextLength)
+}
+
+// IsServerError reports whether err is a provider-side server error
+func IsServerError(err error) bool {
+	return errors.Is(err, ErrServerError)
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestSentinelClassification(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		wantRateLimited bool
+		wantAuth        bool
+		wantContext     bool
+		wantServer      bool
+	}{
+		{
+			name:            "rate limit error",
+			err:             NewRateLimitError("openai", 30),
+			wantRateLimited: true,
+		},
+		{
+			name:            "detailed rate limit error",
+			err:             NewRateLimitErrorWithDetails("anthropic", "tokens", 900, 1000),
+			wantRateLimited: true,
+		},
+		{
+			name:     "authentication error",
+			err:      NewAuthenticationError("gemini", "invalid key"),
+			wantAuth: true,
+		},
+		{
+			name:        "context length error",
+			err:         NewContextLengthError("anthropic", "claude-sonnet-4-5", 250000, 200000),
+			wantContext: true,
+		},
+		{
+			name:       "server error with status",
+			err:        NewServerError("openai", 503, "service unavailable"),
+			wantServer: true,
+		},
+		{
+			name:       "server error without status",
+			err:        NewServerError("anthropic", 0, "overloaded"),
+			wantServer: true,
+		},
+		{
+			name: "generic provider error matches nothing",
+			err:  NewProviderError("openai", "gpt-4", errors.New("boom")),
+		},
+		{
+			name: "nil error matches nothing",
+			err:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRateLimited(tt.err); got != tt.wantRateLimited {
+				t.Errorf("IsRateLimited() = %v, want %v", got, tt.wantRateLimited)
+			}
+			if got := IsAuthentication(tt.err); got != tt.wantAuth {
+				t.Errorf("IsAuthentication() = %v, want %v", got, tt.wantAuth)
+			}
+			if got := IsContextLength(tt.err); got != tt.wantContext {
+				t.Errorf("IsContextLength() = %v, want %v", got, tt.wantContext)
+			}
+			if got := IsServerError(tt.err); got != tt.wantServer {
+				t.Errorf("IsServerError() = %v, want %v", got, tt.wantServer)
+			}
+		})
+	}
+}
+
+func TestSentinelClassificationThroughWrapping(t *testing.T) {
+	// Classification must survive wrapping, both through ProviderError and
+	// through plain fmt.Errorf chains
+	inner := NewRateLimitError("openai", 10)
+
+	wrapped := NewProviderError("openai", "gpt-4", inner)
+	if !IsRateLimited(wrapped) {
+		t.Error("IsRateLimited() should match through ProviderError wrapping")
+	}
+
+	doubleWrapped := fmt.Errorf("chat failed: %w", wrapped)
+	if !IsRateLimited(doubleWrapped) {
+		t.Error("IsRateLimited() should match through fmt.Errorf wrapping")
+	}
+
+	if IsAuthentication(doubleWrapped) {
+		t.Error("IsAuthentication() should not match a wrapped rate limit error")
+	}
+}
+
+func TestSentinelErrorsIs(t *testing.T) {
+	// The predicates are conveniences; errors.Is against the sentinels must
+	// work directly for callers that prefer it
+	if !errors.Is(NewAuthenticationError("azure", "bad key"), ErrAuthentication) {
+		t.Error("errors.Is(AuthenticationError, ErrAuthentication) = false, want true")
+	}
+	if !errors.Is(NewServerError("bedrock", 500, "internal"), ErrServerError) {
+		t.Error("errors.Is(ServerError, ErrServerError) = false, want true")
+	}
+	if errors.Is(NewServerError("bedrock", 500, "internal"), ErrRateLimited) {
+		t.Error("errors.Is(ServerError, ErrRateLimited) = true, want false")
+	}
+}
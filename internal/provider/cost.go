@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/AINative-studio/ainative-code/internal/logger"
+)
+
+// CostUnknown is returned by EstimateCost when the model is not in the
+// price table, so callers can distinguish "no price data" from a free call
+const CostUnknown = -1
+
+// ModelPrice holds per-million-token prices for a model in US dollars
+type ModelPrice struct {
+	InputPerMillion  float64 `yaml:"input_per_million"`
+	OutputPerMillion float64 `yaml:"output_per_million"`
+}
+
+// PriceTable maps model identifiers to their token prices
+type PriceTable map[string]ModelPrice
+
+// DefaultPriceTable returns prices for common models. Prices drift over
+// time; load overrides from a config file via LoadPriceTable to update them
+// without a release.
+func DefaultPriceTable() PriceTable {
+	return PriceTable{
+		// Anthropic
+		"claude-sonnet-4-5-20250929": {InputPerMillion: 3.00, OutputPerMillion: 15.00},
+		"claude-opus-4-1":            {InputPerMillion: 15.00, OutputPerMillion: 75.00},
+		"claude-3-5-sonnet-20241022": {InputPerMillion: 3.00, OutputPerMillion: 15.00},
+		"claude-3-5-haiku-20241022":  {InputPerMillion: 0.80, OutputPerMillion: 4.00},
+		"claude-3-opus-20240229":     {InputPerMillion: 15.00, OutputPerMillion: 75.00},
+
+		// OpenAI
+		"gpt-4-turbo-preview": {InputPerMillion: 10.00, OutputPerMillion: 30.00},
+		"gpt-4":               {InputPerMillion: 30.00, OutputPerMillion: 60.00},
+		"gpt-4o":              {InputPerMillion: 2.50, OutputPerMillion: 10.00},
+		"gpt-4o-mini":         {InputPerMillion: 0.15, OutputPerMillion: 0.60},
+		"gpt-3.5-turbo":       {InputPerMillion: 0.50, OutputPerMillion: 1.50},
+
+		// Google
+		"gemini-pro":       {InputPerMillion: 0.50, OutputPerMillion: 1.50},
+		"gemini-1.5-pro":   {InputPerMillion: 1.25, OutputPerMillion: 5.00},
+		"gemini-1.5-flash": {InputPerMillion: 0.075, OutputPerMillion: 0.30},
+	}
+}
+
+// LoadPriceTable reads price overrides from a YAML file and merges them over
+// the default table. The file maps model identifiers to prices:
+//
+//	claude-sonnet-4-5-20250929:
+//	  input_per_million: 3.00
+//	  output_per_million: 15.00
+func LoadPriceTable(path string) (PriceTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read price table: %w", err)
+	}
+
+	overrides := PriceTable{}
+	if err := yaml.Unmarshal(data, &overrides); err != nil {
+		return nil, fmt.Errorf("failed to parse price table: %w", err)
+	}
+
+	return DefaultPriceTable().WithOverrides(overrides), nil
+}
+
+// WithOverrides returns a copy of the table with overrides applied on top
+func (t PriceTable) WithOverrides(overrides PriceTable) PriceTable {
+	merged := make(PriceTable, len(t)+len(overrides))
+	for model, price := range t {
+		merged[model] = price
+	}
+	for model, price := range overrides {
+		merged[model] = price
+	}
+	return merged
+}
+
+// EstimateCost returns the estimated dollar cost of the response based on
+// its token usage. A nil table uses DefaultPriceTable. Unknown models return
+// CostUnknown rather than silently reporting zero.
+func (r Response) EstimateCost(table PriceTable) float64 {
+	if table == nil {
+		table = DefaultPriceTable()
+	}
+
+	price, ok := table[r.Model]
+	if !ok {
+		logger.WarnEvent().
+			Str("model", r.Model).
+			Msg("No price data for model; cost estimate unavailable")
+		return CostUnknown
+	}
+
+	inputCost := float64(r.Usage.PromptTokens) * price.InputPerMillion / 1e6
+	outputCost := float64(r.Usage.CompletionTokens) * price.OutputPerMillion / 1e6
+
+	return inputCost + outputCost
+}
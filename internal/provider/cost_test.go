@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEstimateCost(t *testing.T) {
+	response := Response{
+		Model: "claude-sonnet-4-5-20250929",
+		Usage: Usage{PromptTokens: 1_000_000, CompletionTokens: 1_000_000},
+	}
+
+	cost := response.EstimateCost(nil)
+	if math.Abs(cost-18.00) > 1e-9 {
+		t.Errorf("expected cost 18.00, got %f", cost)
+	}
+}
+
+func TestEstimateCost_SmallUsage(t *testing.T) {
+	response := Response{
+		Model: "gpt-4o-mini",
+		Usage: Usage{PromptTokens: 1000, CompletionTokens: 500},
+	}
+
+	// 1000 * 0.15/1M + 500 * 0.60/1M
+	want := 0.00015 + 0.0003
+	cost := response.EstimateCost(nil)
+	if math.Abs(cost-want) > 1e-9 {
+		t.Errorf("expected cost %f, got %f", want, cost)
+	}
+}
+
+func TestEstimateCost_UnknownModel(t *testing.T) {
+	response := Response{
+		Model: "some-future-model",
+		Usage: Usage{PromptTokens: 1000, CompletionTokens: 1000},
+	}
+
+	if cost := response.EstimateCost(nil); cost != CostUnknown {
+		t.Errorf("expected CostUnknown for unknown model, got %f", cost)
+	}
+}
+
+func TestEstimateCost_CustomTable(t *testing.T) {
+	table := PriceTable{
+		"custom-model": {InputPerMillion: 1.00, OutputPerMillion: 2.00},
+	}
+	response := Response{
+		Model: "custom-model",
+		Usage: Usage{PromptTokens: 2_000_000, CompletionTokens: 1_000_000},
+	}
+
+	if cost := response.EstimateCost(table); math.Abs(cost-4.00) > 1e-9 {
+		t.Errorf("expected cost 4.00, got %f", cost)
+	}
+}
+
+func TestPriceTableWithOverrides(t *testing.T) {
+	merged := DefaultPriceTable().WithOverrides(PriceTable{
+		"gpt-4": {InputPerMillion: 1.00, OutputPerMillion: 2.00},
+		"new":   {InputPerMillion: 5.00, OutputPerMillion: 10.00},
+	})
+
+	if merged["gpt-4"].InputPerMillion != 1.00 {
+		t.Errorf("expected override to win, got %f", merged["gpt-4"].InputPerMillion)
+	}
+	if _, ok := merged["new"]; !ok {
+		t.Error("expected new model to be added")
+	}
+	if _, ok := merged["gemini-pro"]; !ok {
+		t.Error("expected default entries to be preserved")
+	}
+
+	// The original default table is untouched
+	if DefaultPriceTable()["gpt-4"].InputPerMillion == 1.00 {
+		t.Error("expected WithOverrides to return a copy")
+	}
+}
+
+func TestLoadPriceTable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.yaml")
+	content := `gpt-4:
+  input_per_million: 20.00
+  output_per_million: 40.00
+`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write price file: %v", err)
+	}
+
+	table, err := LoadPriceTable(path)
+	if err != nil {
+		t.Fatalf("failed to load price table: %v", err)
+	}
+
+	if table["gpt-4"].InputPerMillion != 20.00 {
+		t.Errorf("expected loaded override, got %f", table["gpt-4"].InputPerMillion)
+	}
+	if _, ok := table["gemini-pro"]; !ok {
+		t.Error("expected defaults to be merged in")
+	}
+}
+
+func TestLoadPriceTable_Errors(t *testing.T) {
+	if _, err := LoadPriceTable(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("expected error for missing file")
+	}
+
+	path := filepath.Join(t.TempDir(), "bad.yaml")
+	if err := os.WriteFile(path, []byte("{not: [valid"), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	if _, err := LoadPriceTable(path); err == nil {
+		t.Error("expected error for invalid YAML")
+	}
+}
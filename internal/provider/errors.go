@@ -42,6 +42,11 @@ func (e *RateLimitError) Error() string {
 	return fmt.Sprintf("rate limit exceeded for provider %q", e.Provider)
 }
 
+// Is matches the ErrRateLimited sentinel so errors.Is works across providers
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
 // AuthenticationError represents an authentication failure
 type AuthenticationError struct {
 	Provider string
@@ -56,6 +61,11 @@ func (e *AuthenticationError) Error() string {
 	return fmt.Sprintf("authentication failed for provider %q", e.Provider)
 }
 
+// Is matches the ErrAuthentication sentinel so errors.Is works across providers
+func (e *AuthenticationError) Is(target error) bool {
+	return target == ErrAuthentication
+}
+
 // ContextLengthError represents a context length exceeded error
 type ContextLengthError struct {
 	Provider      string
@@ -72,6 +82,31 @@ func (e *ContextLengthError) Error() string {
 	return fmt.Sprintf("context length exceeded for provider %q model %q", e.Provider, e.Model)
 }
 
+// Is matches the ErrContextLength sentinel so errors.Is works across providers
+func (e *ContextLengthError) Is(target error) bool {
+	return target == ErrContextLength
+}
+
+// ServerError represents a provider-side failure (HTTP 5xx or overloaded)
+type ServerError struct {
+	Provider   string
+	StatusCode int // zero when the provider reports overload without a status
+	Message    string
+}
+
+// Error implements the error interface
+func (e *ServerError) Error() string {
+	if e.StatusCode > 0 {
+		return fmt.Sprintf("server error for provider %q (HTTP %d): %s", e.Provider, e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("server error for provider %q: %s", e.Provider, e.Message)
+}
+
+// Is matches the ErrServerError sentinel so errors.Is works across providers
+func (e *ServerError) Is(target error) bool {
+	return target == ErrServerError
+}
+
 // InvalidModelError represents an invalid model identifier error
 type InvalidModelError struct {
 	Provider       string
@@ -142,6 +177,15 @@ func NewAuthenticationError(provider, reason string) *AuthenticationError {
 	}
 }
 
+// NewServerError creates a new ServerError
+func NewServerError(provider string, statusCode int, message string) *ServerError {
+	return &ServerError{
+		Provider:   provider,
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
 // NewContextLengthError creates a new ContextLengthError
 func NewContextLengthError(provider, model string, requestTokens, maxTokens int) *ContextLengthError {
 	return &ContextLengthError{
@@ -204,8 +204,24 @@ func (g *GeminiProvider) buildRequest(ctx context.Context, messages []provider.M
 		}
 	}
 
+	if options.Seed != 0 {
+		genConfig.Seed = &options.Seed
+	}
+	switch options.ResponseFormat {
+	case provider.ResponseFormatJSON:
+		genConfig.ResponseMimeType = "application/json"
+	case provider.ResponseFormatJSONSchema:
+		genConfig.ResponseMimeType = "application/json"
+		genConfig.ResponseSchema = options.ResponseSchema
+	}
+
 	reqBody.GenerationConfig = genConfig
 
+	// Add tool declarations if provided
+	if len(options.Tools) > 0 {
+		reqBody.Tools = convertTools(options.Tools)
+	}
+
 	// Note: Safety settings would need to be passed through a different mechanism
 	// or added to options as a typed field for proper handling
 
@@ -268,12 +284,56 @@ func (g *GeminiProvider) convertMessages(messages []provider.Message, systemProm
 			continue
 		}
 
+		// Tool results map to a user turn carrying a functionResponse part.
+		// Gemini matches responses by function name, so ToolCallID carries
+		// the name of the function that was called.
+		if msg.Role == "tool" {
+			contents = append(contents, geminiContent{
+				Role: "user",
+				Parts: []geminiPart{
+					{FunctionResponse: &functionResponse{
+						Name:     msg.ToolCallID,
+						Response: toolResultPayload(msg.Content),
+					}},
+				},
+			})
+			continue
+		}
+
 		// Convert role (Gemini uses "model" instead of "assistant")
 		role := msg.Role
 		if role == "assistant" {
 			role = "model"
 		}
 
+		// Replay assistant tool calls as functionCall parts
+		if len(msg.ToolCalls) > 0 {
+			var parts []geminiPart
+			if msg.Content != "" {
+				parts = append(parts, geminiPart{Text: msg.Content})
+			}
+			for _, tc := range msg.ToolCalls {
+				var args map[string]interface{}
+				if tc.Arguments != "" {
+					_ = json.Unmarshal([]byte(tc.Arguments), &args)
+				}
+				parts = append(parts, geminiPart{
+					FunctionCall: &functionCall{Name: tc.Name, Args: args},
+				})
+			}
+			contents = append(contents, geminiContent{Role: role, Parts: parts})
+			continue
+		}
+
+		// Multimodal parts take precedence over plain text content
+		if len(msg.Parts) > 0 {
+			contents = append(contents, geminiContent{
+				Role:  role,
+				Parts: convertParts(msg.Parts),
+			})
+			continue
+		}
+
 		contents = append(contents, geminiContent{
 			Role: role,
 			Parts: []geminiPart{
@@ -285,6 +345,58 @@ func (g *GeminiProvider) convertMessages(messages []provider.Message, systemProm
 	return contents, systemInstruction
 }
 
+// convertParts converts multimodal message parts to Gemini content parts
+func convertParts(parts []provider.ContentPart) []geminiPart {
+	apiParts := make([]geminiPart, 0, len(parts))
+	for _, part := range parts {
+		switch part.Type {
+		case provider.ContentPartText:
+			apiParts = append(apiParts, geminiPart{Text: part.Text})
+		case provider.ContentPartImage:
+			if len(part.ImageData) > 0 {
+				apiParts = append(apiParts, geminiPart{
+					InlineData: &inlineData{
+						MimeType: part.MimeType,
+						Data:     part.Base64Data(),
+					},
+				})
+			} else if part.ImageURL != "" {
+				apiParts = append(apiParts, geminiPart{
+					FileData: &fileData{
+						MimeType: part.MimeType,
+						FileURI:  part.ImageURL,
+					},
+				})
+			}
+		}
+	}
+	return apiParts
+}
+
+// convertTools converts provider tool definitions to Gemini function declarations
+func convertTools(tools []provider.ToolDefinition) []geminiTool {
+	declarations := make([]functionDeclaration, 0, len(tools))
+	for _, tool := range tools {
+		declarations = append(declarations, functionDeclaration{
+			Name:        tool.Name,
+			Description: tool.Description,
+			Parameters:  tool.Parameters,
+		})
+	}
+	return []geminiTool{{FunctionDeclarations: declarations}}
+}
+
+// toolResultPayload converts a tool result string into the object form the
+// Gemini API expects. JSON object results pass through unchanged; anything
+// else is wrapped under a "result" key.
+func toolResultPayload(content string) map[string]interface{} {
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(content), &payload); err == nil {
+		return payload
+	}
+	return map[string]interface{}{"result": content}
+}
+
 // parseResponse parses the Gemini API response
 func (g *GeminiProvider) parseResponse(body []byte, model string) (provider.Response, error) {
 	var apiResp geminiResponse
@@ -312,8 +424,9 @@ func (g *GeminiProvider) parseResponse(body []byte, model string) (provider.Resp
 			fmt.Errorf("response blocked due to safety settings"))
 	}
 
-	// Extract text content
+	// Extract text content and any requested tool calls
 	var content string
+	var toolCalls []provider.ToolCall
 	for _, part := range candidate.Content.Parts {
 		if part.Text != "" {
 			if content != "" {
@@ -321,6 +434,19 @@ func (g *GeminiProvider) parseResponse(body []byte, model string) (provider.Resp
 			}
 			content += part.Text
 		}
+		if part.FunctionCall != nil {
+			args := "{}"
+			if part.FunctionCall.Args != nil {
+				if encoded, err := json.Marshal(part.FunctionCall.Args); err == nil {
+					args = string(encoded)
+				}
+			}
+			toolCalls = append(toolCalls, provider.ToolCall{
+				ID:        part.FunctionCall.Name,
+				Name:      part.FunctionCall.Name,
+				Arguments: args,
+			})
+		}
 	}
 
 	// Build usage information
@@ -332,8 +458,9 @@ func (g *GeminiProvider) parseResponse(body []byte, model string) (provider.Resp
 	}
 
 	return provider.Response{
-		Content: content,
-		Model:   model,
-		Usage:   usage,
+		Content:   content,
+		Model:     model,
+		ToolCalls: toolCalls,
+		Usage:     usage,
 	}, nil
 }
@@ -62,6 +62,9 @@ func (g *GeminiProvider) convertAPIError(apiErr *geminiError, statusCode int, mo
 		}
 		return provider.NewProviderError("gemini", model, fmt.Errorf("not found: %s", errMsg))
 
+	case statusCode >= http.StatusInternalServerError:
+		return provider.NewServerError("gemini", statusCode, errMsg)
+
 	case errCode == 400:
 		// Additional BadRequest handling based on error code
 		if strings.Contains(errStatus, "INVALID_ARGUMENT") {
@@ -83,7 +86,7 @@ func (g *GeminiProvider) convertAPIError(apiErr *geminiError, statusCode int, mo
 
 	case errCode == 500, errCode == 503:
 		// Server errors
-		return provider.NewProviderError("gemini", model, fmt.Errorf("server error (%d): %s", errCode, errMsg))
+		return provider.NewServerError("gemini", errCode, errMsg)
 
 	default:
 		// Generic error
@@ -20,7 +20,7 @@ func TestHandleAPIError(t *testing.T) {
 		name          string
 		statusCode    int
 		errorResponse geminiError
-		expectedType  string // "authentication", "rate_limit", "context_length", "invalid_model", "generic"
+		expectedType  string // "authentication", "rate_limit", "context_length", "invalid_model", "server", "generic"
 	}{
 		{
 			name:       "authentication error - 401",
@@ -116,7 +116,7 @@ func TestHandleAPIError(t *testing.T) {
 					Status:  "INTERNAL",
 				},
 			},
-			expectedType: "generic",
+			expectedType: "server",
 		},
 		{
 			name:       "service unavailable - 503",
@@ -128,7 +128,7 @@ func TestHandleAPIError(t *testing.T) {
 					Status:  "UNAVAILABLE",
 				},
 			},
-			expectedType: "generic",
+			expectedType: "server",
 		},
 	}
 
@@ -158,6 +158,9 @@ func TestHandleAPIError(t *testing.T) {
 			case "invalid_model":
 				_, ok := err.(*provider.InvalidModelError)
 				assert.True(t, ok, "expected invalid model error")
+			case "server":
+				_, ok := err.(*provider.ServerError)
+				assert.True(t, ok, "expected server error")
 			case "generic":
 				_, ok := err.(*provider.ProviderError)
 				assert.True(t, ok, "expected generic provider error")
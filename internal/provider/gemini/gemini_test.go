@@ -631,3 +631,93 @@ func TestSystemPromptHandling(t *testing.T) {
 		})
 	}
 }
+
+// TestChatWithSeedAndResponseFormat tests mapping to seed and responseMimeType
+func TestChatWithSeedAndResponseFormat(t *testing.T) {
+	mockResponse := geminiResponse{
+		Candidates: []candidate{
+			{
+				Content: geminiContent{
+					Parts: []geminiPart{{Text: "{}"}},
+				},
+			},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req geminiRequest
+		json.Unmarshal(body, &req)
+
+		require.NotNil(t, req.GenerationConfig)
+		require.NotNil(t, req.GenerationConfig.Seed)
+		assert.Equal(t, 42, *req.GenerationConfig.Seed)
+		assert.Equal(t, "application/json", req.GenerationConfig.ResponseMimeType)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(mockResponse)
+	}))
+	defer server.Close()
+
+	p, err := NewGeminiProvider(Config{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	messages := []provider.Message{
+		{Role: "user", Content: "extract"},
+	}
+
+	_, err = p.Chat(ctx, messages,
+		provider.WithModel("gemini-pro"),
+		provider.WithSeed(42),
+		provider.WithResponseFormat(provider.ResponseFormatJSON),
+	)
+	require.NoError(t, err)
+}
+
+// TestChatWithResponseSchema tests mapping to responseSchema
+func TestChatWithResponseSchema(t *testing.T) {
+	mockResponse := geminiResponse{
+		Candidates: []candidate{
+			{
+				Content: geminiContent{
+					Parts: []geminiPart{{Text: "{}"}},
+				},
+			},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req geminiRequest
+		json.Unmarshal(body, &req)
+
+		require.NotNil(t, req.GenerationConfig)
+		assert.Equal(t, "application/json", req.GenerationConfig.ResponseMimeType)
+		assert.NotNil(t, req.GenerationConfig.ResponseSchema)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(mockResponse)
+	}))
+	defer server.Close()
+
+	p, err := NewGeminiProvider(Config{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	messages := []provider.Message{
+		{Role: "user", Content: "extract"},
+	}
+
+	_, err = p.Chat(ctx, messages,
+		provider.WithModel("gemini-pro"),
+		provider.WithResponseSchema(map[string]interface{}{"type": "object"}),
+	)
+	require.NoError(t, err)
+}
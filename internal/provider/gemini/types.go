@@ -44,6 +44,11 @@ type generationConfig struct {
 	TopP            *float64 `json:"topP,omitempty"`
 	TopK            *int     `json:"topK,omitempty"`
 	CandidateCount  int      `json:"candidateCount,omitempty"`
+	Seed            *int     `json:"seed,omitempty"`
+
+	// Structured output: "application/json" with an optional schema
+	ResponseMimeType string      `json:"responseMimeType,omitempty"`
+	ResponseSchema   interface{} `json:"responseSchema,omitempty"`
 }
 
 // safetySetting represents a safety configuration
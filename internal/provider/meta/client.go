@@ -52,12 +52,22 @@ func (p *MetaProvider) Models() []string {
 
 // Chat sends a complete chat request and waits for the full response
 func (p *MetaProvider) Chat(ctx context.Context, messages []provider.Message, opts ...provider.ChatOption) (provider.Response, error) {
+	// Image parts are not supported by this provider
+	if provider.MessagesContainImages(messages) {
+		return provider.Response{}, provider.ErrMultimodalUnsupported
+	}
+
 	// Apply options
 	options := &provider.ChatOptions{}
 	for _, opt := range opts {
 		opt(options)
 	}
 
+	// Seeds and structured response formats are not supported by this provider
+	if provider.OptionsRequireStructuredSupport(options) {
+		return provider.Response{}, provider.ErrUnsupportedOption
+	}
+
 	// Build request
 	req := p.buildRequest(messages, options)
 
@@ -91,10 +101,20 @@ func (p *MetaProvider) Chat(ctx context.Context, messages []provider.Message, op
 
 // Stream sends a streaming chat request and returns a channel for events
 func (p *MetaProvider) Stream(ctx context.Context, messages []provider.Message, opts ...provider.StreamOption) (<-chan provider.Event, error) {
+	// Image parts are not supported by this provider
+	if provider.MessagesContainImages(messages) {
+		return nil, provider.ErrMultimodalUnsupported
+	}
+
 	// Apply options
 	options := &provider.ChatOptions{}
 	provider.ApplyStreamOptions(options, opts...)
 
+	// Seeds and structured response formats are not supported by this provider
+	if provider.OptionsRequireStructuredSupport(options) {
+		return nil, provider.ErrUnsupportedOption
+	}
+
 	req := p.buildRequest(messages, options)
 	req.Stream = true
 
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/AINative-studio/ainative-code/internal/provider"
 )
 
 // Error types
@@ -39,6 +41,21 @@ func (e *MetaError) Error() string {
 		e.StatusCode, e.Type, e.Message)
 }
 
+// Is matches the provider-agnostic sentinel errors so errors.Is works on
+// MetaError the same way it does on the shared provider error types
+func (e *MetaError) Is(target error) bool {
+	switch target {
+	case provider.ErrRateLimited:
+		return e.IsRateLimitError()
+	case provider.ErrAuthentication:
+		return e.IsAuthenticationError() || e.Type == ErrTypePermission
+	case provider.ErrServerError:
+		return e.StatusCode >= 500
+	default:
+		return false
+	}
+}
+
 // IsAuthenticationError checks if the error is an authentication error
 func (e *MetaError) IsAuthenticationError() bool {
 	return e.Type == ErrTypeAuthentication || e.Type == ErrTypeInvalidAPIKey
@@ -2,10 +2,12 @@ package meta
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"net/http"
 	"testing"
 
+	"github.com/AINative-studio/ainative-code/internal/provider"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -326,3 +328,61 @@ func TestNewErrors(t *testing.T) {
 		assert.Equal(t, "test message", err.Message)
 	})
 }
+
+func TestMetaError_Is(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *MetaError
+		sentinel error
+		expected bool
+	}{
+		{
+			name:     "rate limit type matches ErrRateLimited",
+			err:      &MetaError{Type: ErrTypeRateLimit},
+			sentinel: provider.ErrRateLimited,
+			expected: true,
+		},
+		{
+			name:     "429 status matches ErrRateLimited",
+			err:      &MetaError{StatusCode: 429},
+			sentinel: provider.ErrRateLimited,
+			expected: true,
+		},
+		{
+			name:     "authentication type matches ErrAuthentication",
+			err:      &MetaError{StatusCode: 401, Type: ErrTypeAuthentication},
+			sentinel: provider.ErrAuthentication,
+			expected: true,
+		},
+		{
+			name:     "permission type matches ErrAuthentication",
+			err:      &MetaError{StatusCode: 403, Type: ErrTypePermission},
+			sentinel: provider.ErrAuthentication,
+			expected: true,
+		},
+		{
+			name:     "500 status matches ErrServerError",
+			err:      &MetaError{StatusCode: 500, Type: ErrTypeAPI},
+			sentinel: provider.ErrServerError,
+			expected: true,
+		},
+		{
+			name:     "invalid request matches nothing",
+			err:      &MetaError{StatusCode: 400, Type: ErrTypeInvalidRequest},
+			sentinel: provider.ErrRateLimited,
+			expected: false,
+		},
+		{
+			name:     "authentication does not match ErrServerError",
+			err:      &MetaError{StatusCode: 401, Type: ErrTypeAuthentication},
+			sentinel: provider.ErrServerError,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, errors.Is(tt.err, tt.sentinel))
+		})
+	}
+}
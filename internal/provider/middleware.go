@@ -0,0 +1,163 @@
+package provider
+
+import "context"
+
+// RequestMiddleware intercepts an outgoing chat request before it reaches the
+// underlying provider. Middlewares may mutate the messages in place or adjust
+// the options — for example redacting secrets from prompts before they are
+// logged, or injecting a correlation ID via opts.Metadata.
+type RequestMiddleware func(ctx context.Context, model string, messages []Message, opts *ChatOptions)
+
+// ResponseMiddleware observes the response (or error) of a chat request after
+// the provider returns. For streaming requests it is invoked once with the
+// assembled final content when the stream completes.
+type ResponseMiddleware func(ctx context.Context, resp *Response, err error)
+
+// WithMiddleware registers a request middleware for the request
+func WithMiddleware(fn RequestMiddleware) ChatOption {
+	return func(opts *ChatOptions) {
+		opts.RequestMiddlewares = append(opts.RequestMiddlewares, fn)
+	}
+}
+
+// WithResponseMiddleware registers a response middleware for the request
+func WithResponseMiddleware(fn ResponseMiddleware) ChatOption {
+	return func(opts *ChatOptions) {
+		opts.ResponseMiddlewares = append(opts.ResponseMiddlewares, fn)
+	}
+}
+
+// StreamWithMiddleware registers a request middleware for streaming requests
+func StreamWithMiddleware(fn RequestMiddleware) StreamOption {
+	return func(opts *ChatOptions) {
+		opts.RequestMiddlewares = append(opts.RequestMiddlewares, fn)
+		opts.Stream = true
+	}
+}
+
+// StreamWithResponseMiddleware registers a response middleware for streaming requests
+func StreamWithResponseMiddleware(fn ResponseMiddleware) StreamOption {
+	return func(opts *ChatOptions) {
+		opts.ResponseMiddlewares = append(opts.ResponseMiddlewares, fn)
+		opts.Stream = true
+	}
+}
+
+// MiddlewareProvider wraps another Provider and runs registered middlewares
+// around Chat and Stream calls, so hooks apply across all providers without
+// editing each implementation.
+type MiddlewareProvider struct {
+	inner    Provider
+	request  []RequestMiddleware
+	response []ResponseMiddleware
+}
+
+// NewMiddlewareProvider wraps a provider with the given middlewares. Additional
+// per-request middlewares registered via WithMiddleware options also run.
+func NewMiddlewareProvider(inner Provider, request []RequestMiddleware, response []ResponseMiddleware) *MiddlewareProvider {
+	return &MiddlewareProvider{
+		inner:    inner,
+		request:  request,
+		response: response,
+	}
+}
+
+// Name returns the wrapped provider's name
+func (m *MiddlewareProvider) Name() string {
+	return m.inner.Name()
+}
+
+// Models returns the wrapped provider's supported models
+func (m *MiddlewareProvider) Models() []string {
+	return m.inner.Models()
+}
+
+// Close releases resources held by the wrapped provider
+func (m *MiddlewareProvider) Close() error {
+	return m.inner.Close()
+}
+
+// Chat runs request middlewares, delegates to the wrapped provider, then runs
+// response middlewares
+func (m *MiddlewareProvider) Chat(ctx context.Context, messages []Message, opts ...ChatOption) (Response, error) {
+	options := DefaultChatOptions()
+	ApplyChatOptions(options, opts...)
+
+	m.runRequestMiddlewares(ctx, messages, options)
+
+	resp, err := m.inner.Chat(ctx, messages, withResolvedOptions(options))
+
+	m.runResponseMiddlewares(ctx, options, &resp, err)
+
+	return resp, err
+}
+
+// Stream runs request middlewares, delegates to the wrapped provider, and
+// invokes response middlewares once the stream completes
+func (m *MiddlewareProvider) Stream(ctx context.Context, messages []Message, opts ...StreamOption) (<-chan Event, error) {
+	options := DefaultChatOptions()
+	ApplyStreamOptions(options, opts...)
+
+	m.runRequestMiddlewares(ctx, messages, options)
+
+	innerChan, err := m.inner.Stream(ctx, messages, func(o *ChatOptions) {
+		*o = *options
+	})
+	if err != nil {
+		m.runResponseMiddlewares(ctx, options, nil, err)
+		return nil, err
+	}
+
+	eventChan := make(chan Event)
+	go func() {
+		defer close(eventChan)
+
+		var content string
+		var streamErr error
+		for event := range innerChan {
+			switch event.Type {
+			case EventTypeContentDelta:
+				content += event.Content
+			case EventTypeError:
+				streamErr = event.Error
+			}
+			eventChan <- event
+		}
+
+		resp := &Response{Content: content}
+		if streamErr != nil {
+			resp = nil
+		}
+		m.runResponseMiddlewares(ctx, options, resp, streamErr)
+	}()
+
+	return eventChan, nil
+}
+
+// runRequestMiddlewares invokes wrapper-level then per-request middlewares
+func (m *MiddlewareProvider) runRequestMiddlewares(ctx context.Context, messages []Message, options *ChatOptions) {
+	for _, fn := range m.request {
+		fn(ctx, options.Model, messages, options)
+	}
+	for _, fn := range options.RequestMiddlewares {
+		fn(ctx, options.Model, messages, options)
+	}
+}
+
+// runResponseMiddlewares invokes wrapper-level then per-request middlewares
+func (m *MiddlewareProvider) runResponseMiddlewares(ctx context.Context, options *ChatOptions, resp *Response, err error) {
+	for _, fn := range m.response {
+		fn(ctx, resp, err)
+	}
+	for _, fn := range options.ResponseMiddlewares {
+		fn(ctx, resp, err)
+	}
+}
+
+// withResolvedOptions passes an already-resolved ChatOptions to a provider,
+// preserving any mutations middlewares made
+func withResolvedOptions(options *ChatOptions) ChatOption {
+	return func(o *ChatOptions) {
+		*o = *options
+	}
+}
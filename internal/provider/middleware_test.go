@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// fakeProvider is a minimal Provider implementation for middleware tests
+type fakeProvider struct {
+	lastMessages []Message
+	lastOptions  *ChatOptions
+	response     Response
+	streamChunks []string
+}
+
+func (f *fakeProvider) Chat(ctx context.Context, messages []Message, opts ...ChatOption) (Response, error) {
+	options := DefaultChatOptions()
+	ApplyChatOptions(options, opts...)
+	f.lastMessages = messages
+	f.lastOptions = options
+	return f.response, nil
+}
+
+func (f *fakeProvider) Stream(ctx context.Context, messages []Message, opts ...StreamOption) (<-chan Event, error) {
+	options := DefaultChatOptions()
+	ApplyStreamOptions(options, opts...)
+	f.lastMessages = messages
+	f.lastOptions = options
+
+	eventChan := make(chan Event)
+	go func() {
+		defer close(eventChan)
+		eventChan <- Event{Type: EventTypeContentStart}
+		for _, chunk := range f.streamChunks {
+			eventChan <- Event{Type: EventTypeContentDelta, Content: chunk}
+		}
+		eventChan <- Event{Type: EventTypeContentEnd, Done: true}
+	}()
+	return eventChan, nil
+}
+
+func (f *fakeProvider) Name() string     { return "fake" }
+func (f *fakeProvider) Models() []string { return []string{"fake-model"} }
+func (f *fakeProvider) Close() error     { return nil }
+
+func TestMiddlewareProviderChat(t *testing.T) {
+	inner := &fakeProvider{response: Response{Content: "hello"}}
+
+	var sawModel string
+	redact := func(ctx context.Context, model string, messages []Message, opts *ChatOptions) {
+		sawModel = model
+		for i := range messages {
+			messages[i].Content = strings.ReplaceAll(messages[i].Content, "secret-key", "[REDACTED]")
+		}
+	}
+
+	correlate := func(ctx context.Context, model string, messages []Message, opts *ChatOptions) {
+		if opts.Metadata == nil {
+			opts.Metadata = make(map[string]string)
+		}
+		opts.Metadata["correlation_id"] = "req-123"
+	}
+
+	p := NewMiddlewareProvider(inner, []RequestMiddleware{redact, correlate}, nil)
+
+	messages := []Message{{Role: "user", Content: "my key is secret-key"}}
+	resp, err := p.Chat(context.Background(), messages, WithModel("fake-model"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.Content != "hello" {
+		t.Errorf("expected response 'hello', got: %s", resp.Content)
+	}
+
+	if sawModel != "fake-model" {
+		t.Errorf("middleware saw model %q, want 'fake-model'", sawModel)
+	}
+
+	if inner.lastMessages[0].Content != "my key is [REDACTED]" {
+		t.Errorf("expected redacted message, got: %s", inner.lastMessages[0].Content)
+	}
+
+	if inner.lastOptions.Metadata["correlation_id"] != "req-123" {
+		t.Errorf("expected correlation ID to reach provider, got: %v", inner.lastOptions.Metadata)
+	}
+}
+
+func TestMiddlewareProviderResponseCallback(t *testing.T) {
+	inner := &fakeProvider{response: Response{Content: "hi"}}
+
+	var gotContent string
+	observe := func(ctx context.Context, resp *Response, err error) {
+		if resp != nil {
+			gotContent = resp.Content
+		}
+	}
+
+	p := NewMiddlewareProvider(inner, nil, []ResponseMiddleware{observe})
+
+	_, err := p.Chat(context.Background(), nil, WithModel("fake-model"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotContent != "hi" {
+		t.Errorf("expected response middleware to see 'hi', got: %s", gotContent)
+	}
+}
+
+func TestMiddlewareProviderStream(t *testing.T) {
+	inner := &fakeProvider{streamChunks: []string{"foo", "bar"}}
+
+	var gotContent string
+	done := make(chan struct{})
+	observe := func(ctx context.Context, resp *Response, err error) {
+		if resp != nil {
+			gotContent = resp.Content
+		}
+		close(done)
+	}
+
+	p := NewMiddlewareProvider(inner, nil, nil)
+
+	eventChan, err := p.Stream(context.Background(), nil,
+		StreamWithModel("fake-model"),
+		StreamWithResponseMiddleware(observe),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var deltas int
+	for event := range eventChan {
+		if event.Type == EventTypeContentDelta {
+			deltas++
+		}
+	}
+
+	<-done
+
+	if deltas != 2 {
+		t.Errorf("expected 2 delta events, got: %d", deltas)
+	}
+
+	if gotContent != "foobar" {
+		t.Errorf("expected assembled content 'foobar', got: %s", gotContent)
+	}
+}
+
+func TestWithMiddlewareOption(t *testing.T) {
+	opts := &ChatOptions{}
+	WithMiddleware(func(ctx context.Context, model string, messages []Message, o *ChatOptions) {})(opts)
+	WithResponseMiddleware(func(ctx context.Context, resp *Response, err error) {})(opts)
+
+	if len(opts.RequestMiddlewares) != 1 {
+		t.Errorf("expected 1 request middleware, got: %d", len(opts.RequestMiddlewares))
+	}
+
+	if len(opts.ResponseMiddlewares) != 1 {
+		t.Errorf("expected 1 response middleware, got: %d", len(opts.ResponseMiddlewares))
+	}
+}
@@ -0,0 +1,177 @@
+// Package mock provides a deterministic, offline implementation of the
+// provider.Provider interface for tests. Callers script responses with
+// EnqueueResponse and EnqueueStream, then assert on the recorded calls.
+package mock
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/AINative-studio/ainative-code/internal/provider"
+)
+
+// RecordedCall captures a single Chat or Stream invocation
+type RecordedCall struct {
+	Messages []provider.Message
+	Options  *provider.ChatOptions
+	Stream   bool
+}
+
+// MockProvider implements provider.Provider with scripted responses
+type MockProvider struct {
+	mu        sync.Mutex
+	responses []provider.Response
+	streams   [][]string
+	calls     []RecordedCall
+	closed    bool
+}
+
+// NewMockProvider creates a new mock provider with no scripted responses
+func NewMockProvider() *MockProvider {
+	return &MockProvider{}
+}
+
+// EnqueueResponse scripts the next Chat response
+func (m *MockProvider) EnqueueResponse(resp provider.Response) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.responses = append(m.responses, resp)
+}
+
+// EnqueueStream scripts the next Stream call to emit the given chunks as
+// content delta events followed by a content end event
+func (m *MockProvider) EnqueueStream(chunks []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.streams = append(m.streams, chunks)
+}
+
+// Chat returns the next scripted response, recording the call
+func (m *MockProvider) Chat(ctx context.Context, messages []provider.Message, opts ...provider.ChatOption) (provider.Response, error) {
+	options := provider.DefaultChatOptions()
+	provider.ApplyChatOptions(options, opts...)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = append(m.calls, RecordedCall{
+		Messages: messages,
+		Options:  options,
+		Stream:   false,
+	})
+
+	if len(m.responses) == 0 {
+		return provider.Response{}, fmt.Errorf("mock: no responses enqueued")
+	}
+
+	resp := m.responses[0]
+	m.responses = m.responses[1:]
+	return resp, nil
+}
+
+// Stream emits the next scripted chunk sequence, recording the call
+func (m *MockProvider) Stream(ctx context.Context, messages []provider.Message, opts ...provider.StreamOption) (<-chan provider.Event, error) {
+	options := provider.DefaultChatOptions()
+	provider.ApplyStreamOptions(options, opts...)
+
+	m.mu.Lock()
+	m.calls = append(m.calls, RecordedCall{
+		Messages: messages,
+		Options:  options,
+		Stream:   true,
+	})
+
+	if len(m.streams) == 0 {
+		m.mu.Unlock()
+		return nil, fmt.Errorf("mock: no streams enqueued")
+	}
+
+	chunks := m.streams[0]
+	m.streams = m.streams[1:]
+	m.mu.Unlock()
+
+	eventChan := make(chan provider.Event)
+	go func() {
+		defer close(eventChan)
+
+		eventChan <- provider.Event{Type: provider.EventTypeContentStart}
+
+		var content string
+		for _, chunk := range chunks {
+			select {
+			case <-ctx.Done():
+				eventChan <- provider.Event{
+					Type:  provider.EventTypeError,
+					Error: ctx.Err(),
+				}
+				return
+			case eventChan <- provider.Event{Type: provider.EventTypeContentDelta, Content: chunk}:
+				content += chunk
+			}
+		}
+
+		eventChan <- provider.Event{
+			Type:    provider.EventTypeContentEnd,
+			Content: content,
+			Done:    true,
+		}
+	}()
+
+	return eventChan, nil
+}
+
+// Name returns the provider name
+func (m *MockProvider) Name() string {
+	return "mock"
+}
+
+// Models returns the list of supported models
+func (m *MockProvider) Models() []string {
+	return []string{"mock-model"}
+}
+
+// Close marks the provider as closed
+func (m *MockProvider) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closed = true
+	return nil
+}
+
+// Calls returns a copy of all recorded calls
+func (m *MockProvider) Calls() []RecordedCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]RecordedCall, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// LastCall returns the most recent recorded call, or nil if none were made
+func (m *MockProvider) LastCall() *RecordedCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.calls) == 0 {
+		return nil
+	}
+	call := m.calls[len(m.calls)-1]
+	return &call
+}
+
+// Closed reports whether Close has been called
+func (m *MockProvider) Closed() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.closed
+}
+
+// Reset clears all scripted responses and recorded calls
+func (m *MockProvider) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.responses = nil
+	m.streams = nil
+	m.calls = nil
+	m.closed = false
+}
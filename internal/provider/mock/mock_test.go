@@ -0,0 +1,143 @@
+package mock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AINative-studio/ainative-code/internal/provider"
+)
+
+// Compile-time check that MockProvider satisfies the Provider interface
+var _ provider.Provider = (*MockProvider)(nil)
+
+func TestMockProviderChat(t *testing.T) {
+	m := NewMockProvider()
+	m.EnqueueResponse(provider.Response{Content: "first"})
+	m.EnqueueResponse(provider.Response{Content: "second"})
+
+	messages := []provider.Message{{Role: "user", Content: "hello"}}
+
+	resp, err := m.Chat(context.Background(), messages, provider.WithModel("mock-model"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Content != "first" {
+		t.Errorf("expected 'first', got: %s", resp.Content)
+	}
+
+	resp, err = m.Chat(context.Background(), messages)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Content != "second" {
+		t.Errorf("expected 'second', got: %s", resp.Content)
+	}
+}
+
+func TestMockProviderChatExhausted(t *testing.T) {
+	m := NewMockProvider()
+
+	_, err := m.Chat(context.Background(), nil)
+	if err == nil {
+		t.Fatal("expected error when no responses enqueued")
+	}
+}
+
+func TestMockProviderStream(t *testing.T) {
+	m := NewMockProvider()
+	m.EnqueueStream([]string{"Hello", ", ", "world"})
+
+	eventChan, err := m.Stream(context.Background(), []provider.Message{
+		{Role: "user", Content: "greet me"},
+	}, provider.StreamWithModel("mock-model"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var content string
+	var sawStart, sawEnd bool
+	for event := range eventChan {
+		switch event.Type {
+		case provider.EventTypeContentStart:
+			sawStart = true
+		case provider.EventTypeContentDelta:
+			content += event.Content
+		case provider.EventTypeContentEnd:
+			sawEnd = true
+			if event.Content != "Hello, world" {
+				t.Errorf("expected full content on end event, got: %s", event.Content)
+			}
+		}
+	}
+
+	if !sawStart || !sawEnd {
+		t.Errorf("expected start and end events, got start=%v end=%v", sawStart, sawEnd)
+	}
+	if content != "Hello, world" {
+		t.Errorf("expected 'Hello, world', got: %s", content)
+	}
+}
+
+func TestMockProviderRecordsCalls(t *testing.T) {
+	m := NewMockProvider()
+	m.EnqueueResponse(provider.Response{Content: "ok"})
+
+	messages := []provider.Message{{Role: "user", Content: "hi"}}
+	_, err := m.Chat(context.Background(), messages,
+		provider.WithModel("mock-model"),
+		provider.WithMaxTokens(42),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	calls := m.Calls()
+	if len(calls) != 1 {
+		t.Fatalf("expected 1 recorded call, got: %d", len(calls))
+	}
+
+	call := m.LastCall()
+	if call == nil {
+		t.Fatal("expected a last call")
+	}
+	if call.Stream {
+		t.Error("expected non-stream call")
+	}
+	if call.Options.Model != "mock-model" {
+		t.Errorf("expected model 'mock-model', got: %s", call.Options.Model)
+	}
+	if call.Options.MaxTokens != 42 {
+		t.Errorf("expected MaxTokens 42, got: %d", call.Options.MaxTokens)
+	}
+	if len(call.Messages) != 1 || call.Messages[0].Content != "hi" {
+		t.Errorf("unexpected recorded messages: %v", call.Messages)
+	}
+}
+
+func TestMockProviderReset(t *testing.T) {
+	m := NewMockProvider()
+	m.EnqueueResponse(provider.Response{Content: "ok"})
+	_, _ = m.Chat(context.Background(), nil)
+
+	m.Reset()
+
+	if len(m.Calls()) != 0 {
+		t.Error("expected no calls after reset")
+	}
+	if _, err := m.Chat(context.Background(), nil); err == nil {
+		t.Error("expected error after reset cleared responses")
+	}
+}
+
+func TestMockProviderClose(t *testing.T) {
+	m := NewMockProvider()
+	if m.Closed() {
+		t.Error("expected not closed initially")
+	}
+	if err := m.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !m.Closed() {
+		t.Error("expected closed after Close")
+	}
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"encoding/base64"
+	"errors"
+)
+
+// ErrMultimodalUnsupported is returned by providers that cannot accept image
+// content when a message carries image parts.
+var ErrMultimodalUnsupported = errors.New("provider does not support multimodal content")
+
+// Content part types
+const (
+	ContentPartText  = "text"
+	ContentPartImage = "image"
+)
+
+// ContentPart represents one piece of a multimodal message: either text or
+// an image. Images may be referenced by URL or carried inline as raw bytes
+// with a MIME type.
+type ContentPart struct {
+	Type      string // ContentPartText or ContentPartImage
+	Text      string // Text content when Type is ContentPartText
+	ImageURL  string // Remote image URL when Type is ContentPartImage
+	ImageData []byte // Inline image bytes when Type is ContentPartImage
+	MimeType  string // MIME type for inline image data, e.g. "image/png"
+}
+
+// TextPart creates a text content part
+func TextPart(text string) ContentPart {
+	return ContentPart{Type: ContentPartText, Text: text}
+}
+
+// ImageURLPart creates an image content part referencing a remote URL
+func ImageURLPart(url string) ContentPart {
+	return ContentPart{Type: ContentPartImage, ImageURL: url}
+}
+
+// ImageDataPart creates an image content part carrying inline bytes
+func ImageDataPart(data []byte, mimeType string) ContentPart {
+	return ContentPart{Type: ContentPartImage, ImageData: data, MimeType: mimeType}
+}
+
+// Base64Data returns the inline image data base64-encoded
+func (p ContentPart) Base64Data() string {
+	return base64.StdEncoding.EncodeToString(p.ImageData)
+}
+
+// HasImages reports whether the message carries any image parts
+func (m Message) HasImages() bool {
+	for _, part := range m.Parts {
+		if part.Type == ContentPartImage {
+			return true
+		}
+	}
+	return false
+}
+
+// MessagesContainImages reports whether any message carries image parts.
+// Providers without vision support use this to reject multimodal requests
+// with ErrMultimodalUnsupported.
+func MessagesContainImages(messages []Message) bool {
+	for _, msg := range messages {
+		if msg.HasImages() {
+			return true
+		}
+	}
+	return false
+}
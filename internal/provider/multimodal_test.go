@@ -0,0 +1,46 @@
+package provider
+
+import "testing"
+
+func TestContentPartConstructors(t *testing.T) {
+	text := TextPart("hello")
+	if text.Type != ContentPartText || text.Text != "hello" {
+		t.Errorf("unexpected text part: %+v", text)
+	}
+
+	url := ImageURLPart("https://example.com/a.png")
+	if url.Type != ContentPartImage || url.ImageURL != "https://example.com/a.png" {
+		t.Errorf("unexpected image URL part: %+v", url)
+	}
+
+	data := ImageDataPart([]byte{0x89, 0x50}, "image/png")
+	if data.Type != ContentPartImage || data.MimeType != "image/png" {
+		t.Errorf("unexpected image data part: %+v", data)
+	}
+	if data.Base64Data() != "iVA=" {
+		t.Errorf("unexpected base64 encoding: %s", data.Base64Data())
+	}
+}
+
+func TestMessageHasImages(t *testing.T) {
+	textOnly := Message{Role: "user", Content: "hi"}
+	if textOnly.HasImages() {
+		t.Error("text-only message should not report images")
+	}
+
+	withParts := Message{Role: "user", Parts: []ContentPart{
+		TextPart("describe this"),
+		ImageURLPart("https://example.com/a.png"),
+	}}
+	if !withParts.HasImages() {
+		t.Error("message with image part should report images")
+	}
+
+	if !MessagesContainImages([]Message{textOnly, withParts}) {
+		t.Error("expected MessagesContainImages to detect image part")
+	}
+
+	if MessagesContainImages([]Message{textOnly}) {
+		t.Error("expected no images in text-only messages")
+	}
+}
@@ -68,10 +68,20 @@ func (o *OllamaProvider) Models() []string {
 
 // Chat sends a chat request to Ollama
 func (o *OllamaProvider) Chat(ctx context.Context, messages []provider.Message, opts ...provider.ChatOption) (provider.Response, error) {
+	// Image parts are not supported by this provider
+	if provider.MessagesContainImages(messages) {
+		return provider.Response{}, provider.ErrMultimodalUnsupported
+	}
+
 	// Apply options
 	options := provider.DefaultChatOptions()
 	provider.ApplyChatOptions(options, opts...)
 
+	// Seeds and structured response formats are not supported by this provider
+	if provider.OptionsRequireStructuredSupport(options) {
+		return provider.Response{}, provider.ErrUnsupportedOption
+	}
+
 	// Use config model if not specified
 	if options.Model == "" {
 		options.Model = o.config.Model
@@ -111,10 +121,20 @@ func (o *OllamaProvider) Chat(ctx context.Context, messages []provider.Message,
 
 // Stream sends a streaming chat request to Ollama
 func (o *OllamaProvider) Stream(ctx context.Context, messages []provider.Message, opts ...provider.StreamOption) (<-chan provider.Event, error) {
+	// Image parts are not supported by this provider
+	if provider.MessagesContainImages(messages) {
+		return nil, provider.ErrMultimodalUnsupported
+	}
+
 	// Apply options
 	options := provider.DefaultChatOptions()
 	provider.ApplyStreamOptions(options, opts...)
 
+	// Seeds and structured response formats are not supported by this provider
+	if provider.OptionsRequireStructuredSupport(options) {
+		return nil, provider.ErrUnsupportedOption
+	}
+
 	// Use config model if not specified
 	if options.Model == "" {
 		options.Model = o.config.Model
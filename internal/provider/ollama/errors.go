@@ -78,8 +78,11 @@ func parseOllamaError(statusCode int, body []byte, model string) error {
 	case http.StatusBadRequest:
 		return provider.NewProviderError("ollama", model, fmt.Errorf("bad request: %s", string(body)))
 	case http.StatusInternalServerError:
-		return provider.NewProviderError("ollama", model, fmt.Errorf("internal server error: %s", string(body)))
+		return provider.NewServerError("ollama", statusCode, string(body))
 	default:
+		if statusCode >= http.StatusInternalServerError {
+			return provider.NewServerError("ollama", statusCode, string(body))
+		}
 		return provider.NewProviderError("ollama", model, fmt.Errorf("HTTP %d: %s", statusCode, string(body)))
 	}
 }
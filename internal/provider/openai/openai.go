@@ -48,6 +48,8 @@ type OpenAIProvider struct {
 	apiKey       string
 	baseURL      string
 	organization string
+	authHeader   string
+	authScheme   string
 }
 
 // Config contains configuration for the OpenAI provider
@@ -57,6 +59,16 @@ type Config struct {
 	Organization string // Optional organization ID
 	HTTPClient   *http.Client
 	Logger       logger.LoggerInterface
+
+	// AuthHeader is the header used to send the API key. Defaults to
+	// "Authorization". OpenAI-compatible backends like Azure OpenAI use
+	// "api-key" instead.
+	AuthHeader string
+
+	// AuthScheme prefixes the API key in the auth header. Defaults to
+	// "Bearer" when AuthHeader is left at its default; when empty the raw
+	// key is sent, which is what api-key style headers expect.
+	AuthScheme string
 }
 
 // NewOpenAIProvider creates a new OpenAI provider instance
@@ -70,6 +82,17 @@ func NewOpenAIProvider(config Config) (*OpenAIProvider, error) {
 		baseURL = OpenAIAPIURL
 	}
 
+	authHeader := config.AuthHeader
+	authScheme := config.AuthScheme
+	if authHeader == "" {
+		authHeader = "Authorization"
+		// Only default the scheme for the standard header; custom headers
+		// (e.g. Azure's "api-key") send the raw key unless a scheme is given
+		if authScheme == "" {
+			authScheme = "Bearer"
+		}
+	}
+
 	baseProvider := provider.NewBaseProvider(provider.BaseProviderConfig{
 		Name:        "openai",
 		HTTPClient:  config.HTTPClient,
@@ -82,6 +105,8 @@ func NewOpenAIProvider(config Config) (*OpenAIProvider, error) {
 		apiKey:       config.APIKey,
 		baseURL:      baseURL,
 		organization: config.Organization,
+		authHeader:   authHeader,
+		authScheme:   authScheme,
 	}, nil
 }
 
@@ -170,7 +195,7 @@ func (o *OpenAIProvider) Stream(ctx context.Context, messages []provider.Message
 	eventChan := make(chan provider.Event)
 
 	// Start streaming goroutine
-	go o.streamResponse(ctx, resp.Body, eventChan, options.Model)
+	go o.streamResponse(ctx, resp.Body, eventChan, options.Model, options.IncludeUsage)
 
 	return eventChan, nil
 }
@@ -192,6 +217,11 @@ func (o *OpenAIProvider) buildRequest(ctx context.Context, messages []provider.M
 		Stream:   stream,
 	}
 
+	// Request usage reporting on the final stream chunk
+	if stream && options.IncludeUsage {
+		reqBody.StreamOptions = &streamOptions{IncludeUsage: true}
+	}
+
 	// Add optional fields
 	if options.MaxTokens > 0 {
 		reqBody.MaxTokens = options.MaxTokens
@@ -205,6 +235,25 @@ func (o *OpenAIProvider) buildRequest(ctx context.Context, messages []provider.M
 	if len(options.StopSequences) > 0 {
 		reqBody.Stop = options.StopSequences
 	}
+	if len(options.Tools) > 0 {
+		reqBody.Tools = convertTools(options.Tools)
+	}
+	if options.Seed != 0 {
+		reqBody.Seed = &options.Seed
+	}
+	switch options.ResponseFormat {
+	case provider.ResponseFormatJSON:
+		reqBody.ResponseFormat = &responseFormat{Type: "json_object"}
+	case provider.ResponseFormatJSONSchema:
+		reqBody.ResponseFormat = &responseFormat{
+			Type: "json_schema",
+			JSONSchema: &jsonSchemaSpec{
+				Name:   "response",
+				Schema: options.ResponseSchema,
+				Strict: true,
+			},
+		}
+	}
 
 	// Marshal request body
 	jsonBody, err := json.Marshal(reqBody)
@@ -221,7 +270,11 @@ func (o *OpenAIProvider) buildRequest(ctx context.Context, messages []provider.M
 
 	// Set headers
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", o.apiKey))
+	if o.authScheme != "" {
+		req.Header.Set(o.authHeader, fmt.Sprintf("%s %s", o.authScheme, o.apiKey))
+	} else {
+		req.Header.Set(o.authHeader, o.apiKey)
+	}
 
 	if o.organization != "" {
 		req.Header.Set("OpenAI-Organization", o.organization)
@@ -245,15 +298,76 @@ func (o *OpenAIProvider) convertMessages(messages []provider.Message, systemProm
 	// Convert messages
 	for _, msg := range messages {
 		// OpenAI supports system messages in the messages array
-		apiMessages = append(apiMessages, openAIMessage{
-			Role:    msg.Role,
-			Content: msg.Content,
-		})
+		apiMsg := openAIMessage{
+			Role:       msg.Role,
+			Content:    msg.Content,
+			ToolCallID: msg.ToolCallID,
+		}
+
+		// Multimodal parts take precedence over plain text content
+		if len(msg.Parts) > 0 {
+			apiMsg.Content = convertParts(msg.Parts)
+		}
+
+		// Replay assistant tool calls so the API can match tool results
+		for _, tc := range msg.ToolCalls {
+			apiMsg.ToolCalls = append(apiMsg.ToolCalls, toolCall{
+				ID:   tc.ID,
+				Type: "function",
+				Function: functionCall{
+					Name:      tc.Name,
+					Arguments: tc.Arguments,
+				},
+			})
+		}
+
+		apiMessages = append(apiMessages, apiMsg)
 	}
 
 	return apiMessages
 }
 
+// convertParts converts multimodal message parts to OpenAI content parts
+func convertParts(parts []provider.ContentPart) []contentPart {
+	apiParts := make([]contentPart, 0, len(parts))
+	for _, part := range parts {
+		switch part.Type {
+		case provider.ContentPartText:
+			apiParts = append(apiParts, contentPart{
+				Type: "text",
+				Text: part.Text,
+			})
+		case provider.ContentPartImage:
+			url := part.ImageURL
+			if url == "" && len(part.ImageData) > 0 {
+				// Inline images are sent as data URLs
+				url = fmt.Sprintf("data:%s;base64,%s", part.MimeType, part.Base64Data())
+			}
+			apiParts = append(apiParts, contentPart{
+				Type:     "image_url",
+				ImageURL: &imageURL{URL: url},
+			})
+		}
+	}
+	return apiParts
+}
+
+// convertTools converts provider tool definitions to OpenAI function tools
+func convertTools(tools []provider.ToolDefinition) []openAITool {
+	apiTools := make([]openAITool, 0, len(tools))
+	for _, tool := range tools {
+		apiTools = append(apiTools, openAITool{
+			Type: "function",
+			Function: functionDef{
+				Name:        tool.Name,
+				Description: tool.Description,
+				Parameters:  tool.Parameters,
+			},
+		})
+	}
+	return apiTools
+}
+
 // parseResponse parses the OpenAI API response
 func (o *OpenAIProvider) parseResponse(body []byte, model string) (provider.Response, error) {
 	var apiResp openAIResponse
@@ -284,9 +398,20 @@ func (o *OpenAIProvider) parseResponse(body []byte, model string) (provider.Resp
 		}
 	}
 
+	// Extract tool calls if the model requested any
+	var toolCalls []provider.ToolCall
+	for _, tc := range apiResp.Choices[0].Message.ToolCalls {
+		toolCalls = append(toolCalls, provider.ToolCall{
+			ID:        tc.ID,
+			Name:      tc.Function.Name,
+			Arguments: tc.Function.Arguments,
+		})
+	}
+
 	return provider.Response{
-		Content: content,
-		Model:   apiResp.Model,
+		Content:   content,
+		Model:     apiResp.Model,
+		ToolCalls: toolCalls,
 		Usage: provider.Usage{
 			PromptTokens:     apiResp.Usage.PromptTokens,
 			CompletionTokens: apiResp.Usage.CompletionTokens,
@@ -302,12 +427,13 @@ type eventResult struct {
 }
 
 // streamResponse handles streaming SSE responses from the OpenAI API
-func (o *OpenAIProvider) streamResponse(ctx context.Context, body io.ReadCloser, eventChan chan<- provider.Event, model string) {
+func (o *OpenAIProvider) streamResponse(ctx context.Context, body io.ReadCloser, eventChan chan<- provider.Event, model string, includeUsage bool) {
 	defer close(eventChan)
 	defer body.Close()
 
 	reader := newSSEReader(body)
 	var currentText string
+	var finished bool
 
 	// Send start event
 	eventChan <- provider.Event{
@@ -339,7 +465,16 @@ func (o *OpenAIProvider) streamResponse(ctx context.Context, body io.ReadCloser,
 
 		// Handle read errors
 		if err != nil {
-			if err != io.EOF {
+			if err == io.EOF {
+				// Stream ended without [DONE]; still close out the content
+				if finished {
+					eventChan <- provider.Event{
+						Type:    provider.EventTypeContentEnd,
+						Content: currentText,
+						Done:    true,
+					}
+				}
+			} else {
 				eventChan <- provider.Event{
 					Type:  provider.EventTypeError,
 					Error: provider.NewProviderError("openai", model, err),
@@ -365,12 +500,30 @@ func (o *OpenAIProvider) streamResponse(ctx context.Context, body io.ReadCloser,
 			continue
 		}
 
+		// Emit usage if the final chunk carries it (stream_options.include_usage)
+		if chunk.Usage != nil {
+			eventChan <- provider.Event{
+				Type: provider.EventTypeUsage,
+				Usage: &provider.Usage{
+					PromptTokens:     chunk.Usage.PromptTokens,
+					CompletionTokens: chunk.Usage.CompletionTokens,
+					TotalTokens:      chunk.Usage.TotalTokens,
+				},
+			}
+		}
+
 		// Process choices
 		if len(chunk.Choices) > 0 {
 			choice := chunk.Choices[0]
 
 			// Check for finish
 			if choice.FinishReason != nil && *choice.FinishReason != "" {
+				// When usage was requested it arrives in a trailing chunk,
+				// so keep reading until the usage chunk or [DONE]
+				if includeUsage {
+					finished = true
+					continue
+				}
 				eventChan <- provider.Event{
 					Type:    provider.EventTypeContentEnd,
 					Content: currentText,
@@ -429,6 +582,9 @@ func (o *OpenAIProvider) convertAPIError(apiErr *openAIError, statusCode int, mo
 		}
 		return provider.NewProviderError("openai", model, fmt.Errorf("not found: %s", errMsg))
 
+	case statusCode >= http.StatusInternalServerError:
+		return provider.NewServerError("openai", statusCode, errMsg)
+
 	default:
 		return provider.NewProviderError("openai", model, fmt.Errorf("%s: %s", errType, errMsg))
 	}
@@ -3,6 +3,7 @@ package openai
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -680,3 +681,210 @@ func TestOpenAIProvider_WithOptions(t *testing.T) {
 	)
 	require.NoError(t, err)
 }
+
+func TestOpenAIProvider_AuthHeaders(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     Config
+		wantHeader string
+		wantValue  string
+	}{
+		{
+			name:       "default Authorization Bearer",
+			config:     Config{APIKey: "test-key"},
+			wantHeader: "Authorization",
+			wantValue:  "Bearer test-key",
+		},
+		{
+			name: "Azure api-key header sends raw key",
+			config: Config{
+				APIKey:     "azure-key",
+				AuthHeader: "api-key",
+			},
+			wantHeader: "api-key",
+			wantValue:  "azure-key",
+		},
+		{
+			name: "custom header with explicit scheme",
+			config: Config{
+				APIKey:     "together-key",
+				AuthHeader: "X-Api-Key",
+				AuthScheme: "Token",
+			},
+			wantHeader: "X-Api-Key",
+			wantValue:  "Token together-key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotValue string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotValue = r.Header.Get(tt.wantHeader)
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id":"1","model":"gpt-4","choices":[{"index":0,"message":{"role":"assistant","content":"ok"},"finish_reason":"stop"}],"usage":{"prompt_tokens":1,"completion_tokens":1,"total_tokens":2}}`))
+			}))
+			defer server.Close()
+
+			tt.config.BaseURL = server.URL
+			p, err := NewOpenAIProvider(tt.config)
+			require.NoError(t, err)
+			defer p.Close()
+
+			_, err = p.Chat(context.Background(), []provider.Message{
+				{Role: "user", Content: "hi"},
+			}, provider.WithModel("gpt-4"))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantValue, gotValue)
+		})
+	}
+}
+
+func TestOpenAIProvider_SeedAndResponseFormat(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req openAIRequest
+		require.NoError(t, json.Unmarshal(body, &req))
+
+		require.NotNil(t, req.Seed)
+		assert.Equal(t, 42, *req.Seed)
+		require.NotNil(t, req.ResponseFormat)
+		assert.Equal(t, "json_object", req.ResponseFormat.Type)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"id": "test",
+			"object": "chat.completion",
+			"created": 1677652288,
+			"model": "gpt-4",
+			"choices": [{"index": 0, "message": {"role": "assistant", "content": "{}"}, "finish_reason": "stop"}],
+			"usage": {"prompt_tokens": 10, "completion_tokens": 5, "total_tokens": 15}
+		}`))
+	}))
+	defer server.Close()
+
+	p, err := NewOpenAIProvider(Config{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	messages := []provider.Message{{Role: "user", Content: "extract"}}
+	_, err = p.Chat(ctx, messages,
+		provider.WithModel("gpt-4"),
+		provider.WithSeed(42),
+		provider.WithResponseFormat(provider.ResponseFormatJSON),
+	)
+	require.NoError(t, err)
+}
+
+func TestOpenAIProvider_ResponseSchema(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req openAIRequest
+		require.NoError(t, json.Unmarshal(body, &req))
+
+		require.NotNil(t, req.ResponseFormat)
+		assert.Equal(t, "json_schema", req.ResponseFormat.Type)
+		require.NotNil(t, req.ResponseFormat.JSONSchema)
+		assert.True(t, req.ResponseFormat.JSONSchema.Strict)
+		assert.NotNil(t, req.ResponseFormat.JSONSchema.Schema)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"id": "test",
+			"object": "chat.completion",
+			"created": 1677652288,
+			"model": "gpt-4",
+			"choices": [{"index": 0, "message": {"role": "assistant", "content": "{}"}, "finish_reason": "stop"}],
+			"usage": {"prompt_tokens": 10, "completion_tokens": 5, "total_tokens": 15}
+		}`))
+	}))
+	defer server.Close()
+
+	p, err := NewOpenAIProvider(Config{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	messages := []provider.Message{{Role: "user", Content: "extract"}}
+	_, err = p.Chat(ctx, messages,
+		provider.WithModel("gpt-4"),
+		provider.WithResponseSchema(map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"name": map[string]interface{}{"type": "string"},
+			},
+		}),
+	)
+	require.NoError(t, err)
+}
+
+func TestConvertAPIError_Sentinels(t *testing.T) {
+	p, err := NewOpenAIProvider(Config{APIKey: "test-key"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		statusCode int
+		apiErr     *openAIError
+		sentinel   error
+	}{
+		{
+			name:       "429 maps to ErrRateLimited",
+			statusCode: http.StatusTooManyRequests,
+			apiErr: &openAIError{Error: errorDetails{
+				Type:    "rate_limit_error",
+				Message: "Rate limit reached for requests",
+			}},
+			sentinel: provider.ErrRateLimited,
+		},
+		{
+			name:       "401 maps to ErrAuthentication",
+			statusCode: http.StatusUnauthorized,
+			apiErr: &openAIError{Error: errorDetails{
+				Type:    "invalid_request_error",
+				Message: "Incorrect API key provided",
+			}},
+			sentinel: provider.ErrAuthentication,
+		},
+		{
+			name:       "context length maps to ErrContextLength",
+			statusCode: http.StatusBadRequest,
+			apiErr: &openAIError{Error: errorDetails{
+				Type:    "invalid_request_error",
+				Message: "This model's maximum context length is 128000 tokens",
+			}},
+			sentinel: provider.ErrContextLength,
+		},
+		{
+			name:       "500 maps to ErrServerError",
+			statusCode: http.StatusInternalServerError,
+			apiErr: &openAIError{Error: errorDetails{
+				Type:    "server_error",
+				Message: "The server had an error while processing your request",
+			}},
+			sentinel: provider.ErrServerError,
+		},
+		{
+			name:       "503 maps to ErrServerError",
+			statusCode: http.StatusServiceUnavailable,
+			apiErr: &openAIError{Error: errorDetails{
+				Type:    "server_error",
+				Message: "The engine is currently overloaded",
+			}},
+			sentinel: provider.ErrServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.convertAPIError(tt.apiErr, tt.statusCode, "gpt-4")
+			assert.ErrorIs(t, err, tt.sentinel)
+		})
+	}
+}
@@ -16,6 +16,13 @@ type openAIRequest struct {
 	Tools            []openAITool    `json:"tools,omitempty"`
 	ToolChoice       interface{}     `json:"tool_choice,omitempty"`
 	ResponseFormat   *responseFormat `json:"response_format,omitempty"`
+	StreamOptions    *streamOptions  `json:"stream_options,omitempty"`
+	Seed             *int            `json:"seed,omitempty"`
+}
+
+// streamOptions controls streaming behavior
+type streamOptions struct {
+	IncludeUsage bool `json:"include_usage"`
 }
 
 // openAIMessage represents a message in the OpenAI API format
@@ -68,7 +75,15 @@ type functionCall struct {
 
 // responseFormat controls the output format
 type responseFormat struct {
-	Type string `json:"type"` // "text" or "json_object"
+	Type       string          `json:"type"` // "text", "json_object" or "json_schema"
+	JSONSchema *jsonSchemaSpec `json:"json_schema,omitempty"`
+}
+
+// jsonSchemaSpec carries the schema for json_schema response format
+type jsonSchemaSpec struct {
+	Name   string      `json:"name"`
+	Schema interface{} `json:"schema"`
+	Strict bool        `json:"strict"`
 }
 
 // openAIResponse represents a response from the OpenAI Chat Completions API
@@ -121,6 +136,7 @@ type openAIStreamResponse struct {
 	Created int64          `json:"created"`
 	Model   string         `json:"model"`
 	Choices []streamChoice `json:"choices"`
+	Usage   *usage         `json:"usage,omitempty"` // Final chunk when stream_options.include_usage is set
 }
 
 // streamChoice represents a streaming completion choice
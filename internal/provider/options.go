@@ -10,6 +10,33 @@ type ChatOptions struct {
 	SystemPrompt   string
 	Stream         bool
 	Metadata       map[string]string
+	Tools          []ToolDefinition
+	IncludeUsage   bool
+
+	// ContextWindow, when positive, is the model's context size in tokens;
+	// a ContextWindowProvider trims old messages to stay within it
+	ContextWindow int
+
+	// KeepRecentTurns is how many of the most recent conversational turns
+	// (user/assistant pairs) are protected from trimming
+	KeepRecentTurns int
+
+	// TrimStrategy selects how over-budget messages are handled
+	TrimStrategy TrimStrategy
+
+	// Seed, when non-zero, requests deterministic sampling from providers
+	// that support it
+	Seed int
+
+	// ResponseFormat constrains the output format for structured extraction
+	ResponseFormat ResponseFormat
+
+	// ResponseSchema is the JSON schema enforced when ResponseFormat is
+	// ResponseFormatJSONSchema
+	ResponseSchema map[string]interface{}
+
+	RequestMiddlewares  []RequestMiddleware
+	ResponseMiddlewares []ResponseMiddleware
 }
 
 // ChatOption is a function that modifies ChatOptions
@@ -70,6 +97,63 @@ func WithMetadata(key, value string) ChatOption {
 	}
 }
 
+// WithContextWindow sets the model's context size in tokens so a
+// ContextWindowProvider can trim old messages before sending
+func WithContextWindow(maxTokens int) ChatOption {
+	return func(opts *ChatOptions) {
+		opts.ContextWindow = maxTokens
+	}
+}
+
+// WithKeepRecentTurns protects the given number of most recent
+// conversational turns (user/assistant pairs) from context-window trimming
+func WithKeepRecentTurns(turns int) ChatOption {
+	return func(opts *ChatOptions) {
+		opts.KeepRecentTurns = turns
+	}
+}
+
+// WithTrimStrategy selects how a context-window guard handles messages
+// that no longer fit (drop-oldest or summarize)
+func WithTrimStrategy(strategy TrimStrategy) ChatOption {
+	return func(opts *ChatOptions) {
+		opts.TrimStrategy = strategy
+	}
+}
+
+// WithSeed requests deterministic sampling with the given seed. Providers
+// without seed support return ErrUnsupportedOption.
+func WithSeed(seed int) ChatOption {
+	return func(opts *ChatOptions) {
+		opts.Seed = seed
+	}
+}
+
+// WithResponseFormat constrains the output format (for example
+// ResponseFormatJSON). Providers without structured output support return
+// ErrUnsupportedOption.
+func WithResponseFormat(format ResponseFormat) ChatOption {
+	return func(opts *ChatOptions) {
+		opts.ResponseFormat = format
+	}
+}
+
+// WithResponseSchema constrains the output to the given JSON schema and
+// implies ResponseFormatJSONSchema
+func WithResponseSchema(schema map[string]interface{}) ChatOption {
+	return func(opts *ChatOptions) {
+		opts.ResponseFormat = ResponseFormatJSONSchema
+		opts.ResponseSchema = schema
+	}
+}
+
+// WithTools sets the tool definitions the model may call during the request
+func WithTools(tools []ToolDefinition) ChatOption {
+	return func(opts *ChatOptions) {
+		opts.Tools = tools
+	}
+}
+
 // StreamWithModel sets the model to use for streaming requests
 func StreamWithModel(model string) StreamOption {
 	return func(opts *ChatOptions) {
@@ -129,6 +213,45 @@ func StreamWithMetadata(key, value string) StreamOption {
 	}
 }
 
+// StreamWithContextWindow sets the context size for streaming requests
+func StreamWithContextWindow(maxTokens int) StreamOption {
+	return func(opts *ChatOptions) {
+		opts.ContextWindow = maxTokens
+		opts.Stream = true
+	}
+}
+
+// StreamWithSeed requests deterministic sampling for streaming requests
+func StreamWithSeed(seed int) StreamOption {
+	return func(opts *ChatOptions) {
+		opts.Seed = seed
+	}
+}
+
+// StreamWithResponseFormat constrains the output format for streaming requests
+func StreamWithResponseFormat(format ResponseFormat) StreamOption {
+	return func(opts *ChatOptions) {
+		opts.ResponseFormat = format
+	}
+}
+
+// StreamWithTools sets the tool definitions for streaming requests
+func StreamWithTools(tools []ToolDefinition) StreamOption {
+	return func(opts *ChatOptions) {
+		opts.Tools = tools
+		opts.Stream = true
+	}
+}
+
+// StreamWithUsage toggles emission of a final usage event on streaming
+// requests. Usage reporting is on by default; pass false to disable it.
+func StreamWithUsage(include bool) StreamOption {
+	return func(opts *ChatOptions) {
+		opts.IncludeUsage = include
+		opts.Stream = true
+	}
+}
+
 // ApplyChatOptions applies a list of ChatOption functions to ChatOptions
 func ApplyChatOptions(opts *ChatOptions, options ...ChatOption) {
 	for _, opt := range options {
@@ -147,10 +270,11 @@ func ApplyStreamOptions(opts *ChatOptions, options ...StreamOption) {
 // DefaultChatOptions returns ChatOptions with sensible defaults
 func DefaultChatOptions() *ChatOptions {
 	return &ChatOptions{
-		MaxTokens:   1024,
-		Temperature: 0.7,
-		TopP:        1.0,
-		Stream:      false,
-		Metadata:    make(map[string]string),
+		MaxTokens:    1024,
+		Temperature:  0.7,
+		TopP:         1.0,
+		Stream:       false,
+		IncludeUsage: true,
+		Metadata:     make(map[string]string),
 	}
 }
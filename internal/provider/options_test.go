@@ -659,3 +659,69 @@ func TestNoChatOptionSetsStream(t *testing.T) {
 		})
 	}
 }
+
+func TestStreamWithUsage(t *testing.T) {
+	opts := DefaultChatOptions()
+	if !opts.IncludeUsage {
+		t.Error("expected IncludeUsage on by default")
+	}
+
+	StreamWithUsage(false)(opts)
+	if opts.IncludeUsage {
+		t.Error("expected IncludeUsage false after StreamWithUsage(false)")
+	}
+	if !opts.Stream {
+		t.Error("expected Stream true")
+	}
+
+	StreamWithUsage(true)(opts)
+	if !opts.IncludeUsage {
+		t.Error("expected IncludeUsage true after StreamWithUsage(true)")
+	}
+}
+
+func TestWithSeed(t *testing.T) {
+	opts := &ChatOptions{}
+	WithSeed(42)(opts)
+
+	if opts.Seed != 42 {
+		t.Errorf("expected Seed 42, got: %d", opts.Seed)
+	}
+}
+
+func TestWithResponseFormat(t *testing.T) {
+	opts := &ChatOptions{}
+	WithResponseFormat(ResponseFormatJSON)(opts)
+
+	if opts.ResponseFormat != ResponseFormatJSON {
+		t.Errorf("expected ResponseFormat %q, got: %q", ResponseFormatJSON, opts.ResponseFormat)
+	}
+}
+
+func TestWithResponseSchema(t *testing.T) {
+	opts := &ChatOptions{}
+	schema := map[string]interface{}{"type": "object"}
+	WithResponseSchema(schema)(opts)
+
+	if opts.ResponseFormat != ResponseFormatJSONSchema {
+		t.Errorf("expected ResponseFormat %q, got: %q", ResponseFormatJSONSchema, opts.ResponseFormat)
+	}
+
+	if opts.ResponseSchema == nil {
+		t.Fatal("expected ResponseSchema set, got nil")
+	}
+}
+
+func TestOptionsRequireStructuredSupport(t *testing.T) {
+	if OptionsRequireStructuredSupport(&ChatOptions{}) {
+		t.Error("expected plain options not to require structured support")
+	}
+
+	if !OptionsRequireStructuredSupport(&ChatOptions{Seed: 7}) {
+		t.Error("expected seeded options to require structured support")
+	}
+
+	if !OptionsRequireStructuredSupport(&ChatOptions{ResponseFormat: ResponseFormatJSON}) {
+		t.Error("expected formatted options to require structured support")
+	}
+}
@@ -22,8 +22,20 @@ type Provider interface {
 
 // Message represents a chat message
 type Message struct {
-	Role    string // "user", "assistant", "system"
+	Role    string // "user", "assistant", "system", "tool"
 	Content string
+
+	// Parts carries multimodal content (text and images). When non-empty it
+	// takes precedence over Content; Content remains the convenience field
+	// for text-only messages.
+	Parts []ContentPart
+
+	// ToolCalls carries tool invocations on an assistant message that is
+	// being replayed back to the provider in a tool-calling conversation.
+	ToolCalls []ToolCall
+
+	// ToolCallID identifies which tool call a "tool" role message responds to.
+	ToolCallID string
 }
 
 // Response represents a complete chat response
@@ -31,6 +43,15 @@ type Response struct {
 	Content string
 	Usage   Usage
 	Model   string
+
+	// ToolCalls is populated when the model requests one or more tool
+	// invocations instead of (or alongside) text content. When the model
+	// stops to call tools, Content is typically empty.
+	ToolCalls []ToolCall
+
+	// Metadata carries auxiliary information about how the request was
+	// handled, such as which messages a context-window guard trimmed.
+	Metadata map[string]string
 }
 
 // Usage represents token usage statistics
@@ -46,6 +67,10 @@ type Event struct {
 	Content string
 	Error   error
 	Done    bool
+
+	// Usage is populated on EventTypeUsage events emitted at the end of a
+	// stream when the provider reports token consumption.
+	Usage *Usage
 }
 
 // EventType represents the type of streaming event
@@ -56,6 +81,8 @@ const (
 	EventTypeContentStart                   // Stream started
 	EventTypeContentEnd                     // Stream completed
 	EventTypeError                          // Error occurred
+	EventTypeThinking                       // Extended thinking/reasoning content
+	EventTypeUsage                          // Token usage reported on a stream
 )
 
 // String returns the string representation of EventType
@@ -69,6 +96,10 @@ func (e EventType) String() string {
 		return "ContentEnd"
 	case EventTypeError:
 		return "Error"
+	case EventTypeThinking:
+		return "Thinking"
+	case EventTypeUsage:
+		return "Usage"
 	default:
 		return "Unknown"
 	}
@@ -0,0 +1,37 @@
+package provider
+
+import "strings"
+
+// CollectStream consumes a provider event stream and assembles the complete
+// Response: content deltas are concatenated in order and the final usage
+// report is captured. This saves callers that stream for UI responsiveness
+// from reimplementing the reduce loop when they also need the full response
+// for persistence.
+//
+// When the stream errors mid-way, CollectStream returns the partial response
+// accumulated so far alongside the first error, so callers can still persist
+// what was received.
+func CollectStream(eventChan <-chan Event) (*Response, error) {
+	var content strings.Builder
+	response := &Response{}
+	var firstErr error
+
+	for event := range eventChan {
+		switch event.Type {
+		case EventTypeContentDelta:
+			content.WriteString(event.Content)
+		case EventTypeUsage:
+			if event.Usage != nil {
+				response.Usage = *event.Usage
+			}
+		case EventTypeError:
+			if firstErr == nil && event.Error != nil {
+				firstErr = event.Error
+			}
+		}
+	}
+
+	response.Content = content.String()
+
+	return response, firstErr
+}
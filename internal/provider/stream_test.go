@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+)
+
+// streamEvents returns a closed channel pre-loaded with the given events
+func streamEvents(events ...Event) <-chan Event {
+	ch := make(chan Event, len(events))
+	for _, event := range events {
+		ch <- event
+	}
+	close(ch)
+	return ch
+}
+
+func TestCollectStream(t *testing.T) {
+	eventChan := streamEvents(
+		Event{Type: EventTypeContentStart},
+		Event{Type: EventTypeContentDelta, Content: "Hello, "},
+		Event{Type: EventTypeContentDelta, Content: "world"},
+		Event{Type: EventTypeUsage, Usage: &Usage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15}},
+		Event{Type: EventTypeContentEnd, Done: true},
+	)
+
+	response, err := CollectStream(eventChan)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if response.Content != "Hello, world" {
+		t.Errorf("expected concatenated content, got %q", response.Content)
+	}
+	if response.Usage.TotalTokens != 15 {
+		t.Errorf("expected usage to be captured, got %+v", response.Usage)
+	}
+}
+
+func TestCollectStream_EmptyStream(t *testing.T) {
+	response, err := CollectStream(streamEvents())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if response.Content != "" {
+		t.Errorf("expected empty content, got %q", response.Content)
+	}
+}
+
+func TestCollectStream_ErrorMidStream(t *testing.T) {
+	streamErr := errors.New("connection reset")
+	eventChan := streamEvents(
+		Event{Type: EventTypeContentDelta, Content: "partial "},
+		Event{Type: EventTypeContentDelta, Content: "answer"},
+		Event{Type: EventTypeError, Error: streamErr},
+	)
+
+	response, err := CollectStream(eventChan)
+	if !errors.Is(err, streamErr) {
+		t.Fatalf("expected stream error, got %v", err)
+	}
+
+	// Partial content survives the error for persistence
+	if response == nil || response.Content != "partial answer" {
+		t.Errorf("expected partial content alongside error, got %+v", response)
+	}
+}
+
+func TestCollectStream_FirstErrorWins(t *testing.T) {
+	firstErr := errors.New("first")
+	eventChan := streamEvents(
+		Event{Type: EventTypeError, Error: firstErr},
+		Event{Type: EventTypeError, Error: errors.New("second")},
+	)
+
+	_, err := CollectStream(eventChan)
+	if !errors.Is(err, firstErr) {
+		t.Errorf("expected first error to be surfaced, got %v", err)
+	}
+}
+
+func TestCollectStream_ThinkingNotInContent(t *testing.T) {
+	eventChan := streamEvents(
+		Event{Type: EventTypeThinking, Content: "reasoning..."},
+		Event{Type: EventTypeContentDelta, Content: "final answer"},
+	)
+
+	response, err := CollectStream(eventChan)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if response.Content != "final answer" {
+		t.Errorf("expected thinking content to be excluded, got %q", response.Content)
+	}
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"errors"
+)
+
+// ErrUnsupportedOption is returned by providers when a request asks for a
+// capability (deterministic seed or constrained response format) the
+// underlying API does not implement, instead of silently ignoring it.
+var ErrUnsupportedOption = errors.New("option not supported by this provider")
+
+// ResponseFormat names an output format constraint for providers that
+// support structured responses
+type ResponseFormat string
+
+const (
+	// ResponseFormatJSON asks the model to emit a syntactically valid JSON object
+	ResponseFormatJSON ResponseFormat = "json_object"
+
+	// ResponseFormatJSONSchema constrains output to the JSON schema carried
+	// in ChatOptions.ResponseSchema
+	ResponseFormatJSONSchema ResponseFormat = "json_schema"
+)
+
+// OptionsRequireStructuredSupport reports whether the options ask for a
+// deterministic seed or a constrained response format. Providers without
+// support use this to reject such requests with ErrUnsupportedOption.
+func OptionsRequireStructuredSupport(opts *ChatOptions) bool {
+	return opts.Seed != 0 || opts.ResponseFormat != ""
+}
@@ -0,0 +1,21 @@
+package provider
+
+// ToolDefinition describes a function/tool that the model may call.
+// It is provider-agnostic: each provider implementation maps it to its
+// native tool format (OpenAI function tools, Gemini function declarations,
+// Anthropic tools). Tool schemas discovered from MCP servers can be mapped
+// to this type directly.
+type ToolDefinition struct {
+	Name        string                 // Unique tool name
+	Description string                 // Human-readable description of what the tool does
+	Parameters  map[string]interface{} // JSON schema describing the tool's parameters
+}
+
+// ToolCall represents a tool invocation requested by the model.
+// The caller is expected to execute the tool, append a tool-result
+// message (role "tool" with ToolCallID set), and call Chat again.
+type ToolCall struct {
+	ID        string // Provider-assigned call identifier (may equal Name for providers without IDs)
+	Name      string // Name of the tool being called
+	Arguments string // JSON-encoded arguments for the call
+}
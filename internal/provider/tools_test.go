@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestWithTools(t *testing.T) {
+	tools := []ToolDefinition{
+		{
+			Name:        "get_weather",
+			Description: "Get current weather for a location",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"location": map[string]interface{}{"type": "string"},
+				},
+				"required": []string{"location"},
+			},
+		},
+	}
+
+	opts := &ChatOptions{}
+	WithTools(tools)(opts)
+
+	if len(opts.Tools) != 1 {
+		t.Fatalf("expected 1 tool, got: %d", len(opts.Tools))
+	}
+
+	if opts.Tools[0].Name != "get_weather" {
+		t.Errorf("expected tool name 'get_weather', got: %s", opts.Tools[0].Name)
+	}
+
+	if opts.Tools[0].Parameters == nil {
+		t.Error("expected Parameters to be set, got nil")
+	}
+}
+
+func TestWithToolsEmpty(t *testing.T) {
+	opts := &ChatOptions{}
+	WithTools(nil)(opts)
+
+	if len(opts.Tools) != 0 {
+		t.Errorf("expected no tools, got: %d", len(opts.Tools))
+	}
+}
+
+func TestStreamWithTools(t *testing.T) {
+	tools := []ToolDefinition{
+		{Name: "search", Description: "Search the web"},
+	}
+
+	opts := &ChatOptions{}
+	StreamWithTools(tools)(opts)
+
+	if len(opts.Tools) != 1 {
+		t.Fatalf("expected 1 tool, got: %d", len(opts.Tools))
+	}
+
+	if !opts.Stream {
+		t.Error("expected Stream true, got false")
+	}
+}
+
+func TestResponseToolCalls(t *testing.T) {
+	resp := Response{
+		ToolCalls: []ToolCall{
+			{ID: "call_1", Name: "get_weather", Arguments: `{"location":"Berlin"}`},
+		},
+	}
+
+	if len(resp.ToolCalls) != 1 {
+		t.Fatalf("expected 1 tool call, got: %d", len(resp.ToolCalls))
+	}
+
+	if resp.ToolCalls[0].Name != "get_weather" {
+		t.Errorf("expected tool call name 'get_weather', got: %s", resp.ToolCalls[0].Name)
+	}
+
+	if resp.ToolCalls[0].Arguments != `{"location":"Berlin"}` {
+		t.Errorf("unexpected arguments: %s", resp.ToolCalls[0].Arguments)
+	}
+}
+
+func TestToolResultMessage(t *testing.T) {
+	msg := Message{
+		Role:       "tool",
+		Content:    `{"temperature": 21}`,
+		ToolCallID: "call_1",
+	}
+
+	if msg.Role != "tool" {
+		t.Errorf("expected role 'tool', got: %s", msg.Role)
+	}
+
+	if msg.ToolCallID != "call_1" {
+		t.Errorf("expected ToolCallID 'call_1', got: %s", msg.ToolCallID)
+	}
+}
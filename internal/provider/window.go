@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// charsPerToken is the rough character-to-token ratio used by
+	// CountTokens. Four characters per token is a common approximation for
+	// English text and errs on the conservative side for code.
+	charsPerToken = 4
+
+	// messageOverheadTokens accounts for the per-message framing (role
+	// markers, separators) providers add around the content
+	messageOverheadTokens = 4
+
+	// summarySnippetLength is how many characters of each trimmed message
+	// survive into the synthesized summary
+	summarySnippetLength = 80
+)
+
+// TrimStrategy selects how a context-window guard handles messages that no
+// longer fit in the model's context
+type TrimStrategy int
+
+const (
+	// TrimDropOldest silently removes the oldest non-system messages
+	TrimDropOldest TrimStrategy = iota
+
+	// TrimSummarize replaces the removed messages with a single system
+	// message containing a condensed digest of their content
+	TrimSummarize
+)
+
+// CountTokens estimates the number of tokens in a piece of text. It is a
+// heuristic, not a tokenizer - use it for budgeting decisions, not billing.
+func CountTokens(text string) int {
+	if text == "" {
+		return 0
+	}
+	tokens := len(text) / charsPerToken
+	if tokens == 0 {
+		return 1
+	}
+	return tokens
+}
+
+// MessagesTokens estimates the total token footprint of a conversation,
+// including per-message framing overhead
+func MessagesTokens(messages []Message) int {
+	total := 0
+	for _, msg := range messages {
+		total += messageTokens(msg)
+	}
+	return total
+}
+
+// messageTokens estimates the token footprint of a single message
+func messageTokens(msg Message) int {
+	return CountTokens(msg.Content) + messageOverheadTokens
+}
+
+// TrimToContextWindow drops or summarizes the oldest non-system messages
+// until the conversation fits in opts.ContextWindow, leaving room for
+// opts.MaxTokens of completion and the system prompt. System messages, the
+// final message, and the most recent opts.KeepRecentTurns turns are never
+// trimmed. It returns the resulting messages and the indices (into the
+// original slice) of the messages that were removed.
+func TrimToContextWindow(messages []Message, opts *ChatOptions) ([]Message, []int) {
+	if opts.ContextWindow <= 0 || len(messages) == 0 {
+		return messages, nil
+	}
+
+	budget := opts.ContextWindow - opts.MaxTokens - CountTokens(opts.SystemPrompt)
+	total := MessagesTokens(messages)
+	if total <= budget {
+		return messages, nil
+	}
+
+	protected := protectedIndices(messages, opts.KeepRecentTurns)
+
+	var trimmedIdx []int
+	for i := 0; i < len(messages) && total > budget; i++ {
+		if protected[i] {
+			continue
+		}
+		total -= messageTokens(messages[i])
+		trimmedIdx = append(trimmedIdx, i)
+	}
+
+	if len(trimmedIdx) == 0 {
+		return messages, nil
+	}
+
+	trimmedSet := make(map[int]bool, len(trimmedIdx))
+	for _, i := range trimmedIdx {
+		trimmedSet[i] = true
+	}
+
+	result := make([]Message, 0, len(messages)-len(trimmedIdx)+1)
+	summaryInserted := false
+	for i, msg := range messages {
+		if trimmedSet[i] {
+			if opts.TrimStrategy == TrimSummarize && !summaryInserted {
+				result = append(result, summarizeTrimmed(messages, trimmedIdx))
+				summaryInserted = true
+			}
+			continue
+		}
+		result = append(result, msg)
+	}
+
+	return result, trimmedIdx
+}
+
+// protectedIndices marks the messages that must survive trimming: system
+// messages, the final message, and the last keepRecentTurns user/assistant
+// pairs before it
+func protectedIndices(messages []Message, keepRecentTurns int) map[int]bool {
+	protected := make(map[int]bool)
+	for i, msg := range messages {
+		if msg.Role == "system" {
+			protected[i] = true
+		}
+	}
+
+	// The final message plus two messages per protected turn
+	keep := 1 + 2*keepRecentTurns
+	for i := len(messages) - 1; i >= 0 && keep > 0; i-- {
+		if !protected[i] {
+			protected[i] = true
+			keep--
+		}
+	}
+
+	return protected
+}
+
+// summarizeTrimmed condenses the trimmed messages into a single system
+// message so the model retains a trace of the dropped context
+func summarizeTrimmed(messages []Message, trimmedIdx []int) Message {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Summary of %d earlier messages trimmed to fit the context window:\n", len(trimmedIdx))
+
+	for _, i := range trimmedIdx {
+		snippet := messages[i].Content
+		if len(snippet) > summarySnippetLength {
+			snippet = snippet[:summarySnippetLength] + "..."
+		}
+		fmt.Fprintf(&b, "- %s: %s\n", messages[i].Role, snippet)
+	}
+
+	return Message{Role: "system", Content: b.String()}
+}
+
+// ContextWindowProvider wraps another Provider and trims conversations that
+// exceed the configured context window before they reach the API, instead of
+// letting the request fail. Configure it per request with WithContextWindow,
+// WithKeepRecentTurns, and WithTrimStrategy.
+type ContextWindowProvider struct {
+	inner Provider
+}
+
+// NewContextWindowProvider wraps a provider with context-window trimming
+func NewContextWindowProvider(inner Provider) *ContextWindowProvider {
+	return &ContextWindowProvider{inner: inner}
+}
+
+// Name returns the wrapped provider's name
+func (c *ContextWindowProvider) Name() string {
+	return c.inner.Name()
+}
+
+// Models returns the wrapped provider's supported models
+func (c *ContextWindowProvider) Models() []string {
+	return c.inner.Models()
+}
+
+// Close releases resources held by the wrapped provider
+func (c *ContextWindowProvider) Close() error {
+	return c.inner.Close()
+}
+
+// Chat trims the conversation to the configured context window, delegates to
+// the wrapped provider, and records which messages were trimmed in the
+// response metadata
+func (c *ContextWindowProvider) Chat(ctx context.Context, messages []Message, opts ...ChatOption) (Response, error) {
+	options := DefaultChatOptions()
+	ApplyChatOptions(options, opts...)
+
+	trimmed, trimmedIdx := TrimToContextWindow(messages, options)
+
+	resp, err := c.inner.Chat(ctx, trimmed, withResolvedOptions(options))
+	if err != nil {
+		return resp, err
+	}
+
+	if len(trimmedIdx) > 0 {
+		if resp.Metadata == nil {
+			resp.Metadata = make(map[string]string)
+		}
+		resp.Metadata["trimmed_messages"] = joinIndices(trimmedIdx)
+		resp.Metadata["trimmed_message_count"] = strconv.Itoa(len(trimmedIdx))
+	}
+
+	return resp, nil
+}
+
+// Stream trims the conversation to the configured context window before
+// delegating to the wrapped provider
+func (c *ContextWindowProvider) Stream(ctx context.Context, messages []Message, opts ...StreamOption) (<-chan Event, error) {
+	options := DefaultChatOptions()
+	ApplyStreamOptions(options, opts...)
+
+	trimmed, _ := TrimToContextWindow(messages, options)
+
+	return c.inner.Stream(ctx, trimmed, func(o *ChatOptions) {
+		*o = *options
+	})
+}
+
+// joinIndices renders message indices as a comma-separated list
+func joinIndices(indices []int) string {
+	parts := make([]string, len(indices))
+	for i, idx := range indices {
+		parts[i] = strconv.Itoa(idx)
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestCountTokens(t *testing.T) {
+	if got := CountTokens(""); got != 0 {
+		t.Errorf("expected 0 tokens for empty text, got %d", got)
+	}
+	if got := CountTokens("ab"); got != 1 {
+		t.Errorf("expected short text to count as 1 token, got %d", got)
+	}
+	if got := CountTokens(strings.Repeat("a", 400)); got != 100 {
+		t.Errorf("expected 100 tokens for 400 chars, got %d", got)
+	}
+}
+
+func TestTrimToContextWindow_Fits(t *testing.T) {
+	messages := []Message{
+		{Role: "user", Content: "hello"},
+		{Role: "assistant", Content: "hi"},
+	}
+	opts := &ChatOptions{ContextWindow: 10000, MaxTokens: 100}
+
+	trimmed, trimmedIdx := TrimToContextWindow(messages, opts)
+
+	if len(trimmedIdx) != 0 {
+		t.Errorf("expected no trimming, got indices %v", trimmedIdx)
+	}
+	if len(trimmed) != 2 {
+		t.Errorf("expected messages unchanged, got %d", len(trimmed))
+	}
+}
+
+func TestTrimToContextWindow_DropOldest(t *testing.T) {
+	long := strings.Repeat("x", 400) // ~100 tokens each
+	messages := []Message{
+		{Role: "system", Content: "be concise"},
+		{Role: "user", Content: long},
+		{Role: "assistant", Content: long},
+		{Role: "user", Content: long},
+		{Role: "assistant", Content: long},
+		{Role: "user", Content: "latest question"},
+	}
+	// Room for roughly two long messages after the completion reserve
+	opts := &ChatOptions{ContextWindow: 350, MaxTokens: 100}
+
+	trimmed, trimmedIdx := TrimToContextWindow(messages, opts)
+
+	if len(trimmedIdx) == 0 {
+		t.Fatal("expected messages to be trimmed")
+	}
+
+	// Oldest non-system messages go first
+	if trimmedIdx[0] != 1 {
+		t.Errorf("expected trimming to start at index 1, got %d", trimmedIdx[0])
+	}
+
+	// The system message and the final message always survive
+	if trimmed[0].Role != "system" {
+		t.Errorf("expected system message to survive, got role %s", trimmed[0].Role)
+	}
+	if trimmed[len(trimmed)-1].Content != "latest question" {
+		t.Errorf("expected final message to survive, got %q", trimmed[len(trimmed)-1].Content)
+	}
+}
+
+func TestTrimToContextWindow_KeepRecentTurns(t *testing.T) {
+	long := strings.Repeat("x", 400)
+	messages := []Message{
+		{Role: "user", Content: long},
+		{Role: "assistant", Content: long},
+		{Role: "user", Content: long},
+		{Role: "assistant", Content: long},
+		{Role: "user", Content: "latest"},
+	}
+	opts := &ChatOptions{ContextWindow: 100, MaxTokens: 10, KeepRecentTurns: 1}
+
+	trimmed, trimmedIdx := TrimToContextWindow(messages, opts)
+
+	// The last turn (indices 2, 3) and the final message are protected even
+	// though the conversation still exceeds the budget
+	for _, idx := range trimmedIdx {
+		if idx >= 2 {
+			t.Errorf("protected message %d was trimmed", idx)
+		}
+	}
+	if len(trimmed) != 3 {
+		t.Errorf("expected 3 surviving messages, got %d", len(trimmed))
+	}
+}
+
+func TestTrimToContextWindow_Summarize(t *testing.T) {
+	long := strings.Repeat("x", 400)
+	messages := []Message{
+		{Role: "user", Content: "first question about databases"},
+		{Role: "assistant", Content: long},
+		{Role: "user", Content: long},
+		{Role: "assistant", Content: long},
+		{Role: "user", Content: "latest"},
+	}
+	opts := &ChatOptions{
+		ContextWindow: 150,
+		MaxTokens:     10,
+		TrimStrategy:  TrimSummarize,
+	}
+
+	trimmed, trimmedIdx := TrimToContextWindow(messages, opts)
+
+	if len(trimmedIdx) == 0 {
+		t.Fatal("expected messages to be trimmed")
+	}
+
+	// A single summary message replaces the dropped run
+	if trimmed[0].Role != "system" {
+		t.Fatalf("expected leading summary message, got role %s", trimmed[0].Role)
+	}
+	if !strings.Contains(trimmed[0].Content, "first question about databases") {
+		t.Errorf("expected summary to mention dropped content, got %q", trimmed[0].Content)
+	}
+	if !strings.Contains(trimmed[0].Content, "trimmed to fit the context window") {
+		t.Errorf("unexpected summary preamble: %q", trimmed[0].Content)
+	}
+}
+
+func TestContextWindowProviderChat(t *testing.T) {
+	long := strings.Repeat("x", 400)
+	inner := &fakeProvider{response: Response{Content: "answer"}}
+	guarded := NewContextWindowProvider(inner)
+
+	messages := []Message{
+		{Role: "user", Content: long},
+		{Role: "assistant", Content: long},
+		{Role: "user", Content: "latest"},
+	}
+
+	resp, err := guarded.Chat(context.Background(), messages,
+		WithContextWindow(150),
+		WithMaxTokens(10))
+	if err != nil {
+		t.Fatalf("Chat failed: %v", err)
+	}
+
+	if len(inner.lastMessages) >= len(messages) {
+		t.Errorf("expected inner provider to receive a trimmed conversation, got %d messages", len(inner.lastMessages))
+	}
+
+	if resp.Metadata["trimmed_messages"] == "" {
+		t.Error("expected trimmed_messages in response metadata")
+	}
+	if resp.Metadata["trimmed_message_count"] == "" {
+		t.Error("expected trimmed_message_count in response metadata")
+	}
+}
+
+func TestContextWindowProviderChat_NoWindow(t *testing.T) {
+	inner := &fakeProvider{response: Response{Content: "answer"}}
+	guarded := NewContextWindowProvider(inner)
+
+	messages := []Message{{Role: "user", Content: "hello"}}
+
+	resp, err := guarded.Chat(context.Background(), messages)
+	if err != nil {
+		t.Fatalf("Chat failed: %v", err)
+	}
+
+	if len(inner.lastMessages) != 1 {
+		t.Errorf("expected conversation untouched, got %d messages", len(inner.lastMessages))
+	}
+	if _, ok := resp.Metadata["trimmed_messages"]; ok {
+		t.Error("expected no trim metadata when nothing was trimmed")
+	}
+}
+
+func TestContextWindowProviderStream(t *testing.T) {
+	long := strings.Repeat("x", 400)
+	inner := &fakeProvider{streamChunks: []string{"ok"}}
+	guarded := NewContextWindowProvider(inner)
+
+	messages := []Message{
+		{Role: "user", Content: long},
+		{Role: "assistant", Content: long},
+		{Role: "user", Content: "latest"},
+	}
+
+	eventChan, err := guarded.Stream(context.Background(), messages,
+		StreamWithContextWindow(150),
+		StreamWithMaxTokens(10))
+	if err != nil {
+		t.Fatalf("Stream failed: %v", err)
+	}
+	for range eventChan {
+	}
+
+	if len(inner.lastMessages) >= len(messages) {
+		t.Errorf("expected inner provider to receive a trimmed conversation, got %d messages", len(inner.lastMessages))
+	}
+}
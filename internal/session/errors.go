@@ -51,6 +51,13 @@ var (
 
 	// ErrInvalidDateRange is returned when date range is invalid
 	ErrInvalidDateRange = errors.New("invalid date range: date_from must be before date_to")
+
+	// ErrEmptyTag is returned when a tag is empty
+	ErrEmptyTag = errors.New("tag cannot be empty")
+
+	// ErrConcurrentModification is returned when a session was modified by
+	// another writer since it was read
+	ErrConcurrentModification = errors.New("session was modified concurrently")
 )
 
 // SessionError wraps errors with additional context
@@ -0,0 +1,112 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AINative-studio/ainative-code/internal/database"
+	"github.com/google/uuid"
+)
+
+// ForkSession creates a new session containing a copy of all messages in the
+// source session up to and including fromMessageID. Copied messages get fresh
+// IDs with parent links remapped to the copies, so the fork can diverge from
+// the original without sharing message rows. The copy happens in a single
+// transaction and the newly created session is returned.
+func (m *SQLiteManager) ForkSession(ctx context.Context, sessionID, fromMessageID string, newName string) (*Session, error) {
+	if sessionID == "" {
+		return nil, NewSessionError("ForkSession", ErrInvalidSessionID, "empty session ID")
+	}
+
+	if fromMessageID == "" {
+		return nil, NewSessionError("ForkSession", ErrInvalidMessageID, "empty message ID")
+	}
+
+	if newName == "" {
+		return nil, NewSessionError("ForkSession", ErrEmptySessionName, "")
+	}
+
+	// Get source session
+	source, err := m.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, NewSessionError("ForkSession", err, "failed to get source session")
+	}
+
+	// Get all messages in chronological order and cut at the fork point
+	messages, err := m.GetMessages(ctx, sessionID)
+	if err != nil {
+		return nil, NewSessionError("ForkSession", err, "failed to get messages")
+	}
+
+	cutoff := -1
+	for i, msg := range messages {
+		if msg.ID == fromMessageID {
+			cutoff = i
+			break
+		}
+	}
+	if cutoff == -1 {
+		return nil, NewSessionError("ForkSession", ErrMessageNotFound, fromMessageID)
+	}
+
+	// Build the forked session, inheriting model settings from the source
+	fork := &Session{
+		ID:          uuid.New().String(),
+		Name:        newName,
+		Status:      StatusActive,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+		Model:       source.Model,
+		Temperature: source.Temperature,
+		MaxTokens:   source.MaxTokens,
+		Settings:    source.Settings,
+	}
+
+	sessionParams, err := convertDomainSessionToCreateParams(fork)
+	if err != nil {
+		return nil, NewSessionError("ForkSession", err, "failed to convert session")
+	}
+
+	// Use transaction to ensure atomicity
+	err = m.db.WithTx(ctx, func(q *database.Queries) error {
+		if err := q.CreateSession(ctx, sessionParams); err != nil {
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+
+		// Copy messages with fresh IDs, remapping parent links to the copies
+		idMap := make(map[string]string, cutoff+1)
+		for _, msg := range messages[:cutoff+1] {
+			msgCopy := *msg
+			msgCopy.ID = uuid.New().String()
+			msgCopy.SessionID = fork.ID
+			idMap[msg.ID] = msgCopy.ID
+
+			if msg.ParentID != nil {
+				if newParent, exists := idMap[*msg.ParentID]; exists {
+					msgCopy.ParentID = &newParent
+				} else {
+					// Parent is outside the copied range; drop the link
+					msgCopy.ParentID = nil
+				}
+			}
+
+			msgParams, err := convertDomainMessageToCreateParams(&msgCopy)
+			if err != nil {
+				return fmt.Errorf("failed to convert message %s: %w", msg.ID, err)
+			}
+
+			if err := q.CreateMessage(ctx, msgParams); err != nil {
+				return fmt.Errorf("failed to create message %s: %w", msg.ID, err)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, NewSessionError("ForkSession", err, "transaction error")
+	}
+
+	return fork, nil
+}
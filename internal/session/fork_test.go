@@ -0,0 +1,114 @@
+package session_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AINative-studio/ainative-code/internal/session"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkSession(t *testing.T) {
+	db, mgr, cleanup := setupSearchTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	// Create a source session with a three-message thread
+	model := "claude-3-opus"
+	temperature := 0.7
+	sourceID := uuid.New().String()
+	source := &session.Session{
+		ID:          sourceID,
+		Name:        "Source Session",
+		Status:      session.StatusActive,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+		Model:       &model,
+		Temperature: &temperature,
+	}
+	require.NoError(t, mgr.CreateSession(ctx, source))
+
+	messageIDs := make([]string, 3)
+	contents := []string{"first question", "first answer", "follow-up question"}
+	roles := []session.MessageRole{session.RoleUser, session.RoleAssistant, session.RoleUser}
+	for i := range contents {
+		messageIDs[i] = uuid.New().String()
+		msg := &session.Message{
+			ID:        messageIDs[i],
+			SessionID: sourceID,
+			Role:      roles[i],
+			Content:   contents[i],
+			Timestamp: time.Now().Add(time.Duration(i) * time.Minute),
+		}
+		if i > 0 {
+			msg.ParentID = &messageIDs[i-1]
+		}
+		require.NoError(t, mgr.AddMessage(ctx, msg))
+
+		// Inserts default to CURRENT_TIMESTAMP with second resolution, so
+		// space the timestamps out to make chronological order deterministic
+		_, err := db.DB().ExecContext(ctx,
+			"UPDATE messages SET timestamp = ? WHERE id = ?",
+			time.Now().Add(time.Duration(i)*time.Minute).Format("2006-01-02 15:04:05"),
+			messageIDs[i])
+		require.NoError(t, err)
+	}
+
+	t.Run("fork at middle message", func(t *testing.T) {
+		fork, err := mgr.ForkSession(ctx, sourceID, messageIDs[1], "Forked Session")
+		require.NoError(t, err)
+		require.NotNil(t, fork)
+		assert.NotEqual(t, sourceID, fork.ID)
+		assert.Equal(t, "Forked Session", fork.Name)
+		assert.Equal(t, session.StatusActive, fork.Status)
+		require.NotNil(t, fork.Model)
+		assert.Equal(t, model, *fork.Model)
+
+		// The fork contains copies of the first two messages. Copies share a
+		// creation timestamp, so index them by content rather than order.
+		forked, err := mgr.GetMessages(ctx, fork.ID)
+		require.NoError(t, err)
+		require.Len(t, forked, 2)
+		byContent := make(map[string]*session.Message, len(forked))
+		for _, msg := range forked {
+			byContent[msg.Content] = msg
+		}
+		question := byContent["first question"]
+		answer := byContent["first answer"]
+		require.NotNil(t, question)
+		require.NotNil(t, answer)
+
+		// Copies have fresh IDs with parent links remapped to the copies
+		assert.NotEqual(t, messageIDs[0], question.ID)
+		assert.NotEqual(t, messageIDs[1], answer.ID)
+		require.NotNil(t, answer.ParentID)
+		assert.Equal(t, question.ID, *answer.ParentID)
+
+		// Source session is untouched
+		original, err := mgr.GetMessages(ctx, sourceID)
+		require.NoError(t, err)
+		assert.Len(t, original, 3)
+	})
+
+	t.Run("fork at unknown message", func(t *testing.T) {
+		_, err := mgr.ForkSession(ctx, sourceID, uuid.New().String(), "Bad Fork")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, session.ErrMessageNotFound)
+	})
+
+	t.Run("fork with empty name", func(t *testing.T) {
+		_, err := mgr.ForkSession(ctx, sourceID, messageIDs[0], "")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, session.ErrEmptySessionName)
+	})
+
+	t.Run("fork of unknown session", func(t *testing.T) {
+		_, err := mgr.ForkSession(ctx, uuid.New().String(), messageIDs[0], "Fork")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, session.ErrSessionNotFound)
+	})
+}
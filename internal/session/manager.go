@@ -16,6 +16,7 @@ type Manager interface {
 	DeleteSession(ctx context.Context, id string) error
 	ArchiveSession(ctx context.Context, id string) error
 	HardDeleteSession(ctx context.Context, id string) error
+	ForkSession(ctx context.Context, sessionID, fromMessageID string, newName string) (*Session, error)
 
 	// Message operations
 	AddMessage(ctx context.Context, message *Message) error
@@ -25,6 +26,14 @@ type Manager interface {
 	GetConversationThread(ctx context.Context, messageID string) ([]*Message, error)
 	UpdateMessage(ctx context.Context, message *Message) error
 	DeleteMessage(ctx context.Context, id string) error
+	AppendToMessage(ctx context.Context, messageID string, delta string) error
+	FinalizeMessage(ctx context.Context, messageID string, tokensUsed int64, finishReason string) error
+
+	// Tag operations
+	AddTag(ctx context.Context, sessionID, tag string) error
+	RemoveTag(ctx context.Context, sessionID, tag string) error
+	GetTags(ctx context.Context, sessionID string) ([]string, error)
+	ListSessionsByTag(ctx context.Context, tag string, opts ...ListOption) ([]*Session, error)
 
 	// Search operations
 	SearchSessions(ctx context.Context, query string, opts ...SearchOption) ([]*Session, error)
@@ -33,6 +42,7 @@ type Manager interface {
 	// Statistics operations
 	GetSessionMessageCount(ctx context.Context, sessionID string) (int64, error)
 	GetTotalTokensUsed(ctx context.Context, sessionID string) (int64, error)
+	GetTokenUsageByModel(ctx context.Context, sessionID string) (map[string]int64, error)
 
 	// Export/Import operations
 	ExportSession(ctx context.Context, sessionID string, format ExportFormat, w io.Writer) error
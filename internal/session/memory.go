@@ -0,0 +1,937 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Compile-time checks that both implementations satisfy the Manager contract
+var (
+	_ Manager = (*SQLiteManager)(nil)
+	_ Manager = (*MemoryManager)(nil)
+)
+
+// MemoryManager implements the Manager interface with in-process maps. It
+// enforces the same validation and error semantics as SQLiteManager, so TUI
+// and command tests can exercise session logic without a database file. All
+// state is lost when the manager is garbage collected.
+type MemoryManager struct {
+	mu       sync.Mutex
+	sessions map[string]*Session
+	messages map[string]*Message
+
+	// order holds each session's message IDs in insertion order, which is
+	// also chronological order since AddMessage stamps the timestamp
+	order map[string][]string
+	tags  map[string]map[string]bool
+}
+
+// NewMemoryManager creates a Manager backed entirely by in-process maps.
+func NewMemoryManager() *MemoryManager {
+	return &MemoryManager{
+		sessions: make(map[string]*Session),
+		messages: make(map[string]*Message),
+		order:    make(map[string][]string),
+		tags:     make(map[string]map[string]bool),
+	}
+}
+
+// copyMap returns a shallow copy of a settings or metadata map, normalizing
+// empty maps to nil the way the SQLite conversion helpers store them as NULL.
+func copyMap(m map[string]any) map[string]any {
+	if len(m) == 0 {
+		return nil
+	}
+	c := make(map[string]any, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+// copySession returns a copy of s so callers never share storage with the
+// manager, matching the fresh structs the SQLite round trip produces.
+func copySession(s *Session) *Session {
+	c := *s
+	if s.Model != nil {
+		v := *s.Model
+		c.Model = &v
+	}
+	if s.Temperature != nil {
+		v := *s.Temperature
+		c.Temperature = &v
+	}
+	if s.MaxTokens != nil {
+		v := *s.MaxTokens
+		c.MaxTokens = &v
+	}
+	c.Settings = copyMap(s.Settings)
+	return &c
+}
+
+// copyMessage returns a copy of msg with no storage shared with the manager.
+func copyMessage(msg *Message) *Message {
+	c := *msg
+	if msg.ParentID != nil {
+		v := *msg.ParentID
+		c.ParentID = &v
+	}
+	if msg.TokensUsed != nil {
+		v := *msg.TokensUsed
+		c.TokensUsed = &v
+	}
+	if msg.Model != nil {
+		v := *msg.Model
+		c.Model = &v
+	}
+	if msg.FinishReason != nil {
+		v := *msg.FinishReason
+		c.FinishReason = &v
+	}
+	c.Metadata = copyMap(msg.Metadata)
+	return &c
+}
+
+// getSessionLocked returns the stored session with the same not-found
+// semantics as GetSession: soft-deleted sessions are invisible. The caller
+// must hold mu.
+func (m *MemoryManager) getSessionLocked(op, id string) (*Session, error) {
+	if id == "" {
+		return nil, NewSessionError(op, ErrInvalidSessionID, "empty ID")
+	}
+
+	s, ok := m.sessions[id]
+	if !ok || s.Status == StatusDeleted {
+		return nil, NewSessionError(op, ErrSessionNotFound, id)
+	}
+
+	return s, nil
+}
+
+// sortAndPage orders sessions by updated_at descending and applies the limit
+// and offset, returning copies of the stored sessions.
+func sortAndPage(sessions []*Session, limit, offset int64) []*Session {
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].UpdatedAt.After(sessions[j].UpdatedAt)
+	})
+
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > int64(len(sessions)) {
+		offset = int64(len(sessions))
+	}
+	sessions = sessions[offset:]
+	if limit >= 0 && int64(len(sessions)) > limit {
+		sessions = sessions[:limit]
+	}
+
+	result := make([]*Session, 0, len(sessions))
+	for _, s := range sessions {
+		result = append(result, copySession(s))
+	}
+	return result
+}
+
+// CreateSession creates a new session
+func (m *MemoryManager) CreateSession(ctx context.Context, session *Session) error {
+	if session == nil {
+		return NewSessionError("CreateSession", ErrInvalidSessionID, "session is nil")
+	}
+
+	if session.Name == "" {
+		return NewSessionError("CreateSession", ErrEmptySessionName, "")
+	}
+
+	if !session.Status.IsValid() {
+		return NewSessionError("CreateSession", ErrInvalidStatus, string(session.Status))
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.sessions[session.ID]; exists {
+		return NewSessionError("CreateSession", fmt.Errorf("session %q already exists", session.ID), "database error")
+	}
+
+	// The database stamps timestamps and the initial version itself, so the
+	// stored copy gets fresh values regardless of what the caller set
+	stored := copySession(session)
+	now := time.Now()
+	stored.CreatedAt = now
+	stored.UpdatedAt = now
+	stored.Version = 1
+	m.sessions[stored.ID] = stored
+
+	return nil
+}
+
+// GetSession retrieves a session by ID
+func (m *MemoryManager) GetSession(ctx context.Context, id string) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, err := m.getSessionLocked("GetSession", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return copySession(s), nil
+}
+
+// GetSessionSummary retrieves a session with message count and total tokens
+func (m *MemoryManager) GetSessionSummary(ctx context.Context, id string) (*SessionSummary, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, err := m.getSessionLocked("GetSessionSummary", id)
+	if err != nil {
+		return nil, err
+	}
+
+	var totalTokens int64
+	for _, msgID := range m.order[id] {
+		if tokens := m.messages[msgID].TokensUsed; tokens != nil {
+			totalTokens += *tokens
+		}
+	}
+
+	return &SessionSummary{
+		Session:      *copySession(s),
+		MessageCount: int64(len(m.order[id])),
+		TotalTokens:  totalTokens,
+		Tags:         m.sortedTagsLocked(id),
+	}, nil
+}
+
+// ListSessions lists sessions with optional filters
+func (m *MemoryManager) ListSessions(ctx context.Context, opts ...ListOption) ([]*Session, error) {
+	options := ApplyListOptions(opts...)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	matched := make([]*Session, 0, len(m.sessions))
+	for _, s := range m.sessions {
+		if options.Status != "" {
+			if s.Status != options.Status {
+				continue
+			}
+		} else if s.Status == StatusDeleted {
+			// Without an explicit status filter, soft-deleted sessions stay
+			// hidden just like the SQLite queries
+			continue
+		}
+		matched = append(matched, s)
+	}
+
+	return sortAndPage(matched, options.Limit, options.Offset), nil
+}
+
+// UpdateSession updates an existing session
+func (m *MemoryManager) UpdateSession(ctx context.Context, session *Session) error {
+	if session == nil {
+		return NewSessionError("UpdateSession", ErrInvalidSessionID, "session is nil")
+	}
+
+	if session.ID == "" {
+		return NewSessionError("UpdateSession", ErrInvalidSessionID, "empty ID")
+	}
+
+	if session.Name == "" {
+		return NewSessionError("UpdateSession", ErrEmptySessionName, "")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, ok := m.sessions[session.ID]
+	if !ok || stored.Status == StatusDeleted {
+		return NewSessionError("UpdateSession", ErrSessionNotFound, session.ID)
+	}
+
+	// Optimistic concurrency: only touch the session if it still carries the
+	// version the caller read. A zero version (session not loaded through
+	// GetSession) keeps the legacy last-write-wins behavior.
+	if session.Version > 0 && session.Version != stored.Version {
+		return NewSessionError("UpdateSession", ErrConcurrentModification, session.ID)
+	}
+
+	updated := copySession(session)
+	stored.Name = updated.Name
+	stored.Model = updated.Model
+	stored.Temperature = updated.Temperature
+	stored.MaxTokens = updated.MaxTokens
+	stored.Settings = updated.Settings
+	stored.UpdatedAt = time.Now()
+	stored.Version++
+
+	session.Version++
+	return nil
+}
+
+// DeleteSession soft-deletes a session by setting status to 'deleted'
+func (m *MemoryManager) DeleteSession(ctx context.Context, id string) error {
+	if id == "" {
+		return NewSessionError("DeleteSession", ErrInvalidSessionID, "empty ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if s, ok := m.sessions[id]; ok {
+		s.Status = StatusDeleted
+		s.UpdatedAt = time.Now()
+	}
+
+	return nil
+}
+
+// ArchiveSession archives a session by setting status to 'archived'
+func (m *MemoryManager) ArchiveSession(ctx context.Context, id string) error {
+	if id == "" {
+		return NewSessionError("ArchiveSession", ErrInvalidSessionID, "empty ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if s, ok := m.sessions[id]; ok {
+		s.Status = StatusArchived
+		s.UpdatedAt = time.Now()
+	}
+
+	return nil
+}
+
+// HardDeleteSession permanently deletes a session and all its messages
+func (m *MemoryManager) HardDeleteSession(ctx context.Context, id string) error {
+	if id == "" {
+		return NewSessionError("HardDeleteSession", ErrInvalidSessionID, "empty ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, msgID := range m.order[id] {
+		delete(m.messages, msgID)
+	}
+	delete(m.order, id)
+	delete(m.tags, id)
+	delete(m.sessions, id)
+
+	return nil
+}
+
+// ForkSession creates a new session containing a copy of all messages in the
+// source session up to and including fromMessageID. Copied messages get fresh
+// IDs with parent links remapped to the copies, so the fork can diverge from
+// the original without sharing storage.
+func (m *MemoryManager) ForkSession(ctx context.Context, sessionID, fromMessageID string, newName string) (*Session, error) {
+	if sessionID == "" {
+		return nil, NewSessionError("ForkSession", ErrInvalidSessionID, "empty session ID")
+	}
+
+	if fromMessageID == "" {
+		return nil, NewSessionError("ForkSession", ErrInvalidMessageID, "empty message ID")
+	}
+
+	if newName == "" {
+		return nil, NewSessionError("ForkSession", ErrEmptySessionName, "")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	source, err := m.getSessionLocked("GetSession", sessionID)
+	if err != nil {
+		return nil, NewSessionError("ForkSession", err, "failed to get source session")
+	}
+
+	ids := m.order[sessionID]
+	cutoff := -1
+	for i, msgID := range ids {
+		if msgID == fromMessageID {
+			cutoff = i
+			break
+		}
+	}
+	if cutoff == -1 {
+		return nil, NewSessionError("ForkSession", ErrMessageNotFound, fromMessageID)
+	}
+
+	// Build the forked session, inheriting model settings from the source;
+	// copying first keeps the fork from aliasing the stored source
+	src := copySession(source)
+	now := time.Now()
+	fork := &Session{
+		ID:          uuid.New().String(),
+		Name:        newName,
+		Status:      StatusActive,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Model:       src.Model,
+		Temperature: src.Temperature,
+		MaxTokens:   src.MaxTokens,
+		Settings:    src.Settings,
+		Version:     1,
+	}
+	m.sessions[fork.ID] = copySession(fork)
+
+	// Copy messages with fresh IDs, remapping parent links to the copies
+	idMap := make(map[string]string, cutoff+1)
+	for _, msgID := range ids[:cutoff+1] {
+		msgCopy := copyMessage(m.messages[msgID])
+		msgCopy.ID = uuid.New().String()
+		msgCopy.SessionID = fork.ID
+		msgCopy.Timestamp = time.Now()
+		idMap[msgID] = msgCopy.ID
+
+		if msgCopy.ParentID != nil {
+			if newParent, exists := idMap[*msgCopy.ParentID]; exists {
+				msgCopy.ParentID = &newParent
+			} else {
+				// Parent is outside the copied range; drop the link
+				msgCopy.ParentID = nil
+			}
+		}
+
+		m.messages[msgCopy.ID] = msgCopy
+		m.order[fork.ID] = append(m.order[fork.ID], msgCopy.ID)
+	}
+
+	return fork, nil
+}
+
+// AddMessage adds a new message to a session
+func (m *MemoryManager) AddMessage(ctx context.Context, message *Message) error {
+	if message == nil {
+		return NewSessionError("AddMessage", ErrInvalidMessageID, "message is nil")
+	}
+
+	if message.Content == "" {
+		return NewSessionError("AddMessage", ErrEmptyMessageContent, "")
+	}
+
+	if !message.Role.IsValid() {
+		return NewSessionError("AddMessage", ErrInvalidRole, string(message.Role))
+	}
+
+	// Validate parent ID to prevent circular references
+	if message.ParentID != nil && *message.ParentID == message.ID {
+		return NewSessionError("AddMessage", ErrCircularReference, message.ID)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// The foreign key constraint in SQLite rejects messages for sessions that
+	// do not exist at all; soft-deleted sessions still accept messages
+	if _, ok := m.sessions[message.SessionID]; !ok {
+		return NewSessionError("AddMessage", ErrSessionNotFound, message.SessionID)
+	}
+
+	if _, exists := m.messages[message.ID]; exists {
+		return NewSessionError("AddMessage", fmt.Errorf("message %q already exists", message.ID), "database error")
+	}
+
+	stored := copyMessage(message)
+	stored.Timestamp = time.Now()
+	m.messages[stored.ID] = stored
+	m.order[stored.SessionID] = append(m.order[stored.SessionID], stored.ID)
+
+	return nil
+}
+
+// GetMessage retrieves a message by ID
+func (m *MemoryManager) GetMessage(ctx context.Context, id string) (*Message, error) {
+	if id == "" {
+		return nil, NewSessionError("GetMessage", ErrInvalidMessageID, "empty ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	msg, ok := m.messages[id]
+	if !ok {
+		return nil, NewSessionError("GetMessage", ErrMessageNotFound, id)
+	}
+
+	return copyMessage(msg), nil
+}
+
+// GetMessages retrieves all messages for a session in chronological order
+func (m *MemoryManager) GetMessages(ctx context.Context, sessionID string) ([]*Message, error) {
+	if sessionID == "" {
+		return nil, NewSessionError("GetMessages", ErrInvalidSessionID, "empty session ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ids := m.order[sessionID]
+	messages := make([]*Message, 0, len(ids))
+	for _, id := range ids {
+		messages = append(messages, copyMessage(m.messages[id]))
+	}
+
+	return messages, nil
+}
+
+// GetMessagesPaginated retrieves messages for a session with pagination,
+// returning newest-first pages like the underlying SQLite query
+func (m *MemoryManager) GetMessagesPaginated(ctx context.Context, sessionID string, limit, offset int64) ([]*Message, error) {
+	if sessionID == "" {
+		return nil, NewSessionError("GetMessagesPaginated", ErrInvalidSessionID, "empty session ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ids := m.order[sessionID]
+	if limit < 0 {
+		limit = int64(len(ids))
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	messages := make([]*Message, 0)
+	var skipped int64
+	for i := len(ids) - 1; i >= 0 && int64(len(messages)) < limit; i-- {
+		if skipped < offset {
+			skipped++
+			continue
+		}
+		messages = append(messages, copyMessage(m.messages[ids[i]]))
+	}
+
+	return messages, nil
+}
+
+// GetConversationThread retrieves all messages in a conversation thread, from
+// the root ancestor down to the given message
+func (m *MemoryManager) GetConversationThread(ctx context.Context, messageID string) ([]*Message, error) {
+	if messageID == "" {
+		return nil, NewSessionError("GetConversationThread", ErrInvalidMessageID, "empty message ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Walk the parent chain, guarding against cycles in stored data
+	chain := make([]*Message, 0)
+	seen := make(map[string]bool)
+	for id := messageID; id != "" && !seen[id]; {
+		msg, ok := m.messages[id]
+		if !ok {
+			break
+		}
+		seen[id] = true
+		chain = append(chain, copyMessage(msg))
+
+		if msg.ParentID == nil {
+			break
+		}
+		id = *msg.ParentID
+	}
+
+	// Reverse so the root of the thread comes first
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+
+	return chain, nil
+}
+
+// UpdateMessage updates an existing message
+func (m *MemoryManager) UpdateMessage(ctx context.Context, message *Message) error {
+	if message == nil {
+		return NewSessionError("UpdateMessage", ErrInvalidMessageID, "message is nil")
+	}
+
+	if message.ID == "" {
+		return NewSessionError("UpdateMessage", ErrInvalidMessageID, "empty ID")
+	}
+
+	if message.Content == "" {
+		return NewSessionError("UpdateMessage", ErrEmptyMessageContent, "")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Updating a missing message is a no-op, matching the SQLite UPDATE
+	if stored, ok := m.messages[message.ID]; ok {
+		updated := copyMessage(message)
+		stored.Content = updated.Content
+		stored.TokensUsed = updated.TokensUsed
+		stored.FinishReason = updated.FinishReason
+		stored.Metadata = updated.Metadata
+	}
+
+	return nil
+}
+
+// DeleteMessage deletes a message
+func (m *MemoryManager) DeleteMessage(ctx context.Context, id string) error {
+	if id == "" {
+		return NewSessionError("DeleteMessage", ErrInvalidMessageID, "empty ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	msg, ok := m.messages[id]
+	if !ok {
+		return nil
+	}
+
+	delete(m.messages, id)
+	ids := m.order[msg.SessionID]
+	for i, msgID := range ids {
+		if msgID == id {
+			m.order[msg.SessionID] = append(ids[:i], ids[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+// AppendToMessage appends a text delta to an existing message's content. An
+// empty delta is a no-op.
+func (m *MemoryManager) AppendToMessage(ctx context.Context, messageID string, delta string) error {
+	if messageID == "" {
+		return NewSessionError("AppendToMessage", ErrInvalidMessageID, "empty ID")
+	}
+
+	if delta == "" {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, ok := m.messages[messageID]
+	if !ok {
+		return NewSessionError("AppendToMessage", ErrMessageNotFound, messageID)
+	}
+
+	stored.Content += delta
+	return nil
+}
+
+// FinalizeMessage records the final metadata for a streamed message once the
+// stream completes. An empty finish reason is stored as nil.
+func (m *MemoryManager) FinalizeMessage(ctx context.Context, messageID string, tokensUsed int64, finishReason string) error {
+	if messageID == "" {
+		return NewSessionError("FinalizeMessage", ErrInvalidMessageID, "empty ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, ok := m.messages[messageID]
+	if !ok {
+		return NewSessionError("FinalizeMessage", ErrMessageNotFound, messageID)
+	}
+
+	stored.TokensUsed = &tokensUsed
+	stored.FinishReason = nil
+	if finishReason != "" {
+		stored.FinishReason = &finishReason
+	}
+
+	return nil
+}
+
+// AddTag attaches a tag to a session. Adding a tag the session already has is
+// a no-op, so tags stay unique per session.
+func (m *MemoryManager) AddTag(ctx context.Context, sessionID, tag string) error {
+	if sessionID == "" {
+		return NewSessionError("AddTag", ErrInvalidSessionID, "empty session ID")
+	}
+
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return NewSessionError("AddTag", ErrEmptyTag, "")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Verify the session exists so callers get ErrSessionNotFound, matching
+	// the lookup SQLiteManager performs before inserting
+	if _, err := m.getSessionLocked("GetSession", sessionID); err != nil {
+		return NewSessionError("AddTag", err, "failed to get session")
+	}
+
+	if m.tags[sessionID] == nil {
+		m.tags[sessionID] = make(map[string]bool)
+	}
+	m.tags[sessionID][tag] = true
+
+	return nil
+}
+
+// RemoveTag removes a tag from a session. Removing a tag the session does not
+// have is a no-op.
+func (m *MemoryManager) RemoveTag(ctx context.Context, sessionID, tag string) error {
+	if sessionID == "" {
+		return NewSessionError("RemoveTag", ErrInvalidSessionID, "empty session ID")
+	}
+
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return NewSessionError("RemoveTag", ErrEmptyTag, "")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.tags[sessionID], tag)
+	return nil
+}
+
+// GetTags returns a session's tags in alphabetical order
+func (m *MemoryManager) GetTags(ctx context.Context, sessionID string) ([]string, error) {
+	if sessionID == "" {
+		return nil, NewSessionError("GetTags", ErrInvalidSessionID, "empty session ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.sortedTagsLocked(sessionID), nil
+}
+
+// sortedTagsLocked returns a session's tags in alphabetical order. The caller
+// must hold mu.
+func (m *MemoryManager) sortedTagsLocked(sessionID string) []string {
+	tags := make([]string, 0, len(m.tags[sessionID]))
+	for tag := range m.tags[sessionID] {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// ListSessionsByTag lists sessions carrying the given tag, respecting the
+// status, limit and offset from the usual list options
+func (m *MemoryManager) ListSessionsByTag(ctx context.Context, tag string, opts ...ListOption) ([]*Session, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return nil, NewSessionError("ListSessionsByTag", ErrEmptyTag, "")
+	}
+
+	options := ApplyListOptions(opts...)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	matched := make([]*Session, 0)
+	for sessionID, tags := range m.tags {
+		if !tags[tag] {
+			continue
+		}
+		if s, ok := m.sessions[sessionID]; ok && s.Status == options.Status {
+			matched = append(matched, s)
+		}
+	}
+
+	return sortAndPage(matched, options.Limit, options.Offset), nil
+}
+
+// SearchSessions searches for sessions by name or ID
+func (m *MemoryManager) SearchSessions(ctx context.Context, query string, opts ...SearchOption) ([]*Session, error) {
+	options := ApplySearchOptions(opts...)
+	query = strings.ToLower(query)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	matched := make([]*Session, 0)
+	for _, s := range m.sessions {
+		if s.Status == StatusDeleted {
+			continue
+		}
+		// Case-insensitive substring match, like the LIKE patterns SQLite uses
+		if strings.Contains(strings.ToLower(s.Name), query) || strings.Contains(strings.ToLower(s.ID), query) {
+			matched = append(matched, s)
+		}
+	}
+
+	return sortAndPage(matched, options.Limit, options.Offset), nil
+}
+
+// SearchMessages searches for messages within a session by content, returning
+// newest matches first
+func (m *MemoryManager) SearchMessages(ctx context.Context, sessionID string, query string, opts ...SearchOption) ([]*Message, error) {
+	if sessionID == "" {
+		return nil, NewSessionError("SearchMessages", ErrInvalidSessionID, "empty session ID")
+	}
+
+	options := ApplySearchOptions(opts...)
+	query = strings.ToLower(query)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ids := m.order[sessionID]
+	messages := make([]*Message, 0)
+	var skipped int64
+	for i := len(ids) - 1; i >= 0 && int64(len(messages)) < options.Limit; i-- {
+		msg := m.messages[ids[i]]
+		if !strings.Contains(strings.ToLower(msg.Content), query) {
+			continue
+		}
+		if skipped < options.Offset {
+			skipped++
+			continue
+		}
+		messages = append(messages, copyMessage(msg))
+	}
+
+	return messages, nil
+}
+
+// GetSessionMessageCount returns the number of messages in a session
+func (m *MemoryManager) GetSessionMessageCount(ctx context.Context, sessionID string) (int64, error) {
+	if sessionID == "" {
+		return 0, NewSessionError("GetSessionMessageCount", ErrInvalidSessionID, "empty session ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return int64(len(m.order[sessionID])), nil
+}
+
+// GetTotalTokensUsed returns the total tokens used in a session
+func (m *MemoryManager) GetTotalTokensUsed(ctx context.Context, sessionID string) (int64, error) {
+	if sessionID == "" {
+		return 0, NewSessionError("GetTotalTokensUsed", ErrInvalidSessionID, "empty session ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var totalTokens int64
+	for _, msgID := range m.order[sessionID] {
+		if tokens := m.messages[msgID].TokensUsed; tokens != nil {
+			totalTokens += *tokens
+		}
+	}
+
+	return totalTokens, nil
+}
+
+// GetTokenUsageByModel returns the total tokens used in a session grouped by
+// model. Messages without a model are bucketed under "unknown".
+func (m *MemoryManager) GetTokenUsageByModel(ctx context.Context, sessionID string) (map[string]int64, error) {
+	if sessionID == "" {
+		return nil, NewSessionError("GetTokenUsageByModel", ErrInvalidSessionID, "empty session ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	usage := make(map[string]int64)
+	for _, msgID := range m.order[sessionID] {
+		msg := m.messages[msgID]
+		model := "unknown"
+		if msg.Model != nil {
+			model = *msg.Model
+		}
+		var tokens int64
+		if msg.TokensUsed != nil {
+			tokens = *msg.TokensUsed
+		}
+		usage[model] += tokens
+	}
+
+	return usage, nil
+}
+
+// ExportSession exports a session to the specified format
+func (m *MemoryManager) ExportSession(ctx context.Context, sessionID string, format ExportFormat, w io.Writer) error {
+	return exportSession(ctx, m, sessionID, format, w)
+}
+
+// ImportSession imports a session from JSON format
+func (m *MemoryManager) ImportSession(ctx context.Context, r io.Reader) (*Session, error) {
+	var export SessionExport
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&export); err != nil {
+		return nil, NewSessionError("ImportSession", ErrInvalidImportData, fmt.Sprintf("failed to decode JSON: %v", err))
+	}
+
+	// Validate session
+	if export.Session.ID == "" {
+		return nil, NewSessionError("ImportSession", ErrInvalidImportData, "session ID is empty")
+	}
+
+	if export.Session.Name == "" {
+		return nil, NewSessionError("ImportSession", ErrInvalidImportData, "session name is empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Check all IDs up front so a partial import never sticks, matching the
+	// transaction SQLiteManager uses
+	if _, exists := m.sessions[export.Session.ID]; exists {
+		return nil, NewSessionError("ImportSession", fmt.Errorf("failed to create session: session %q already exists", export.Session.ID), "transaction error")
+	}
+	for _, msg := range export.Messages {
+		if _, exists := m.messages[msg.ID]; exists {
+			return nil, NewSessionError("ImportSession", fmt.Errorf("failed to create message %s: message already exists", msg.ID), "transaction error")
+		}
+	}
+
+	now := time.Now()
+	stored := copySession(&export.Session)
+	stored.CreatedAt = now
+	stored.UpdatedAt = now
+	stored.Version = 1
+	m.sessions[stored.ID] = stored
+
+	for i := range export.Messages {
+		msgCopy := copyMessage(&export.Messages[i])
+		msgCopy.Timestamp = time.Now()
+		m.messages[msgCopy.ID] = msgCopy
+		m.order[msgCopy.SessionID] = append(m.order[msgCopy.SessionID], msgCopy.ID)
+	}
+
+	return &export.Session, nil
+}
+
+// TouchSession updates the session's updated_at timestamp
+func (m *MemoryManager) TouchSession(ctx context.Context, id string) error {
+	if id == "" {
+		return NewSessionError("TouchSession", ErrInvalidSessionID, "empty ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if s, ok := m.sessions[id]; ok {
+		s.UpdatedAt = time.Now()
+	}
+
+	return nil
+}
+
+// Close is a no-op; there are no resources to release
+func (m *MemoryManager) Close() error {
+	return nil
+}
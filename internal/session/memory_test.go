@@ -0,0 +1,402 @@
+package session
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// addTestMessages adds n user messages to the session and returns them in
+// insertion order, re-read through the manager so timestamps are stamped.
+func addTestMessages(t *testing.T, manager Manager, sessionID string, n int) []*Message {
+	t.Helper()
+
+	ctx := context.Background()
+	for i := 0; i < n; i++ {
+		msg := createTestMessage(t, sessionID, RoleUser, "message "+string(rune('a'+i)))
+		require.NoError(t, manager.AddMessage(ctx, msg))
+	}
+
+	messages, err := manager.GetMessages(ctx, sessionID)
+	require.NoError(t, err)
+	require.Len(t, messages, n)
+	return messages
+}
+
+func TestMemoryManager_SessionCRUD(t *testing.T) {
+	manager := NewMemoryManager()
+	ctx := context.Background()
+
+	session := createTestSession(t, "Memory Session")
+	require.NoError(t, manager.CreateSession(ctx, session))
+
+	t.Run("GetSession", func(t *testing.T) {
+		got, err := manager.GetSession(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, session.Name, got.Name)
+		assert.Equal(t, StatusActive, got.Status)
+		assert.Equal(t, int64(1), got.Version)
+	})
+
+	t.Run("UpdateSession", func(t *testing.T) {
+		got, err := manager.GetSession(ctx, session.ID)
+		require.NoError(t, err)
+
+		got.Name = "Renamed Session"
+		require.NoError(t, manager.UpdateSession(ctx, got))
+		assert.Equal(t, int64(2), got.Version)
+
+		updated, err := manager.GetSession(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed Session", updated.Name)
+	})
+
+	t.Run("ArchiveSession", func(t *testing.T) {
+		require.NoError(t, manager.ArchiveSession(ctx, session.ID))
+
+		got, err := manager.GetSession(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, StatusArchived, got.Status)
+	})
+
+	t.Run("DeleteSessionHidesSession", func(t *testing.T) {
+		require.NoError(t, manager.DeleteSession(ctx, session.ID))
+
+		_, err := manager.GetSession(ctx, session.ID)
+		assert.ErrorIs(t, err, ErrSessionNotFound)
+	})
+
+	t.Run("HardDeleteSession", func(t *testing.T) {
+		require.NoError(t, manager.HardDeleteSession(ctx, session.ID))
+
+		_, err := manager.GetSession(ctx, session.ID)
+		assert.ErrorIs(t, err, ErrSessionNotFound)
+	})
+}
+
+func TestMemoryManager_ValidationErrors(t *testing.T) {
+	manager := NewMemoryManager()
+	ctx := context.Background()
+
+	t.Run("CreateSession", func(t *testing.T) {
+		assert.ErrorIs(t, manager.CreateSession(ctx, nil), ErrInvalidSessionID)
+
+		noName := createTestSession(t, "")
+		assert.ErrorIs(t, manager.CreateSession(ctx, noName), ErrEmptySessionName)
+
+		badStatus := createTestSession(t, "Bad Status")
+		badStatus.Status = SessionStatus("bogus")
+		assert.ErrorIs(t, manager.CreateSession(ctx, badStatus), ErrInvalidStatus)
+	})
+
+	t.Run("GetSession", func(t *testing.T) {
+		_, err := manager.GetSession(ctx, "")
+		assert.ErrorIs(t, err, ErrInvalidSessionID)
+
+		_, err = manager.GetSession(ctx, "missing")
+		assert.ErrorIs(t, err, ErrSessionNotFound)
+	})
+
+	t.Run("AddMessage", func(t *testing.T) {
+		session := createTestSession(t, "Message Validation")
+		require.NoError(t, manager.CreateSession(ctx, session))
+
+		assert.ErrorIs(t, manager.AddMessage(ctx, nil), ErrInvalidMessageID)
+
+		empty := createTestMessage(t, session.ID, RoleUser, "")
+		assert.ErrorIs(t, manager.AddMessage(ctx, empty), ErrEmptyMessageContent)
+
+		badRole := createTestMessage(t, session.ID, MessageRole("robot"), "hello")
+		assert.ErrorIs(t, manager.AddMessage(ctx, badRole), ErrInvalidRole)
+
+		circular := createTestMessage(t, session.ID, RoleUser, "hello")
+		circular.ParentID = &circular.ID
+		assert.ErrorIs(t, manager.AddMessage(ctx, circular), ErrCircularReference)
+
+		orphan := createTestMessage(t, "missing-session", RoleUser, "hello")
+		assert.ErrorIs(t, manager.AddMessage(ctx, orphan), ErrSessionNotFound)
+	})
+
+	t.Run("Tags", func(t *testing.T) {
+		session := createTestSession(t, "Tag Validation")
+		require.NoError(t, manager.CreateSession(ctx, session))
+
+		assert.ErrorIs(t, manager.AddTag(ctx, session.ID, "   "), ErrEmptyTag)
+		assert.ErrorIs(t, manager.AddTag(ctx, "missing", "work"), ErrSessionNotFound)
+	})
+}
+
+func TestMemoryManager_OptimisticConcurrency(t *testing.T) {
+	manager := NewMemoryManager()
+	ctx := context.Background()
+
+	session := createTestSession(t, "Concurrent Session")
+	require.NoError(t, manager.CreateSession(ctx, session))
+
+	first, err := manager.GetSession(ctx, session.ID)
+	require.NoError(t, err)
+	second, err := manager.GetSession(ctx, session.ID)
+	require.NoError(t, err)
+
+	first.Name = "First Writer"
+	require.NoError(t, manager.UpdateSession(ctx, first))
+
+	second.Name = "Second Writer"
+	err = manager.UpdateSession(ctx, second)
+	assert.ErrorIs(t, err, ErrConcurrentModification)
+}
+
+func TestMemoryManager_Messages(t *testing.T) {
+	manager := NewMemoryManager()
+	ctx := context.Background()
+
+	session := createTestSession(t, "Message Session")
+	require.NoError(t, manager.CreateSession(ctx, session))
+	messages := addTestMessages(t, manager, session.ID, 5)
+
+	t.Run("PaginatedNewestFirst", func(t *testing.T) {
+		page, err := manager.GetMessagesPaginated(ctx, session.ID, 2, 0)
+		require.NoError(t, err)
+		require.Len(t, page, 2)
+		assert.Equal(t, messages[4].ID, page[0].ID)
+		assert.Equal(t, messages[3].ID, page[1].ID)
+
+		page, err = manager.GetMessagesPaginated(ctx, session.ID, 2, 4)
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		assert.Equal(t, messages[0].ID, page[0].ID)
+	})
+
+	t.Run("UpdateMessage", func(t *testing.T) {
+		msg := messages[0]
+		msg.Content = "edited"
+		require.NoError(t, manager.UpdateMessage(ctx, msg))
+
+		got, err := manager.GetMessage(ctx, msg.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "edited", got.Content)
+	})
+
+	t.Run("AppendAndFinalize", func(t *testing.T) {
+		msg := createTestMessage(t, session.ID, RoleAssistant, "partial")
+		msg.TokensUsed = nil
+		msg.FinishReason = nil
+		require.NoError(t, manager.AddMessage(ctx, msg))
+
+		require.NoError(t, manager.AppendToMessage(ctx, msg.ID, " output"))
+		require.NoError(t, manager.AppendToMessage(ctx, msg.ID, ""))
+		require.NoError(t, manager.FinalizeMessage(ctx, msg.ID, 42, "end_turn"))
+
+		got, err := manager.GetMessage(ctx, msg.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "partial output", got.Content)
+		require.NotNil(t, got.TokensUsed)
+		assert.Equal(t, int64(42), *got.TokensUsed)
+		require.NotNil(t, got.FinishReason)
+		assert.Equal(t, "end_turn", *got.FinishReason)
+
+		assert.ErrorIs(t, manager.AppendToMessage(ctx, "missing", "x"), ErrMessageNotFound)
+		assert.ErrorIs(t, manager.FinalizeMessage(ctx, "missing", 0, ""), ErrMessageNotFound)
+	})
+
+	t.Run("ConversationThread", func(t *testing.T) {
+		root := createTestMessage(t, session.ID, RoleUser, "root")
+		require.NoError(t, manager.AddMessage(ctx, root))
+
+		reply := createTestMessage(t, session.ID, RoleAssistant, "reply")
+		reply.ParentID = &root.ID
+		require.NoError(t, manager.AddMessage(ctx, reply))
+
+		thread, err := manager.GetConversationThread(ctx, reply.ID)
+		require.NoError(t, err)
+		require.Len(t, thread, 2)
+		assert.Equal(t, root.ID, thread[0].ID)
+		assert.Equal(t, reply.ID, thread[1].ID)
+	})
+
+	t.Run("DeleteMessage", func(t *testing.T) {
+		msg := messages[1]
+		require.NoError(t, manager.DeleteMessage(ctx, msg.ID))
+
+		_, err := manager.GetMessage(ctx, msg.ID)
+		assert.ErrorIs(t, err, ErrMessageNotFound)
+
+		count, err := manager.GetSessionMessageCount(ctx, session.ID)
+		require.NoError(t, err)
+		remaining, err := manager.GetMessages(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, count, int64(len(remaining)))
+	})
+}
+
+func TestMemoryManager_TagsAndListing(t *testing.T) {
+	manager := NewMemoryManager()
+	ctx := context.Background()
+
+	tagged := createTestSession(t, "Tagged")
+	require.NoError(t, manager.CreateSession(ctx, tagged))
+	other := createTestSession(t, "Untagged")
+	require.NoError(t, manager.CreateSession(ctx, other))
+
+	require.NoError(t, manager.AddTag(ctx, tagged.ID, "work"))
+	require.NoError(t, manager.AddTag(ctx, tagged.ID, "golang"))
+	require.NoError(t, manager.AddTag(ctx, tagged.ID, "work"))
+
+	tags, err := manager.GetTags(ctx, tagged.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"golang", "work"}, tags)
+
+	byTag, err := manager.ListSessionsByTag(ctx, "work")
+	require.NoError(t, err)
+	require.Len(t, byTag, 1)
+	assert.Equal(t, tagged.ID, byTag[0].ID)
+
+	require.NoError(t, manager.RemoveTag(ctx, tagged.ID, "work"))
+	byTag, err = manager.ListSessionsByTag(ctx, "work")
+	require.NoError(t, err)
+	assert.Empty(t, byTag)
+
+	sessions, err := manager.ListSessions(ctx)
+	require.NoError(t, err)
+	assert.Len(t, sessions, 2)
+
+	require.NoError(t, manager.ArchiveSession(ctx, other.ID))
+	sessions, err = manager.ListSessions(ctx, WithStatus(StatusArchived))
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, other.ID, sessions[0].ID)
+}
+
+func TestMemoryManager_Search(t *testing.T) {
+	manager := NewMemoryManager()
+	ctx := context.Background()
+
+	session := createTestSession(t, "Search Target")
+	require.NoError(t, manager.CreateSession(ctx, session))
+
+	msg := createTestMessage(t, session.ID, RoleUser, "the quick brown fox")
+	require.NoError(t, manager.AddMessage(ctx, msg))
+	noise := createTestMessage(t, session.ID, RoleUser, "unrelated")
+	require.NoError(t, manager.AddMessage(ctx, noise))
+
+	sessions, err := manager.SearchSessions(ctx, "search")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, session.ID, sessions[0].ID)
+
+	sessions, err = manager.SearchSessions(ctx, "no such session")
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+
+	messages, err := manager.SearchMessages(ctx, session.ID, "Quick Brown")
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, msg.ID, messages[0].ID)
+}
+
+func TestMemoryManager_Stats(t *testing.T) {
+	manager := NewMemoryManager()
+	ctx := context.Background()
+
+	session := createTestSession(t, "Stats Session")
+	require.NoError(t, manager.CreateSession(ctx, session))
+
+	opus := "claude-3-opus-20240229"
+	for i, tokens := range []int64{100, 200, 50} {
+		msg := createTestMessage(t, session.ID, RoleAssistant, "stats message")
+		msg.TokensUsed = &tokens
+		if i == 2 {
+			msg.Model = &opus
+		}
+		require.NoError(t, manager.AddMessage(ctx, msg))
+	}
+
+	unmodeled := createTestMessage(t, session.ID, RoleUser, "no model")
+	unmodeled.Model = nil
+	unmodeled.TokensUsed = nil
+	require.NoError(t, manager.AddMessage(ctx, unmodeled))
+
+	total, err := manager.GetTotalTokensUsed(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(350), total)
+
+	usage, err := manager.GetTokenUsageByModel(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(300), usage["claude-3-5-sonnet-20241022"])
+	assert.Equal(t, int64(50), usage[opus])
+	assert.Equal(t, int64(0), usage["unknown"])
+
+	summary, err := manager.GetSessionSummary(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), summary.MessageCount)
+	assert.Equal(t, int64(350), summary.TotalTokens)
+}
+
+func TestMemoryManager_Fork(t *testing.T) {
+	manager := NewMemoryManager()
+	ctx := context.Background()
+
+	session := createTestSession(t, "Fork Source")
+	require.NoError(t, manager.CreateSession(ctx, session))
+	messages := addTestMessages(t, manager, session.ID, 3)
+
+	fork, err := manager.ForkSession(ctx, session.ID, messages[1].ID, "Forked")
+	require.NoError(t, err)
+	assert.Equal(t, "Forked", fork.Name)
+	assert.Equal(t, StatusActive, fork.Status)
+	assert.Equal(t, session.Model, fork.Model)
+
+	forked, err := manager.GetMessages(ctx, fork.ID)
+	require.NoError(t, err)
+	require.Len(t, forked, 2)
+	assert.Equal(t, messages[0].Content, forked[0].Content)
+	assert.NotEqual(t, messages[0].ID, forked[0].ID)
+
+	_, err = manager.ForkSession(ctx, session.ID, uuid.New().String(), "Bad Fork")
+	assert.ErrorIs(t, err, ErrMessageNotFound)
+}
+
+func TestMemoryManager_ExportImport(t *testing.T) {
+	manager := NewMemoryManager()
+	ctx := context.Background()
+
+	session := createTestSession(t, "Export Session")
+	require.NoError(t, manager.CreateSession(ctx, session))
+	addTestMessages(t, manager, session.ID, 2)
+
+	t.Run("JSONRoundTrip", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, manager.ExportSession(ctx, session.ID, ExportFormatJSON, &buf))
+
+		target := NewMemoryManager()
+		imported, err := target.ImportSession(ctx, &buf)
+		require.NoError(t, err)
+		assert.Equal(t, session.ID, imported.ID)
+
+		messages, err := target.GetMessages(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Len(t, messages, 2)
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, manager.ExportSession(ctx, session.ID, ExportFormatCSV, &buf))
+		assert.True(t, strings.HasPrefix(buf.String(), "timestamp,role,content"))
+	})
+
+	t.Run("InvalidFormat", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := manager.ExportSession(ctx, session.ID, ExportFormat("xml"), &buf)
+		assert.ErrorIs(t, err, ErrInvalidExportFormat)
+	})
+
+	t.Run("InvalidImportData", func(t *testing.T) {
+		_, err := manager.ImportSession(ctx, strings.NewReader("not json"))
+		assert.ErrorIs(t, err, ErrInvalidImportData)
+	})
+}
@@ -72,6 +72,10 @@ type SearchOptions struct {
 	DateFrom *time.Time // Filter messages from this date
 	DateTo   *time.Time // Filter messages until this date
 	Provider string     // Filter by provider/model (e.g., "claude", "gpt")
+
+	// SessionStatus restricts results to sessions with the given status.
+	// When empty, all non-deleted sessions are searched.
+	SessionStatus SessionStatus
 }
 
 // SearchOption is a functional option for configuring SearchOptions
@@ -98,6 +102,14 @@ func WithSearchOffset(offset int64) SearchOption {
 	}
 }
 
+// WithSessionStatus restricts search results to sessions with the given
+// status, e.g. to include archived sessions explicitly
+func WithSessionStatus(status SessionStatus) SearchOption {
+	return func(opts *SearchOptions) {
+		opts.SessionStatus = status
+	}
+}
+
 // DefaultSearchOptions returns default search options
 func DefaultSearchOptions() *SearchOptions {
 	return &SearchOptions{
@@ -0,0 +1,88 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AINative-studio/ainative-code/internal/database"
+)
+
+// ArchiveSessionsOlderThan archives every active session whose last update is
+// before the cutoff. Deleted (and already archived) sessions are left alone.
+// Returns the number of sessions archived.
+func (m *SQLiteManager) ArchiveSessionsOlderThan(ctx context.Context, cutoff time.Time) (int, error) {
+	query := `
+		UPDATE sessions
+		SET status = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE status = ?
+			AND updated_at < ?
+	`
+
+	result, err := m.db.DB().ExecContext(ctx, query,
+		string(StatusArchived), string(StatusActive), formatTimestamp(cutoff.UTC()))
+	if err != nil {
+		return 0, NewSessionError("ArchiveSessionsOlderThan", err, "database error")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, NewSessionError("ArchiveSessionsOlderThan", err, "failed to count affected rows")
+	}
+
+	return int(affected), nil
+}
+
+// HardDeleteSessionsByStatus permanently deletes all sessions with the given
+// status along with their messages, in a single transaction. Returns the
+// number of sessions deleted.
+func (m *SQLiteManager) HardDeleteSessionsByStatus(ctx context.Context, status SessionStatus) (int, error) {
+	if !status.IsValid() {
+		return 0, NewSessionError("HardDeleteSessionsByStatus", ErrInvalidStatus, string(status))
+	}
+
+	rows, err := m.db.DB().QueryContext(ctx, `SELECT id FROM sessions WHERE status = ?`, string(status))
+	if err != nil {
+		return 0, NewSessionError("HardDeleteSessionsByStatus", err, "database error")
+	}
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, NewSessionError("HardDeleteSessionsByStatus", err, "failed to scan session ID")
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return 0, NewSessionError("HardDeleteSessionsByStatus", err, "failed to iterate sessions")
+	}
+	rows.Close()
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	// Use transaction to ensure atomicity
+	err = m.db.WithTx(ctx, func(q *database.Queries) error {
+		for _, id := range ids {
+			// Delete all messages first (due to foreign key constraint)
+			if err := q.DeleteMessagesBySession(ctx, id); err != nil {
+				return fmt.Errorf("failed to delete messages for session %s: %w", id, err)
+			}
+
+			if err := q.HardDeleteSession(ctx, id); err != nil {
+				return fmt.Errorf("failed to delete session %s: %w", id, err)
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return 0, NewSessionError("HardDeleteSessionsByStatus", err, "transaction error")
+	}
+
+	return len(ids), nil
+}
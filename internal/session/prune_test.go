@@ -0,0 +1,112 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveSessionsOlderThan(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	manager := NewSQLiteManager(db)
+	ctx := context.Background()
+
+	oldSession := createTestSession(t, "Old Session")
+	require.NoError(t, manager.CreateSession(ctx, oldSession))
+	freshSession := createTestSession(t, "Fresh Session")
+	require.NoError(t, manager.CreateSession(ctx, freshSession))
+	deletedSession := createTestSession(t, "Deleted Session")
+	require.NoError(t, manager.CreateSession(ctx, deletedSession))
+	require.NoError(t, manager.DeleteSession(ctx, deletedSession.ID))
+
+	// Age the old and deleted sessions past the cutoff. The schema's
+	// update_sessions_timestamp trigger would reset updated_at, so drop it
+	// while backdating.
+	_, err := db.DB().ExecContext(ctx, "DROP TRIGGER update_sessions_timestamp")
+	require.NoError(t, err)
+	staleTimestamp := formatTimestamp(time.Now().UTC().Add(-48 * time.Hour))
+	for _, id := range []string{oldSession.ID, deletedSession.ID} {
+		_, err := db.DB().ExecContext(ctx,
+			"UPDATE sessions SET updated_at = ? WHERE id = ?", staleTimestamp, id)
+		require.NoError(t, err)
+	}
+
+	archived, err := manager.ArchiveSessionsOlderThan(ctx, time.Now().UTC().Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, archived)
+
+	// The stale active session was archived
+	retrieved, err := manager.GetSession(ctx, oldSession.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusArchived, retrieved.Status)
+
+	// The fresh session is untouched
+	retrieved, err = manager.GetSession(ctx, freshSession.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusActive, retrieved.Status)
+
+	// Soft-deleted sessions keep their deleted status (GetSession hides
+	// deleted rows, so check the column directly)
+	var status string
+	err = db.DB().QueryRowContext(ctx,
+		"SELECT status FROM sessions WHERE id = ?", deletedSession.ID).Scan(&status)
+	require.NoError(t, err)
+	assert.Equal(t, string(StatusDeleted), status)
+}
+
+func TestHardDeleteSessionsByStatus(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	manager := NewSQLiteManager(db)
+	ctx := context.Background()
+
+	active := createTestSession(t, "Active Session")
+	require.NoError(t, manager.CreateSession(ctx, active))
+
+	deleted1 := createTestSession(t, "Deleted Session 1")
+	require.NoError(t, manager.CreateSession(ctx, deleted1))
+	require.NoError(t, manager.AddMessage(ctx, createTestMessage(t, deleted1.ID, RoleUser, "hello")))
+	require.NoError(t, manager.DeleteSession(ctx, deleted1.ID))
+
+	deleted2 := createTestSession(t, "Deleted Session 2")
+	require.NoError(t, manager.CreateSession(ctx, deleted2))
+	require.NoError(t, manager.DeleteSession(ctx, deleted2.ID))
+
+	t.Run("DeletesAllWithStatus", func(t *testing.T) {
+		count, err := manager.HardDeleteSessionsByStatus(ctx, StatusDeleted)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		_, err = manager.GetSession(ctx, deleted1.ID)
+		assert.ErrorIs(t, err, ErrSessionNotFound)
+		_, err = manager.GetSession(ctx, deleted2.ID)
+		assert.ErrorIs(t, err, ErrSessionNotFound)
+
+		// Messages are gone too
+		messages, err := manager.GetMessages(ctx, deleted1.ID)
+		require.NoError(t, err)
+		assert.Empty(t, messages)
+
+		// Other sessions survive
+		_, err = manager.GetSession(ctx, active.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("NoMatches", func(t *testing.T) {
+		count, err := manager.HardDeleteSessionsByStatus(ctx, StatusDeleted)
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("InvalidStatus", func(t *testing.T) {
+		_, err := manager.HardDeleteSessionsByStatus(ctx, SessionStatus("bogus"))
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidStatus)
+	})
+}
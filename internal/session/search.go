@@ -78,12 +78,15 @@ func (m *SQLiteManager) searchBasic(ctx context.Context, opts *SearchOptions) ([
 		JOIN messages m ON fts.message_id = m.id
 		JOIN sessions s ON m.session_id = s.id
 		WHERE messages_fts MATCH ?
+			AND s.status != 'deleted'
+			AND (? = '' OR s.status = ?)
 		ORDER BY relevance_score
 		LIMIT ? OFFSET ?
 	`
 
 	sanitized := sanitizeFTS5Query(opts.Query)
-	rows, err := m.db.DB().QueryContext(ctx, query, sanitized, opts.Limit, opts.Offset)
+	status := string(opts.SessionStatus)
+	rows, err := m.db.DB().QueryContext(ctx, query, sanitized, status, status, opts.Limit, opts.Offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to execute search query: %w", err)
 	}
@@ -95,7 +98,7 @@ func (m *SQLiteManager) searchBasic(ctx context.Context, opts *SearchOptions) ([
 	}
 
 	// Get total count
-	totalCount, err := m.searchCount(ctx, sanitized)
+	totalCount, err := m.searchCount(ctx, sanitized, status)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -118,6 +121,8 @@ func (m *SQLiteManager) searchWithDateRange(ctx context.Context, opts *SearchOpt
 		WHERE messages_fts MATCH ?
 			AND m.timestamp >= ?
 			AND m.timestamp <= ?
+			AND s.status != 'deleted'
+			AND (? = '' OR s.status = ?)
 		ORDER BY relevance_score
 		LIMIT ? OFFSET ?
 	`
@@ -126,7 +131,8 @@ func (m *SQLiteManager) searchWithDateRange(ctx context.Context, opts *SearchOpt
 	dateTo := formatTimestamp(*opts.DateTo)
 
 	sanitized := sanitizeFTS5Query(opts.Query)
-	rows, err := m.db.DB().QueryContext(ctx, query, sanitized, dateFrom, dateTo, opts.Limit, opts.Offset)
+	status := string(opts.SessionStatus)
+	rows, err := m.db.DB().QueryContext(ctx, query, sanitized, dateFrom, dateTo, status, status, opts.Limit, opts.Offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to execute search query with date range: %w", err)
 	}
@@ -138,7 +144,7 @@ func (m *SQLiteManager) searchWithDateRange(ctx context.Context, opts *SearchOpt
 	}
 
 	// Get total count
-	totalCount, err := m.searchCountWithDateRange(ctx, sanitized, dateFrom, dateTo)
+	totalCount, err := m.searchCountWithDateRange(ctx, sanitized, dateFrom, dateTo, status)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -160,6 +166,8 @@ func (m *SQLiteManager) searchWithProvider(ctx context.Context, opts *SearchOpti
 		JOIN sessions s ON m.session_id = s.id
 		WHERE messages_fts MATCH ?
 			AND m.model LIKE ?
+			AND s.status != 'deleted'
+			AND (? = '' OR s.status = ?)
 		ORDER BY relevance_score
 		LIMIT ? OFFSET ?
 	`
@@ -167,7 +175,8 @@ func (m *SQLiteManager) searchWithProvider(ctx context.Context, opts *SearchOpti
 	providerPattern := "%" + opts.Provider + "%"
 
 	sanitized := sanitizeFTS5Query(opts.Query)
-	rows, err := m.db.DB().QueryContext(ctx, query, sanitized, providerPattern, opts.Limit, opts.Offset)
+	status := string(opts.SessionStatus)
+	rows, err := m.db.DB().QueryContext(ctx, query, sanitized, providerPattern, status, status, opts.Limit, opts.Offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to execute search query with provider: %w", err)
 	}
@@ -179,7 +188,7 @@ func (m *SQLiteManager) searchWithProvider(ctx context.Context, opts *SearchOpti
 	}
 
 	// Get total count
-	totalCount, err := m.searchCountWithProvider(ctx, sanitized, providerPattern)
+	totalCount, err := m.searchCountWithProvider(ctx, sanitized, providerPattern, status)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -203,6 +212,8 @@ func (m *SQLiteManager) searchWithAllFilters(ctx context.Context, opts *SearchOp
 			AND m.timestamp >= ?
 			AND m.timestamp <= ?
 			AND m.model LIKE ?
+			AND s.status != 'deleted'
+			AND (? = '' OR s.status = ?)
 		ORDER BY relevance_score
 		LIMIT ? OFFSET ?
 	`
@@ -212,7 +223,8 @@ func (m *SQLiteManager) searchWithAllFilters(ctx context.Context, opts *SearchOp
 	providerPattern := "%" + opts.Provider + "%"
 
 	sanitized := sanitizeFTS5Query(opts.Query)
-	rows, err := m.db.DB().QueryContext(ctx, query, sanitized, dateFrom, dateTo, providerPattern, opts.Limit, opts.Offset)
+	status := string(opts.SessionStatus)
+	rows, err := m.db.DB().QueryContext(ctx, query, sanitized, dateFrom, dateTo, providerPattern, status, status, opts.Limit, opts.Offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to execute search query with all filters: %w", err)
 	}
@@ -224,7 +236,7 @@ func (m *SQLiteManager) searchWithAllFilters(ctx context.Context, opts *SearchOp
 	}
 
 	// Get total count
-	totalCount, err := m.searchCountWithAllFilters(ctx, sanitized, dateFrom, dateTo, providerPattern)
+	totalCount, err := m.searchCountWithAllFilters(ctx, sanitized, dateFrom, dateTo, providerPattern, status)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -301,16 +313,19 @@ func (m *SQLiteManager) scanSearchResults(rows *sql.Rows) ([]SearchResult, error
 }
 
 // searchCount gets the total count of search results
-func (m *SQLiteManager) searchCount(ctx context.Context, query string) (int64, error) {
+func (m *SQLiteManager) searchCount(ctx context.Context, query, status string) (int64, error) {
 	countQuery := `
 		SELECT COUNT(*)
 		FROM messages_fts fts
 		JOIN messages m ON fts.message_id = m.id
+		JOIN sessions s ON m.session_id = s.id
 		WHERE messages_fts MATCH ?
+			AND s.status != 'deleted'
+			AND (? = '' OR s.status = ?)
 	`
 
 	var count int64
-	err := m.db.DB().QueryRowContext(ctx, countQuery, query).Scan(&count)
+	err := m.db.DB().QueryRowContext(ctx, countQuery, query, status, status).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get search count: %w", err)
 	}
@@ -319,18 +334,21 @@ func (m *SQLiteManager) searchCount(ctx context.Context, query string) (int64, e
 }
 
 // searchCountWithDateRange gets the count with date range filter
-func (m *SQLiteManager) searchCountWithDateRange(ctx context.Context, query, dateFrom, dateTo string) (int64, error) {
+func (m *SQLiteManager) searchCountWithDateRange(ctx context.Context, query, dateFrom, dateTo, status string) (int64, error) {
 	countQuery := `
 		SELECT COUNT(*)
 		FROM messages_fts fts
 		JOIN messages m ON fts.message_id = m.id
+		JOIN sessions s ON m.session_id = s.id
 		WHERE messages_fts MATCH ?
 			AND m.timestamp >= ?
 			AND m.timestamp <= ?
+			AND s.status != 'deleted'
+			AND (? = '' OR s.status = ?)
 	`
 
 	var count int64
-	err := m.db.DB().QueryRowContext(ctx, countQuery, query, dateFrom, dateTo).Scan(&count)
+	err := m.db.DB().QueryRowContext(ctx, countQuery, query, dateFrom, dateTo, status, status).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get search count with date range: %w", err)
 	}
@@ -339,17 +357,20 @@ func (m *SQLiteManager) searchCountWithDateRange(ctx context.Context, query, dat
 }
 
 // searchCountWithProvider gets the count with provider filter
-func (m *SQLiteManager) searchCountWithProvider(ctx context.Context, query, providerPattern string) (int64, error) {
+func (m *SQLiteManager) searchCountWithProvider(ctx context.Context, query, providerPattern, status string) (int64, error) {
 	countQuery := `
 		SELECT COUNT(*)
 		FROM messages_fts fts
 		JOIN messages m ON fts.message_id = m.id
+		JOIN sessions s ON m.session_id = s.id
 		WHERE messages_fts MATCH ?
 			AND m.model LIKE ?
+			AND s.status != 'deleted'
+			AND (? = '' OR s.status = ?)
 	`
 
 	var count int64
-	err := m.db.DB().QueryRowContext(ctx, countQuery, query, providerPattern).Scan(&count)
+	err := m.db.DB().QueryRowContext(ctx, countQuery, query, providerPattern, status, status).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get search count with provider: %w", err)
 	}
@@ -358,19 +379,22 @@ func (m *SQLiteManager) searchCountWithProvider(ctx context.Context, query, prov
 }
 
 // searchCountWithAllFilters gets the count with all filters
-func (m *SQLiteManager) searchCountWithAllFilters(ctx context.Context, query, dateFrom, dateTo, providerPattern string) (int64, error) {
+func (m *SQLiteManager) searchCountWithAllFilters(ctx context.Context, query, dateFrom, dateTo, providerPattern, status string) (int64, error) {
 	countQuery := `
 		SELECT COUNT(*)
 		FROM messages_fts fts
 		JOIN messages m ON fts.message_id = m.id
+		JOIN sessions s ON m.session_id = s.id
 		WHERE messages_fts MATCH ?
 			AND m.timestamp >= ?
 			AND m.timestamp <= ?
 			AND m.model LIKE ?
+			AND s.status != 'deleted'
+			AND (? = '' OR s.status = ?)
 	`
 
 	var count int64
-	err := m.db.DB().QueryRowContext(ctx, countQuery, query, dateFrom, dateTo, providerPattern).Scan(&count)
+	err := m.db.DB().QueryRowContext(ctx, countQuery, query, dateFrom, dateTo, providerPattern, status, status).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get search count with all filters: %w", err)
 	}
@@ -506,6 +506,79 @@ func TestSearchMessages_EdgeCases(t *testing.T) {
 	})
 }
 
+func TestSearchMessages_SessionStatusFilter(t *testing.T) {
+	_, mgr, cleanup := setupSearchTestDB(t)
+	defer cleanup()
+
+	activeID := createTestSessionWithMessages(t, mgr, "Active Session", []struct {
+		role    session.MessageRole
+		content string
+		model   string
+	}{
+		{session.RoleUser, "Tell me about kubernetes deployments", ""},
+	})
+
+	archivedID := createTestSessionWithMessages(t, mgr, "Archived Session", []struct {
+		role    session.MessageRole
+		content string
+		model   string
+	}{
+		{session.RoleUser, "More kubernetes questions here", ""},
+	})
+
+	deletedID := createTestSessionWithMessages(t, mgr, "Deleted Session", []struct {
+		role    session.MessageRole
+		content string
+		model   string
+	}{
+		{session.RoleUser, "Old kubernetes notes", ""},
+	})
+
+	ctx := context.Background()
+	require.NoError(t, mgr.ArchiveSession(ctx, archivedID))
+	require.NoError(t, mgr.DeleteSession(ctx, deletedID))
+
+	t.Run("default search excludes deleted sessions", func(t *testing.T) {
+		opts := session.DefaultSearchOptionsWithQuery("kubernetes")
+		results, err := mgr.SearchAllMessages(ctx, opts)
+
+		require.NoError(t, err)
+		require.NotNil(t, results)
+		assert.Len(t, results.Results, 2, "active and archived sessions should match")
+		assert.Equal(t, int64(2), results.TotalCount)
+		for _, result := range results.Results {
+			assert.NotEqual(t, deletedID, result.Message.SessionID, "deleted sessions should be excluded")
+		}
+	})
+
+	t.Run("filter to archived sessions only", func(t *testing.T) {
+		opts := session.ApplySearchOptions(
+			session.WithQuery("kubernetes"),
+			session.WithSessionStatus(session.StatusArchived),
+		)
+		results, err := mgr.SearchAllMessages(ctx, opts)
+
+		require.NoError(t, err)
+		require.NotNil(t, results)
+		require.Len(t, results.Results, 1)
+		assert.Equal(t, archivedID, results.Results[0].Message.SessionID)
+		assert.Equal(t, int64(1), results.TotalCount)
+	})
+
+	t.Run("filter to active sessions only", func(t *testing.T) {
+		opts := session.ApplySearchOptions(
+			session.WithQuery("kubernetes"),
+			session.WithSessionStatus(session.StatusActive),
+		)
+		results, err := mgr.SearchAllMessages(ctx, opts)
+
+		require.NoError(t, err)
+		require.NotNil(t, results)
+		require.Len(t, results.Results, 1)
+		assert.Equal(t, activeID, results.Results[0].Message.SessionID)
+	})
+}
+
 // Helper function to check if a string contains a substring (case-insensitive)
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr ||
@@ -3,6 +3,7 @@ package session
 import (
 	"context"
 	"database/sql"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -241,6 +242,14 @@ func (m *SQLiteManager) GetSession(ctx context.Context, id string) (*Session, er
 		return nil, NewSessionError("GetSession", err, "conversion error")
 	}
 
+	// Load the version column for optimistic concurrency control; it lives
+	// outside the generated queries
+	err = m.db.DB().QueryRowContext(ctx,
+		`SELECT version FROM sessions WHERE id = ?`, id).Scan(&session.Version)
+	if err != nil {
+		return nil, NewSessionError("GetSession", err, "database error")
+	}
+
 	return session, nil
 }
 
@@ -296,6 +305,11 @@ func (m *SQLiteManager) GetSessionSummary(ctx context.Context, id string) (*Sess
 		}
 	}
 
+	tags, err := m.GetTags(ctx, id)
+	if err != nil {
+		return nil, NewSessionError("GetSessionSummary", err, "failed to get tags")
+	}
+
 	return &SessionSummary{
 		Session: Session{
 			ID:          row.ID,
@@ -310,6 +324,7 @@ func (m *SQLiteManager) GetSessionSummary(ctx context.Context, id string) (*Sess
 		},
 		MessageCount: row.MessageCount,
 		TotalTokens:  totalTokens,
+		Tags:         tags,
 	}, nil
 }
 
@@ -372,10 +387,52 @@ func (m *SQLiteManager) UpdateSession(ctx context.Context, session *Session) err
 		return NewSessionError("UpdateSession", err, "failed to convert session")
 	}
 
-	if err := m.db.UpdateSession(ctx, params); err != nil {
+	// Optimistic concurrency: only touch the row if it still carries the
+	// version the caller read. A zero version (session not loaded through
+	// GetSession) keeps the legacy last-write-wins behavior.
+	query := `
+		UPDATE sessions
+		SET name = ?,
+		    model = ?,
+		    temperature = ?,
+		    max_tokens = ?,
+		    settings = ?,
+		    version = version + 1
+		WHERE id = ? AND status != 'deleted'
+	`
+	args := []interface{}{params.Name, params.Model, params.Temperature, params.MaxTokens, params.Settings, params.ID}
+	if session.Version > 0 {
+		query += ` AND version = ?`
+		args = append(args, session.Version)
+	}
+
+	result, err := m.db.DB().ExecContext(ctx, query, args...)
+	if err != nil {
 		return NewSessionError("UpdateSession", err, "database error")
 	}
 
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return NewSessionError("UpdateSession", err, "failed to count affected rows")
+	}
+
+	if affected == 0 {
+		// Distinguish a stale version from a missing session
+		if session.Version > 0 {
+			var count int64
+			err := m.db.DB().QueryRowContext(ctx,
+				`SELECT COUNT(*) FROM sessions WHERE id = ? AND status != 'deleted'`, session.ID).Scan(&count)
+			if err != nil {
+				return NewSessionError("UpdateSession", err, "database error")
+			}
+			if count > 0 {
+				return NewSessionError("UpdateSession", ErrConcurrentModification, session.ID)
+			}
+		}
+		return NewSessionError("UpdateSession", ErrSessionNotFound, session.ID)
+	}
+
+	session.Version++
 	return nil
 }
 
@@ -728,8 +785,86 @@ func (m *SQLiteManager) GetTotalTokensUsed(ctx context.Context, sessionID string
 	return totalTokens, nil
 }
 
+// GetTokenUsageByModel returns the total tokens used in a session grouped by
+// model. Messages without a model are bucketed under "unknown".
+func (m *SQLiteManager) GetTokenUsageByModel(ctx context.Context, sessionID string) (map[string]int64, error) {
+	if sessionID == "" {
+		return nil, NewSessionError("GetTokenUsageByModel", ErrInvalidSessionID, "empty session ID")
+	}
+
+	query := `
+		SELECT COALESCE(model, 'unknown'), COALESCE(SUM(tokens_used), 0)
+		FROM messages
+		WHERE session_id = ?
+		GROUP BY COALESCE(model, 'unknown')
+	`
+
+	rows, err := m.db.DB().QueryContext(ctx, query, sessionID)
+	if err != nil {
+		return nil, NewSessionError("GetTokenUsageByModel", err, "database error")
+	}
+	defer rows.Close()
+
+	usage := make(map[string]int64)
+	for rows.Next() {
+		var model string
+		var tokens int64
+		if err := rows.Scan(&model, &tokens); err != nil {
+			return nil, NewSessionError("GetTokenUsageByModel", err, "failed to scan usage row")
+		}
+		usage[model] = tokens
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, NewSessionError("GetTokenUsageByModel", err, "failed to iterate usage rows")
+	}
+
+	return usage, nil
+}
+
+// exportPageSize is the number of messages fetched per page when streaming exports
+const exportPageSize = 500
+
+// forEachMessage streams a session's messages in chronological order, fetching
+// exportPageSize messages at a time so exports never hold the full history in
+// memory. GetMessagesPaginated returns newest-first pages, so iteration walks
+// the offsets backwards and reverses each page.
+func forEachMessage(ctx context.Context, m Manager, sessionID string, fn func(*Message) error) error {
+	total, err := m.GetSessionMessageCount(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	for offset := total; offset > 0; {
+		limit := int64(exportPageSize)
+		if offset < limit {
+			limit = offset
+		}
+		offset -= limit
+
+		page, err := m.GetMessagesPaginated(ctx, sessionID, limit, offset)
+		if err != nil {
+			return err
+		}
+
+		for i := len(page) - 1; i >= 0; i-- {
+			if err := fn(page[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // ExportSession exports a session to the specified format
 func (m *SQLiteManager) ExportSession(ctx context.Context, sessionID string, format ExportFormat, w io.Writer) error {
+	return exportSession(ctx, m, sessionID, format, w)
+}
+
+// exportSession implements ExportSession on top of the Manager interface so
+// every Manager implementation shares the same formats and streaming behavior.
+func exportSession(ctx context.Context, m Manager, sessionID string, format ExportFormat, w io.Writer) error {
 	if sessionID == "" {
 		return NewSessionError("ExportSession", ErrInvalidSessionID, "empty session ID")
 	}
@@ -744,29 +879,45 @@ func (m *SQLiteManager) ExportSession(ctx context.Context, sessionID string, for
 		return NewSessionError("ExportSession", err, "failed to get session")
 	}
 
-	// Get all messages
-	messages, err := m.GetMessages(ctx, sessionID)
-	if err != nil {
-		return NewSessionError("ExportSession", err, "failed to get messages")
-	}
-
-	// Export based on format
+	// Export based on format, paging through messages so very long sessions
+	// never need the full history in memory
 	switch format {
 	case ExportFormatJSON:
-		export := SessionExport{
-			Session:  *session,
-			Messages: make([]Message, len(messages)),
-		}
-		for i, msg := range messages {
-			export.Messages[i] = *msg
+		// Stream the SessionExport shape by hand so the messages array can be
+		// written element-by-element; the indentation matches what a
+		// json.Encoder with two-space indent would produce
+		sessionJSON, err := json.MarshalIndent(session, "  ", "  ")
+		if err != nil {
+			return NewSessionError("ExportSession", err, "failed to encode JSON")
 		}
+		fmt.Fprintf(w, "{\n  \"session\": %s,\n  \"messages\": [", sessionJSON)
+
+		first := true
+		err = forEachMessage(ctx, m, sessionID, func(msg *Message) error {
+			msgJSON, err := json.MarshalIndent(msg, "    ", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to encode message %s: %w", msg.ID, err)
+			}
 
-		encoder := json.NewEncoder(w)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(export); err != nil {
+			separator := ",\n    "
+			if first {
+				separator = "\n    "
+				first = false
+			}
+
+			_, err = fmt.Fprintf(w, "%s%s", separator, msgJSON)
+			return err
+		})
+		if err != nil {
 			return NewSessionError("ExportSession", err, "failed to encode JSON")
 		}
 
+		if first {
+			fmt.Fprintf(w, "]\n}\n")
+		} else {
+			fmt.Fprintf(w, "\n  ]\n}\n")
+		}
+
 	case ExportFormatMarkdown:
 		// Write session header
 		fmt.Fprintf(w, "# %s\n\n", session.Name)
@@ -782,7 +933,7 @@ func (m *SQLiteManager) ExportSession(ctx context.Context, sessionID string, for
 		fmt.Fprintf(w, "\n---\n\n")
 
 		// Write messages
-		for _, msg := range messages {
+		err = forEachMessage(ctx, m, sessionID, func(msg *Message) error {
 			fmt.Fprintf(w, "**%s**: ", msg.Role)
 			fmt.Fprintf(w, "%s\n\n", msg.Content)
 			fmt.Fprintf(w, "*%s*\n\n", msg.Timestamp.Format(time.RFC3339))
@@ -792,6 +943,10 @@ func (m *SQLiteManager) ExportSession(ctx context.Context, sessionID string, for
 			}
 
 			fmt.Fprintf(w, "---\n\n")
+			return nil
+		})
+		if err != nil {
+			return NewSessionError("ExportSession", err, "failed to get messages")
 		}
 
 	case ExportFormatText:
@@ -805,7 +960,7 @@ func (m *SQLiteManager) ExportSession(ctx context.Context, sessionID string, for
 		fmt.Fprintf(w, "========================================\n\n")
 
 		// Write messages
-		for _, msg := range messages {
+		err = forEachMessage(ctx, m, sessionID, func(msg *Message) error {
 			fmt.Fprintf(w, "[%s]: ", msg.Role)
 			fmt.Fprintf(w, "%s\n\n", msg.Content)
 
@@ -814,6 +969,46 @@ func (m *SQLiteManager) ExportSession(ctx context.Context, sessionID string, for
 			}
 
 			fmt.Fprintf(w, "----------------------------------------\n\n")
+			return nil
+		})
+		if err != nil {
+			return NewSessionError("ExportSession", err, "failed to get messages")
+		}
+
+	case ExportFormatCSV:
+		// encoding/csv handles RFC 4180 quoting of embedded commas, quotes
+		// and newlines
+		csvWriter := csv.NewWriter(w)
+		if err := csvWriter.Write([]string{"timestamp", "role", "content", "tokens_used", "model"}); err != nil {
+			return NewSessionError("ExportSession", err, "failed to write CSV header")
+		}
+
+		err = forEachMessage(ctx, m, sessionID, func(msg *Message) error {
+			tokensUsed := ""
+			if msg.TokensUsed != nil {
+				tokensUsed = fmt.Sprintf("%d", *msg.TokensUsed)
+			}
+
+			model := ""
+			if msg.Model != nil {
+				model = *msg.Model
+			}
+
+			return csvWriter.Write([]string{
+				msg.Timestamp.Format(time.RFC3339),
+				string(msg.Role),
+				msg.Content,
+				tokensUsed,
+				model,
+			})
+		})
+		if err != nil {
+			return NewSessionError("ExportSession", err, "failed to write CSV")
+		}
+
+		csvWriter.Flush()
+		if err := csvWriter.Error(); err != nil {
+			return NewSessionError("ExportSession", err, "failed to write CSV")
 		}
 
 	default:
@@ -2,6 +2,9 @@ package session
 
 import (
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -818,6 +821,63 @@ func TestGetTotalTokensUsed(t *testing.T) {
 	})
 }
 
+func TestGetTokenUsageByModel(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	manager := NewSQLiteManager(db)
+	ctx := context.Background()
+
+	session := createTestSession(t, "Token Usage Test")
+	err := manager.CreateSession(ctx, session)
+	require.NoError(t, err)
+
+	addWithModel := func(content string, model *string, tokens int64) {
+		msg := createTestMessage(t, session.ID, RoleAssistant, content)
+		msg.Model = model
+		msg.TokensUsed = &tokens
+		require.NoError(t, manager.AddMessage(ctx, msg))
+	}
+
+	claude := "claude-3-opus"
+	gpt := "gpt-4"
+	addWithModel("first", &claude, 100)
+	addWithModel("second", &claude, 150)
+	addWithModel("third", &gpt, 200)
+
+	// A message without a model buckets under "unknown"
+	noModel := createTestMessage(t, session.ID, RoleUser, "question")
+	noModel.Model = nil
+	tokens := int64(50)
+	noModel.TokensUsed = &tokens
+	require.NoError(t, manager.AddMessage(ctx, noModel))
+
+	t.Run("Success", func(t *testing.T) {
+		usage, err := manager.GetTokenUsageByModel(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{
+			"claude-3-opus": 250,
+			"gpt-4":         200,
+			"unknown":       50,
+		}, usage)
+	})
+
+	t.Run("NoMessages", func(t *testing.T) {
+		emptySession := createTestSession(t, "Empty")
+		require.NoError(t, manager.CreateSession(ctx, emptySession))
+
+		usage, err := manager.GetTokenUsageByModel(ctx, emptySession.ID)
+		require.NoError(t, err)
+		assert.Empty(t, usage)
+	})
+
+	t.Run("EmptySessionID", func(t *testing.T) {
+		_, err := manager.GetTokenUsageByModel(ctx, "")
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidSessionID)
+	})
+}
+
 func TestExportSession(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
@@ -880,6 +940,120 @@ func TestExportSession(t *testing.T) {
 	})
 }
 
+func TestExportSessionCSV(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	manager := NewSQLiteManager(db)
+	ctx := context.Background()
+
+	session := createTestSession(t, "CSV Export")
+	err := manager.CreateSession(ctx, session)
+	require.NoError(t, err)
+
+	msg := createTestMessage(t, session.ID, RoleUser, `He said "hello, world"
+and left`)
+	tokens := int64(42)
+	msg.TokensUsed = &tokens
+	err = manager.AddMessage(ctx, msg)
+	require.NoError(t, err)
+
+	var buf strings.Builder
+	err = manager.ExportSession(ctx, session.ID, ExportFormatCSV, &buf)
+	require.NoError(t, err)
+
+	output := buf.String()
+	assert.True(t, strings.HasPrefix(output, "timestamp,role,content,tokens_used,model\n"),
+		"CSV output should start with the header row")
+
+	// Round-trip through a CSV reader to verify RFC 4180 escaping
+	records, err := csv.NewReader(strings.NewReader(output)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, []string{"timestamp", "role", "content", "tokens_used", "model"}, records[0])
+	assert.Equal(t, "user", records[1][1])
+	assert.Equal(t, "He said \"hello, world\"\nand left", records[1][2])
+	assert.Equal(t, "42", records[1][3])
+}
+
+func TestExportSessionStreaming(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	manager := NewSQLiteManager(db)
+	ctx := context.Background()
+
+	t.Run("FormatMatchesEncoder", func(t *testing.T) {
+		session := createTestSession(t, "Format Test")
+		err := manager.CreateSession(ctx, session)
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			msg := createTestMessage(t, session.ID, RoleUser, fmt.Sprintf("message %d", i))
+			require.NoError(t, manager.AddMessage(ctx, msg))
+		}
+
+		// Space the timestamps out so chronological order is deterministic
+		_, err = db.DB().ExecContext(ctx,
+			"UPDATE messages SET timestamp = datetime('2024-01-01', '+' || rowid || ' seconds') WHERE session_id = ?",
+			session.ID)
+		require.NoError(t, err)
+
+		var buf strings.Builder
+		err = manager.ExportSession(ctx, session.ID, ExportFormatJSON, &buf)
+		require.NoError(t, err)
+
+		// The streamed output must be byte-identical to encoding the full
+		// SessionExport struct in one shot
+		stored, err := manager.GetSession(ctx, session.ID)
+		require.NoError(t, err)
+		messages, err := manager.GetMessages(ctx, session.ID)
+		require.NoError(t, err)
+
+		export := SessionExport{
+			Session:  *stored,
+			Messages: make([]Message, len(messages)),
+		}
+		for i, msg := range messages {
+			export.Messages[i] = *msg
+		}
+
+		var want strings.Builder
+		encoder := json.NewEncoder(&want)
+		encoder.SetIndent("", "  ")
+		require.NoError(t, encoder.Encode(export))
+
+		assert.Equal(t, want.String(), buf.String())
+	})
+
+	t.Run("ThousandsOfMessages", func(t *testing.T) {
+		session := createTestSession(t, "Large Export")
+		err := manager.CreateSession(ctx, session)
+		require.NoError(t, err)
+
+		const messageCount = 2500 // several pages worth
+		for i := 0; i < messageCount; i++ {
+			msg := createTestMessage(t, session.ID, RoleUser, fmt.Sprintf("message %d", i))
+			require.NoError(t, manager.AddMessage(ctx, msg))
+		}
+
+		_, err = db.DB().ExecContext(ctx,
+			"UPDATE messages SET timestamp = datetime('2024-01-01', '+' || rowid || ' seconds') WHERE session_id = ?",
+			session.ID)
+		require.NoError(t, err)
+
+		var buf strings.Builder
+		err = manager.ExportSession(ctx, session.ID, ExportFormatJSON, &buf)
+		require.NoError(t, err)
+
+		var exported SessionExport
+		require.NoError(t, json.Unmarshal([]byte(buf.String()), &exported))
+		require.Len(t, exported.Messages, messageCount)
+		assert.Equal(t, "message 0", exported.Messages[0].Content)
+		assert.Equal(t, fmt.Sprintf("message %d", messageCount-1), exported.Messages[messageCount-1].Content)
+	})
+}
+
 func TestImportSession(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
@@ -0,0 +1,64 @@
+package session
+
+import (
+	"context"
+)
+
+// AppendToMessage appends a text delta to an existing message's content. The
+// append happens inside the database (content || delta), so partial streamed
+// output can be flushed periodically without rewriting the full row. An empty
+// delta is a no-op.
+func (m *SQLiteManager) AppendToMessage(ctx context.Context, messageID string, delta string) error {
+	if messageID == "" {
+		return NewSessionError("AppendToMessage", ErrInvalidMessageID, "empty ID")
+	}
+
+	if delta == "" {
+		return nil
+	}
+
+	query := `UPDATE messages SET content = content || ? WHERE id = ?`
+	result, err := m.db.DB().ExecContext(ctx, query, delta, messageID)
+	if err != nil {
+		return NewSessionError("AppendToMessage", err, "database error")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return NewSessionError("AppendToMessage", err, "failed to count affected rows")
+	}
+	if affected == 0 {
+		return NewSessionError("AppendToMessage", ErrMessageNotFound, messageID)
+	}
+
+	return nil
+}
+
+// FinalizeMessage records the final metadata for a streamed message once the
+// stream completes. An empty finish reason is stored as NULL.
+func (m *SQLiteManager) FinalizeMessage(ctx context.Context, messageID string, tokensUsed int64, finishReason string) error {
+	if messageID == "" {
+		return NewSessionError("FinalizeMessage", ErrInvalidMessageID, "empty ID")
+	}
+
+	var reason *string
+	if finishReason != "" {
+		reason = &finishReason
+	}
+
+	query := `UPDATE messages SET tokens_used = ?, finish_reason = ? WHERE id = ?`
+	result, err := m.db.DB().ExecContext(ctx, query, tokensUsed, reason, messageID)
+	if err != nil {
+		return NewSessionError("FinalizeMessage", err, "database error")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return NewSessionError("FinalizeMessage", err, "failed to count affected rows")
+	}
+	if affected == 0 {
+		return NewSessionError("FinalizeMessage", ErrMessageNotFound, messageID)
+	}
+
+	return nil
+}
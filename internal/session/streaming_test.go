@@ -0,0 +1,90 @@
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendToMessage(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	manager := NewSQLiteManager(db)
+	ctx := context.Background()
+
+	session := createTestSession(t, "Streaming Session")
+	require.NoError(t, manager.CreateSession(ctx, session))
+
+	message := createTestMessage(t, session.ID, RoleAssistant, "Once")
+	require.NoError(t, manager.AddMessage(ctx, message))
+
+	t.Run("AppendsIncrementally", func(t *testing.T) {
+		require.NoError(t, manager.AppendToMessage(ctx, message.ID, " upon"))
+		require.NoError(t, manager.AppendToMessage(ctx, message.ID, " a time"))
+
+		got, err := manager.GetMessage(ctx, message.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Once upon a time", got.Content)
+	})
+
+	t.Run("EmptyDeltaIsNoOp", func(t *testing.T) {
+		require.NoError(t, manager.AppendToMessage(ctx, message.ID, ""))
+
+		got, err := manager.GetMessage(ctx, message.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Once upon a time", got.Content)
+	})
+
+	t.Run("UnknownMessage", func(t *testing.T) {
+		err := manager.AppendToMessage(ctx, "nonexistent-id", "delta")
+		assert.ErrorIs(t, err, ErrMessageNotFound)
+	})
+
+	t.Run("EmptyID", func(t *testing.T) {
+		err := manager.AppendToMessage(ctx, "", "delta")
+		assert.ErrorIs(t, err, ErrInvalidMessageID)
+	})
+}
+
+func TestFinalizeMessage(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	manager := NewSQLiteManager(db)
+	ctx := context.Background()
+
+	session := createTestSession(t, "Streaming Session")
+	require.NoError(t, manager.CreateSession(ctx, session))
+
+	message := createTestMessage(t, session.ID, RoleAssistant, "partial")
+	message.TokensUsed = nil
+	message.FinishReason = nil
+	require.NoError(t, manager.AddMessage(ctx, message))
+
+	t.Run("SetsFinalMetadata", func(t *testing.T) {
+		require.NoError(t, manager.FinalizeMessage(ctx, message.ID, 512, "end_turn"))
+
+		got, err := manager.GetMessage(ctx, message.ID)
+		require.NoError(t, err)
+		require.NotNil(t, got.TokensUsed)
+		assert.Equal(t, int64(512), *got.TokensUsed)
+		require.NotNil(t, got.FinishReason)
+		assert.Equal(t, "end_turn", *got.FinishReason)
+	})
+
+	t.Run("EmptyFinishReasonStoredAsNull", func(t *testing.T) {
+		require.NoError(t, manager.FinalizeMessage(ctx, message.ID, 512, ""))
+
+		got, err := manager.GetMessage(ctx, message.ID)
+		require.NoError(t, err)
+		assert.Nil(t, got.FinishReason)
+	})
+
+	t.Run("UnknownMessage", func(t *testing.T) {
+		err := manager.FinalizeMessage(ctx, "nonexistent-id", 1, "end_turn")
+		assert.ErrorIs(t, err, ErrMessageNotFound)
+	})
+}
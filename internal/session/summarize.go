@@ -0,0 +1,188 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/AINative-studio/ainative-code/internal/provider"
+)
+
+const (
+	// summarizeThreshold is how many messages beyond the ones already
+	// covered must accumulate before an existing summary is refreshed
+	summarizeThreshold = 10
+
+	// maxSummaryInputLength caps how much conversation text is sent to the
+	// provider per summarization call
+	maxSummaryInputLength = 8000
+)
+
+// summarySystemPrompt instructs the provider to produce a compact digest
+const summarySystemPrompt = "Summarize the following conversation concisely, preserving key facts, " +
+	"decisions, and open questions. Respond with the summary only, in at most one short paragraph."
+
+// incrementalSummaryPrompt is used when a summary already exists and only the
+// newer messages need to be folded in
+const incrementalSummaryPrompt = "Below is a summary of a conversation so far, followed by newer messages. " +
+	"Produce an updated summary that folds the new messages into the existing one, preserving key facts, " +
+	"decisions, and open questions. Respond with the summary only, in at most one short paragraph."
+
+// SummarizeSession generates a concise summary of the session's conversation
+// and persists it on the session. The first call summarizes the whole
+// history; later calls are incremental, feeding the provider the stored
+// summary plus only the messages that arrived since, and are skipped
+// entirely until at least summarizeThreshold new messages accumulate.
+func (m *SQLiteManager) SummarizeSession(ctx context.Context, sessionID string, p provider.Provider) error {
+	if sessionID == "" {
+		return NewSessionError("SummarizeSession", ErrInvalidSessionID, "empty session ID")
+	}
+
+	session, err := m.GetSession(ctx, sessionID)
+	if err != nil {
+		return NewSessionError("SummarizeSession", err, "failed to get session")
+	}
+
+	summary, covered, err := m.getStoredSummary(ctx, sessionID)
+	if err != nil {
+		return NewSessionError("SummarizeSession", err, "failed to read stored summary")
+	}
+
+	messages, err := m.GetMessages(ctx, sessionID)
+	if err != nil {
+		return NewSessionError("SummarizeSession", err, "failed to get messages")
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+
+	// A covered count beyond the message count means messages were deleted
+	// since the last summary; start over from scratch
+	if covered > len(messages) {
+		summary = ""
+		covered = 0
+	}
+
+	newMessages := messages[covered:]
+	if len(newMessages) == 0 {
+		return nil
+	}
+	if summary != "" && len(newMessages) < summarizeThreshold {
+		// Not enough new material to be worth a provider round-trip
+		return nil
+	}
+
+	// Build the prompt: incremental when a summary exists, full otherwise
+	var prompt strings.Builder
+	systemPrompt := summarySystemPrompt
+	if summary != "" {
+		systemPrompt = incrementalSummaryPrompt
+		prompt.WriteString("Summary so far:\n")
+		prompt.WriteString(summary)
+		prompt.WriteString("\n\nNew messages:\n")
+	}
+	prompt.WriteString(formatTranscript(newMessages, maxSummaryInputLength-prompt.Len()))
+
+	opts := []provider.ChatOption{
+		provider.WithSystemPrompt(systemPrompt),
+		provider.WithMaxTokens(256),
+		provider.WithTemperature(0.3),
+	}
+	if session.Model != nil && *session.Model != "" {
+		opts = append(opts, provider.WithModel(*session.Model))
+	}
+
+	resp, err := p.Chat(ctx, []provider.Message{
+		{Role: "user", Content: prompt.String()},
+	}, opts...)
+	if err != nil {
+		return NewSessionError("SummarizeSession", err, "provider error")
+	}
+
+	updated := strings.TrimSpace(resp.Content)
+	if updated == "" {
+		return NewSessionError("SummarizeSession", ErrEmptyMessageContent, "provider returned an empty summary")
+	}
+
+	query := `UPDATE sessions SET summary = ?, summary_message_count = ? WHERE id = ?`
+	if _, err := m.db.DB().ExecContext(ctx, query, updated, len(messages), sessionID); err != nil {
+		return NewSessionError("SummarizeSession", err, "database error")
+	}
+
+	return nil
+}
+
+// GetContextWithSummary returns provider-ready context for the session: the
+// stored summary (when present) as a system message, followed by the last
+// recentN messages. Sessions without a summary just get the recent messages,
+// so callers can use this unconditionally.
+func (m *SQLiteManager) GetContextWithSummary(ctx context.Context, sessionID string, recentN int) ([]provider.Message, error) {
+	if sessionID == "" {
+		return nil, NewSessionError("GetContextWithSummary", ErrInvalidSessionID, "empty session ID")
+	}
+	if recentN < 0 {
+		recentN = 0
+	}
+
+	summary, _, err := m.getStoredSummary(ctx, sessionID)
+	if err != nil {
+		return nil, NewSessionError("GetContextWithSummary", err, "failed to read stored summary")
+	}
+
+	messages, err := m.GetMessages(ctx, sessionID)
+	if err != nil {
+		return nil, NewSessionError("GetContextWithSummary", err, "failed to get messages")
+	}
+
+	recent := messages
+	if len(recent) > recentN {
+		recent = recent[len(recent)-recentN:]
+	}
+
+	context := make([]provider.Message, 0, len(recent)+1)
+	if summary != "" {
+		context = append(context, provider.Message{
+			Role:    "system",
+			Content: "Summary of the conversation so far: " + summary,
+		})
+	}
+	for _, msg := range recent {
+		context = append(context, provider.Message{
+			Role:    string(msg.Role),
+			Content: msg.Content,
+		})
+	}
+
+	return context, nil
+}
+
+// getStoredSummary reads the rolling summary and the number of messages it
+// covers. The columns live outside the generated sqlc model, so they are
+// read with a direct query like the session version column.
+func (m *SQLiteManager) getStoredSummary(ctx context.Context, sessionID string) (string, int, error) {
+	var summary *string
+	var covered int
+	query := `SELECT summary, summary_message_count FROM sessions WHERE id = ?`
+	if err := m.db.DB().QueryRowContext(ctx, query, sessionID).Scan(&summary, &covered); err != nil {
+		return "", 0, err
+	}
+	if summary == nil {
+		return "", covered, nil
+	}
+	return *summary, covered, nil
+}
+
+// formatTranscript renders messages as "role: content" lines, truncating
+// from the front so the most recent exchanges survive the length cap
+func formatTranscript(messages []*Message, maxLen int) string {
+	var b strings.Builder
+	for _, msg := range messages {
+		fmt.Fprintf(&b, "%s: %s\n", msg.Role, msg.Content)
+	}
+
+	transcript := b.String()
+	if maxLen > 0 && len(transcript) > maxLen {
+		transcript = transcript[len(transcript)-maxLen:]
+	}
+	return transcript
+}
@@ -0,0 +1,162 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/AINative-studio/ainative-code/internal/provider"
+	"github.com/AINative-studio/ainative-code/internal/provider/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeSession(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	manager := NewSQLiteManager(db)
+	ctx := context.Background()
+
+	addMessages := func(t *testing.T, sessionID string, count int) {
+		t.Helper()
+		for i := 0; i < count; i++ {
+			role := RoleUser
+			if i%2 == 1 {
+				role = RoleAssistant
+			}
+			msg := createTestMessage(t, sessionID, role, fmt.Sprintf("message %d", i))
+			require.NoError(t, manager.AddMessage(ctx, msg))
+		}
+	}
+
+	t.Run("SummarizesAndStores", func(t *testing.T) {
+		session := createTestSession(t, "Summarize Test")
+		require.NoError(t, manager.CreateSession(ctx, session))
+		addMessages(t, session.ID, 4)
+
+		p := mock.NewMockProvider()
+		p.EnqueueResponse(provider.Response{Content: "Talked about four things.\n"})
+
+		require.NoError(t, manager.SummarizeSession(ctx, session.ID, p))
+
+		summary, covered, err := manager.getStoredSummary(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Talked about four things.", summary)
+		assert.Equal(t, 4, covered)
+	})
+
+	t.Run("SkipsBelowThreshold", func(t *testing.T) {
+		session := createTestSession(t, "Threshold Test")
+		require.NoError(t, manager.CreateSession(ctx, session))
+		addMessages(t, session.ID, 4)
+
+		p := mock.NewMockProvider()
+		p.EnqueueResponse(provider.Response{Content: "Initial summary."})
+		require.NoError(t, manager.SummarizeSession(ctx, session.ID, p))
+
+		// A few more messages are not enough to re-summarize
+		addMessages(t, session.ID, summarizeThreshold-1)
+		require.NoError(t, manager.SummarizeSession(ctx, session.ID, p))
+		assert.Len(t, p.Calls(), 1, "re-summarization should wait for the threshold")
+
+		summary, covered, err := manager.getStoredSummary(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Initial summary.", summary)
+		assert.Equal(t, 4, covered)
+	})
+
+	t.Run("ResummarizesIncrementally", func(t *testing.T) {
+		session := createTestSession(t, "Incremental Test")
+		require.NoError(t, manager.CreateSession(ctx, session))
+		addMessages(t, session.ID, 4)
+
+		p := mock.NewMockProvider()
+		p.EnqueueResponse(provider.Response{Content: "Initial summary."})
+		require.NoError(t, manager.SummarizeSession(ctx, session.ID, p))
+
+		addMessages(t, session.ID, summarizeThreshold)
+		p.EnqueueResponse(provider.Response{Content: "Updated summary."})
+		require.NoError(t, manager.SummarizeSession(ctx, session.ID, p))
+
+		calls := p.Calls()
+		require.Len(t, calls, 2)
+
+		// The second call must carry the prior summary and only the new
+		// messages, not the full history
+		prompt := calls[1].Messages[0].Content
+		assert.Contains(t, prompt, "Initial summary.")
+		assert.Contains(t, prompt, fmt.Sprintf("message %d", 4))
+		assert.NotContains(t, prompt, "message 0\n")
+
+		summary, covered, err := manager.getStoredSummary(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Updated summary.", summary)
+		assert.Equal(t, 4+summarizeThreshold, covered)
+	})
+
+	t.Run("NoOpForEmptySession", func(t *testing.T) {
+		session := createTestSession(t, "Empty Test")
+		require.NoError(t, manager.CreateSession(ctx, session))
+
+		p := mock.NewMockProvider()
+		require.NoError(t, manager.SummarizeSession(ctx, session.ID, p))
+		assert.Empty(t, p.Calls())
+	})
+
+	t.Run("EmptyID", func(t *testing.T) {
+		p := mock.NewMockProvider()
+		err := manager.SummarizeSession(ctx, "", p)
+		assert.ErrorIs(t, err, ErrInvalidSessionID)
+	})
+}
+
+func TestGetContextWithSummary(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	manager := NewSQLiteManager(db)
+	ctx := context.Background()
+
+	session := createTestSession(t, "Context Test")
+	require.NoError(t, manager.CreateSession(ctx, session))
+	for i := 0; i < 6; i++ {
+		msg := createTestMessage(t, session.ID, RoleUser, fmt.Sprintf("message %d", i))
+		require.NoError(t, manager.AddMessage(ctx, msg))
+	}
+
+	t.Run("WithoutSummary", func(t *testing.T) {
+		context, err := manager.GetContextWithSummary(ctx, session.ID, 2)
+		require.NoError(t, err)
+		require.Len(t, context, 2)
+		assert.Equal(t, "message 4", context[0].Content)
+		assert.Equal(t, "message 5", context[1].Content)
+	})
+
+	t.Run("WithSummary", func(t *testing.T) {
+		p := mock.NewMockProvider()
+		p.EnqueueResponse(provider.Response{Content: "Six messages so far."})
+		require.NoError(t, manager.SummarizeSession(ctx, session.ID, p))
+
+		context, err := manager.GetContextWithSummary(ctx, session.ID, 2)
+		require.NoError(t, err)
+		require.Len(t, context, 3)
+
+		assert.Equal(t, "system", context[0].Role)
+		assert.True(t, strings.Contains(context[0].Content, "Six messages so far."))
+		assert.Equal(t, "message 4", context[1].Content)
+		assert.Equal(t, "message 5", context[2].Content)
+	})
+
+	t.Run("RecentNLargerThanHistory", func(t *testing.T) {
+		context, err := manager.GetContextWithSummary(ctx, session.ID, 100)
+		require.NoError(t, err)
+		assert.Len(t, context, 7) // summary + all 6 messages
+	})
+
+	t.Run("EmptyID", func(t *testing.T) {
+		_, err := manager.GetContextWithSummary(ctx, "", 5)
+		assert.ErrorIs(t, err, ErrInvalidSessionID)
+	})
+}
@@ -0,0 +1,140 @@
+package session
+
+import (
+	"context"
+	"strings"
+
+	"github.com/AINative-studio/ainative-code/internal/database"
+)
+
+// AddTag attaches a tag to a session. Adding a tag the session already has is
+// a no-op, so tags stay unique per session.
+func (m *SQLiteManager) AddTag(ctx context.Context, sessionID, tag string) error {
+	if sessionID == "" {
+		return NewSessionError("AddTag", ErrInvalidSessionID, "empty session ID")
+	}
+
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return NewSessionError("AddTag", ErrEmptyTag, "")
+	}
+
+	// Verify the session exists so callers get ErrSessionNotFound instead of
+	// a foreign key violation
+	if _, err := m.GetSession(ctx, sessionID); err != nil {
+		return NewSessionError("AddTag", err, "failed to get session")
+	}
+
+	query := `INSERT OR IGNORE INTO session_tags (session_id, tag) VALUES (?, ?)`
+	if _, err := m.db.DB().ExecContext(ctx, query, sessionID, tag); err != nil {
+		return NewSessionError("AddTag", err, "database error")
+	}
+
+	return nil
+}
+
+// RemoveTag removes a tag from a session. Removing a tag the session does not
+// have is a no-op.
+func (m *SQLiteManager) RemoveTag(ctx context.Context, sessionID, tag string) error {
+	if sessionID == "" {
+		return NewSessionError("RemoveTag", ErrInvalidSessionID, "empty session ID")
+	}
+
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return NewSessionError("RemoveTag", ErrEmptyTag, "")
+	}
+
+	query := `DELETE FROM session_tags WHERE session_id = ? AND tag = ?`
+	if _, err := m.db.DB().ExecContext(ctx, query, sessionID, tag); err != nil {
+		return NewSessionError("RemoveTag", err, "database error")
+	}
+
+	return nil
+}
+
+// GetTags returns a session's tags in alphabetical order
+func (m *SQLiteManager) GetTags(ctx context.Context, sessionID string) ([]string, error) {
+	if sessionID == "" {
+		return nil, NewSessionError("GetTags", ErrInvalidSessionID, "empty session ID")
+	}
+
+	query := `SELECT tag FROM session_tags WHERE session_id = ? ORDER BY tag ASC`
+	rows, err := m.db.DB().QueryContext(ctx, query, sessionID)
+	if err != nil {
+		return nil, NewSessionError("GetTags", err, "database error")
+	}
+	defer rows.Close()
+
+	tags := make([]string, 0)
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, NewSessionError("GetTags", err, "failed to scan tag")
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, NewSessionError("GetTags", err, "failed to iterate tags")
+	}
+
+	return tags, nil
+}
+
+// ListSessionsByTag lists sessions carrying the given tag, respecting the
+// status, limit and offset from the usual list options
+func (m *SQLiteManager) ListSessionsByTag(ctx context.Context, tag string, opts ...ListOption) ([]*Session, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return nil, NewSessionError("ListSessionsByTag", ErrEmptyTag, "")
+	}
+
+	options := ApplyListOptions(opts...)
+
+	query := `
+		SELECT s.id, s.name, s.created_at, s.updated_at, s.status, s.model, s.temperature, s.max_tokens, s.settings
+		FROM sessions s
+		JOIN session_tags t ON t.session_id = s.id
+		WHERE t.tag = ?
+			AND s.status = ?
+		ORDER BY s.updated_at DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := m.db.DB().QueryContext(ctx, query, tag, string(options.Status), options.Limit, options.Offset)
+	if err != nil {
+		return nil, NewSessionError("ListSessionsByTag", err, "database error")
+	}
+	defer rows.Close()
+
+	sessions := make([]*Session, 0)
+	for rows.Next() {
+		var dbSession database.Session
+		if err := rows.Scan(
+			&dbSession.ID,
+			&dbSession.Name,
+			&dbSession.CreatedAt,
+			&dbSession.UpdatedAt,
+			&dbSession.Status,
+			&dbSession.Model,
+			&dbSession.Temperature,
+			&dbSession.MaxTokens,
+			&dbSession.Settings,
+		); err != nil {
+			return nil, NewSessionError("ListSessionsByTag", err, "failed to scan session")
+		}
+
+		session, err := convertDBSessionToDomain(dbSession)
+		if err != nil {
+			return nil, NewSessionError("ListSessionsByTag", err, "conversion error")
+		}
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, NewSessionError("ListSessionsByTag", err, "failed to iterate sessions")
+	}
+
+	return sessions, nil
+}
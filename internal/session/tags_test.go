@@ -0,0 +1,137 @@
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionTags(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	manager := NewSQLiteManager(db)
+	ctx := context.Background()
+
+	session := createTestSession(t, "Tagged Session")
+	err := manager.CreateSession(ctx, session)
+	require.NoError(t, err)
+
+	t.Run("AddAndGetTags", func(t *testing.T) {
+		require.NoError(t, manager.AddTag(ctx, session.ID, "work"))
+		require.NoError(t, manager.AddTag(ctx, session.ID, "golang"))
+
+		tags, err := manager.GetTags(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"golang", "work"}, tags)
+	})
+
+	t.Run("DuplicateTagIsNoOp", func(t *testing.T) {
+		require.NoError(t, manager.AddTag(ctx, session.ID, "work"))
+
+		tags, err := manager.GetTags(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"golang", "work"}, tags)
+	})
+
+	t.Run("RemoveTag", func(t *testing.T) {
+		require.NoError(t, manager.RemoveTag(ctx, session.ID, "golang"))
+
+		tags, err := manager.GetTags(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"work"}, tags)
+
+		// Removing an absent tag is a no-op
+		require.NoError(t, manager.RemoveTag(ctx, session.ID, "golang"))
+	})
+
+	t.Run("EmptyTag", func(t *testing.T) {
+		err := manager.AddTag(ctx, session.ID, "  ")
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrEmptyTag)
+
+		err = manager.RemoveTag(ctx, session.ID, "")
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrEmptyTag)
+	})
+
+	t.Run("UnknownSession", func(t *testing.T) {
+		err := manager.AddTag(ctx, "nonexistent-id", "work")
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrSessionNotFound)
+	})
+
+	t.Run("SummaryIncludesTags", func(t *testing.T) {
+		summary, err := manager.GetSessionSummary(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"work"}, summary.Tags)
+	})
+}
+
+func TestListSessionsByTag(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	manager := NewSQLiteManager(db)
+	ctx := context.Background()
+
+	session1 := createTestSession(t, "Work Session 1")
+	require.NoError(t, manager.CreateSession(ctx, session1))
+	session2 := createTestSession(t, "Work Session 2")
+	require.NoError(t, manager.CreateSession(ctx, session2))
+	session3 := createTestSession(t, "Personal Session")
+	require.NoError(t, manager.CreateSession(ctx, session3))
+
+	require.NoError(t, manager.AddTag(ctx, session1.ID, "work"))
+	require.NoError(t, manager.AddTag(ctx, session2.ID, "work"))
+	require.NoError(t, manager.AddTag(ctx, session3.ID, "personal"))
+
+	t.Run("ByTag", func(t *testing.T) {
+		sessions, err := manager.ListSessionsByTag(ctx, "work")
+		require.NoError(t, err)
+		require.Len(t, sessions, 2)
+		for _, s := range sessions {
+			assert.Contains(t, []string{session1.ID, session2.ID}, s.ID)
+		}
+	})
+
+	t.Run("WithLimit", func(t *testing.T) {
+		sessions, err := manager.ListSessionsByTag(ctx, "work", WithLimit(1))
+		require.NoError(t, err)
+		assert.Len(t, sessions, 1)
+	})
+
+	t.Run("WithOffset", func(t *testing.T) {
+		sessions, err := manager.ListSessionsByTag(ctx, "work", WithOffset(1))
+		require.NoError(t, err)
+		assert.Len(t, sessions, 1)
+	})
+
+	t.Run("RespectsStatus", func(t *testing.T) {
+		require.NoError(t, manager.ArchiveSession(ctx, session2.ID))
+
+		sessions, err := manager.ListSessionsByTag(ctx, "work")
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, session1.ID, sessions[0].ID)
+
+		archived, err := manager.ListSessionsByTag(ctx, "work", WithStatus(StatusArchived))
+		require.NoError(t, err)
+		require.Len(t, archived, 1)
+		assert.Equal(t, session2.ID, archived[0].ID)
+	})
+
+	t.Run("NoMatches", func(t *testing.T) {
+		sessions, err := manager.ListSessionsByTag(ctx, "unknown-tag")
+		require.NoError(t, err)
+		assert.Empty(t, sessions)
+	})
+
+	t.Run("EmptyTag", func(t *testing.T) {
+		_, err := manager.ListSessionsByTag(ctx, "")
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrEmptyTag)
+	})
+}
@@ -0,0 +1,113 @@
+package session
+
+import (
+	"context"
+	"strings"
+
+	"github.com/AINative-studio/ainative-code/internal/provider"
+)
+
+const (
+	// DefaultSessionName is the placeholder name given to sessions created
+	// without an explicit title
+	DefaultSessionName = "New Session"
+
+	// maxTitleInputLength caps how much of the first message is sent to the
+	// provider when generating a title
+	maxTitleInputLength = 2000
+
+	// maxTitleLength caps the length of a generated title
+	maxTitleLength = 80
+)
+
+// titleSystemPrompt instructs the provider to answer with nothing but a title
+const titleSystemPrompt = "Generate a short, descriptive title (at most six words) for a conversation " +
+	"that starts with the following message. Respond with the title only, no quotes or punctuation around it."
+
+// GenerateTitle asks the provider for a short descriptive title based on the
+// session's first user message and stores it as the session name. Sessions
+// that already carry a non-default name are left alone unless force is set.
+// Returns the session name in effect after the call.
+func (m *SQLiteManager) GenerateTitle(ctx context.Context, sessionID string, p provider.Provider, force bool) (string, error) {
+	if sessionID == "" {
+		return "", NewSessionError("GenerateTitle", ErrInvalidSessionID, "empty session ID")
+	}
+
+	session, err := m.GetSession(ctx, sessionID)
+	if err != nil {
+		return "", NewSessionError("GenerateTitle", err, "failed to get session")
+	}
+
+	// Respect manually chosen names
+	if !force && session.Name != "" && session.Name != DefaultSessionName {
+		return session.Name, nil
+	}
+
+	messages, err := m.GetMessages(ctx, sessionID)
+	if err != nil {
+		return "", NewSessionError("GenerateTitle", err, "failed to get messages")
+	}
+
+	var firstUserMessage string
+	for _, msg := range messages {
+		if msg.Role == RoleUser {
+			firstUserMessage = msg.Content
+			break
+		}
+	}
+	if firstUserMessage == "" {
+		return "", NewSessionError("GenerateTitle", ErrMessageNotFound, "session has no user messages")
+	}
+
+	// Truncate very long messages before sending
+	if len(firstUserMessage) > maxTitleInputLength {
+		firstUserMessage = firstUserMessage[:maxTitleInputLength]
+	}
+
+	opts := []provider.ChatOption{
+		provider.WithSystemPrompt(titleSystemPrompt),
+		provider.WithMaxTokens(32),
+		provider.WithTemperature(0.3),
+	}
+	if session.Model != nil && *session.Model != "" {
+		opts = append(opts, provider.WithModel(*session.Model))
+	}
+
+	resp, err := p.Chat(ctx, []provider.Message{
+		{Role: "user", Content: firstUserMessage},
+	}, opts...)
+	if err != nil {
+		return "", NewSessionError("GenerateTitle", err, "provider error")
+	}
+
+	title := sanitizeTitle(resp.Content)
+	if title == "" {
+		return "", NewSessionError("GenerateTitle", ErrEmptySessionName, "provider returned an empty title")
+	}
+
+	session.Name = title
+	if err := m.UpdateSession(ctx, session); err != nil {
+		return "", NewSessionError("GenerateTitle", err, "failed to update session")
+	}
+
+	return title, nil
+}
+
+// sanitizeTitle collapses a provider response to a single bounded line
+func sanitizeTitle(raw string) string {
+	title := strings.TrimSpace(raw)
+
+	// Keep only the first line in case the model rambles
+	if idx := strings.IndexByte(title, '\n'); idx >= 0 {
+		title = strings.TrimSpace(title[:idx])
+	}
+
+	// Strip surrounding quotes some models insist on
+	title = strings.Trim(title, `"'`)
+
+	if len(title) > maxTitleLength {
+		title = strings.TrimSpace(title[:maxTitleLength])
+	}
+
+	return title
+}
@@ -0,0 +1,106 @@
+package session
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/AINative-studio/ainative-code/internal/provider"
+	"github.com/AINative-studio/ainative-code/internal/provider/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTitle(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	manager := NewSQLiteManager(db)
+	ctx := context.Background()
+
+	newSession := func(t *testing.T, name string) *Session {
+		t.Helper()
+		session := createTestSession(t, name)
+		require.NoError(t, manager.CreateSession(ctx, session))
+		msg := createTestMessage(t, session.ID, RoleUser, "How do I set up TLS certificates for nginx?")
+		require.NoError(t, manager.AddMessage(ctx, msg))
+		return session
+	}
+
+	t.Run("GeneratesAndStoresTitle", func(t *testing.T) {
+		session := newSession(t, DefaultSessionName)
+
+		p := mock.NewMockProvider()
+		p.EnqueueResponse(provider.Response{Content: "Nginx TLS Setup\n"})
+
+		title, err := manager.GenerateTitle(ctx, session.ID, p, false)
+		require.NoError(t, err)
+		assert.Equal(t, "Nginx TLS Setup", title)
+
+		updated, err := manager.GetSession(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Nginx TLS Setup", updated.Name)
+	})
+
+	t.Run("NoOpForCustomName", func(t *testing.T) {
+		session := newSession(t, "My Custom Name")
+
+		p := mock.NewMockProvider()
+
+		title, err := manager.GenerateTitle(ctx, session.ID, p, false)
+		require.NoError(t, err)
+		assert.Equal(t, "My Custom Name", title)
+		assert.Empty(t, p.Calls(), "provider should not be called for a non-default name")
+	})
+
+	t.Run("ForceOverridesCustomName", func(t *testing.T) {
+		session := newSession(t, "My Custom Name")
+
+		p := mock.NewMockProvider()
+		p.EnqueueResponse(provider.Response{Content: `"Nginx TLS Setup"`})
+
+		title, err := manager.GenerateTitle(ctx, session.ID, p, true)
+		require.NoError(t, err)
+		assert.Equal(t, "Nginx TLS Setup", title)
+	})
+
+	t.Run("TruncatesLongInput", func(t *testing.T) {
+		session := createTestSession(t, DefaultSessionName)
+		require.NoError(t, manager.CreateSession(ctx, session))
+		msg := createTestMessage(t, session.ID, RoleUser, strings.Repeat("very long message ", 1000))
+		require.NoError(t, manager.AddMessage(ctx, msg))
+
+		p := mock.NewMockProvider()
+		p.EnqueueResponse(provider.Response{Content: "Long Message"})
+
+		_, err := manager.GenerateTitle(ctx, session.ID, p, false)
+		require.NoError(t, err)
+
+		calls := p.Calls()
+		require.Len(t, calls, 1)
+		require.Len(t, calls[0].Messages, 1)
+		assert.LessOrEqual(t, len(calls[0].Messages[0].Content), maxTitleInputLength)
+	})
+
+	t.Run("CapsTitleLength", func(t *testing.T) {
+		session := newSession(t, DefaultSessionName)
+
+		p := mock.NewMockProvider()
+		p.EnqueueResponse(provider.Response{Content: strings.Repeat("Endless Title ", 20)})
+
+		title, err := manager.GenerateTitle(ctx, session.ID, p, false)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(title), maxTitleLength)
+	})
+
+	t.Run("NoUserMessages", func(t *testing.T) {
+		session := createTestSession(t, DefaultSessionName)
+		require.NoError(t, manager.CreateSession(ctx, session))
+
+		p := mock.NewMockProvider()
+
+		_, err := manager.GenerateTitle(ctx, session.ID, p, false)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrMessageNotFound)
+	})
+}
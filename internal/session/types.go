@@ -67,6 +67,10 @@ type Session struct {
 	Temperature *float64       `json:"temperature,omitempty"`
 	MaxTokens   *int64         `json:"max_tokens,omitempty"`
 	Settings    map[string]any `json:"settings,omitempty"`
+
+	// Version is incremented on every update and used for optimistic
+	// concurrency control. A zero version skips the conflict check.
+	Version int64 `json:"version,omitempty"`
 }
 
 // Message represents a conversation message
@@ -86,8 +90,9 @@ type Message struct {
 // SessionSummary represents a session with summary information
 type SessionSummary struct {
 	Session
-	MessageCount int64 `json:"message_count"`
-	TotalTokens  int64 `json:"total_tokens,omitempty"`
+	MessageCount int64    `json:"message_count"`
+	TotalTokens  int64    `json:"total_tokens,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
 }
 
 // ExportFormat represents the format for session export
@@ -105,12 +110,15 @@ const (
 
 	// ExportFormatText exports session as plain text
 	ExportFormatText ExportFormat = "text"
+
+	// ExportFormatCSV exports session as CSV
+	ExportFormatCSV ExportFormat = "csv"
 )
 
 // IsValid checks if an export format is valid
 func (f ExportFormat) IsValid() bool {
 	switch f {
-	case ExportFormatJSON, ExportFormatMarkdown, ExportFormatHTML, ExportFormatText:
+	case ExportFormatJSON, ExportFormatMarkdown, ExportFormatHTML, ExportFormatText, ExportFormatCSV:
 		return true
 	default:
 		return false
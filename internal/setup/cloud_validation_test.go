@@ -0,0 +1,218 @@
+package setup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAzureServer returns a server that answers the chat-completions probe
+// with the given status after checking the api-key header
+func mockAzureServer(t *testing.T, expectedKey string, statusCode int) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/openai/deployments/gpt-4o/chat/completions", r.URL.Path)
+		assert.NotEmpty(t, r.URL.Query().Get("api-version"))
+
+		if r.Header.Get("api-key") != expectedKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(statusCode)
+	}))
+}
+
+func TestValidateAzureOpenAI(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		apiKey     string
+		wantErr    bool
+		errMsg     string
+	}{
+		{
+			name:       "valid deployment",
+			statusCode: http.StatusOK,
+			apiKey:     "azure-key",
+			wantErr:    false,
+		},
+		{
+			name:       "bad request still proves access",
+			statusCode: http.StatusBadRequest,
+			apiKey:     "azure-key",
+			wantErr:    false,
+		},
+		{
+			name:       "rate limited still proves access",
+			statusCode: http.StatusTooManyRequests,
+			apiKey:     "azure-key",
+			wantErr:    false,
+		},
+		{
+			name:       "invalid key",
+			statusCode: http.StatusOK,
+			apiKey:     "wrong-key",
+			wantErr:    true,
+			errMsg:     "authentication failed",
+		},
+		{
+			name:       "deployment not found",
+			statusCode: http.StatusNotFound,
+			apiKey:     "azure-key",
+			wantErr:    true,
+			errMsg:     "deployment 'gpt-4o' not found",
+		},
+		{
+			name:       "server error",
+			statusCode: http.StatusInternalServerError,
+			apiKey:     "azure-key",
+			wantErr:    true,
+			errMsg:     "server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := mockAzureServer(t, "azure-key", tt.statusCode)
+			defer server.Close()
+
+			validator := NewValidator()
+			err := validator.ValidateAzureOpenAI(context.Background(), server.URL, tt.apiKey, "gpt-4o", "")
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAzureOpenAI_RequiredFields(t *testing.T) {
+	validator := NewValidator()
+	ctx := context.Background()
+
+	err := validator.ValidateAzureOpenAI(ctx, "", "key", "gpt-4o", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "endpoint cannot be empty")
+
+	err = validator.ValidateAzureOpenAI(ctx, "https://example.openai.azure.com", "", "gpt-4o", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "API key cannot be empty")
+
+	err = validator.ValidateAzureOpenAI(ctx, "https://example.openai.azure.com", "key", "", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deployment name cannot be empty")
+
+	err = validator.ValidateAzureOpenAI(ctx, "ftp://example.com", "key", "gpt-4o", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "http or https")
+}
+
+func TestValidateProviderConfig_Bedrock(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	validator := NewValidator()
+	err := validator.ValidateProviderConfig(context.Background(), "bedrock", map[string]interface{}{
+		"bedrock_region":            "us-east-1",
+		"bedrock_access_key_id":     "AKIATEST",
+		"bedrock_secret_access_key": "secret",
+		"bedrock_endpoint":          server.URL,
+	})
+	require.NoError(t, err)
+}
+
+func TestValidateProviderConfig_BedrockMissingCredentials(t *testing.T) {
+	validator := NewValidator()
+	ctx := context.Background()
+
+	err := validator.ValidateProviderConfig(ctx, "bedrock", map[string]interface{}{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AWS access key ID is required")
+
+	err = validator.ValidateProviderConfig(ctx, "bedrock", map[string]interface{}{
+		"bedrock_access_key_id": "AKIATEST",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AWS secret access key is required")
+}
+
+func TestValidateProviderConfig_Azure(t *testing.T) {
+	server := mockAzureServer(t, "azure-key", http.StatusOK)
+	defer server.Close()
+
+	validator := NewValidator()
+	err := validator.ValidateProviderConfig(context.Background(), "azure", map[string]interface{}{
+		"azure_endpoint":   server.URL,
+		"azure_api_key":    "azure-key",
+		"azure_deployment": "gpt-4o",
+	})
+	require.NoError(t, err)
+}
+
+func TestValidateProviderConfig_AzureMissingFields(t *testing.T) {
+	validator := NewValidator()
+	ctx := context.Background()
+
+	err := validator.ValidateProviderConfig(ctx, "azure", map[string]interface{}{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Azure OpenAI endpoint is required")
+
+	err = validator.ValidateProviderConfig(ctx, "azure", map[string]interface{}{
+		"azure_endpoint": "https://example.openai.azure.com",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Azure OpenAI API key is required")
+
+	err = validator.ValidateProviderConfig(ctx, "azure", map[string]interface{}{
+		"azure_endpoint": "https://example.openai.azure.com",
+		"azure_api_key":  "azure-key",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Azure OpenAI deployment name is required")
+}
+
+func TestSelectionsFromEnv(t *testing.T) {
+	t.Setenv("AINATIVE_PROVIDER", "bedrock")
+	t.Setenv("AINATIVE_BEDROCK_REGION", "eu-west-1")
+	t.Setenv("AINATIVE_BEDROCK_ACCESS_KEY_ID", "AKIATEST")
+	t.Setenv("AINATIVE_BEDROCK_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AINATIVE_AZURE_ENDPOINT", "https://example.openai.azure.com")
+	t.Setenv("AINATIVE_EXTENDED_THINKING", "true")
+
+	selections := SelectionsFromEnv()
+	assert.Equal(t, "bedrock", selections["provider"])
+	assert.Equal(t, "eu-west-1", selections["bedrock_region"])
+	assert.Equal(t, "AKIATEST", selections["bedrock_access_key_id"])
+	assert.Equal(t, "secret", selections["bedrock_secret_access_key"])
+	assert.Equal(t, "https://example.openai.azure.com", selections["azure_endpoint"])
+	assert.Equal(t, true, selections["extended_thinking"])
+
+	// Unset variables produce no selections
+	_, present := selections["azure_api_key"]
+	assert.False(t, present)
+}
+
+func TestSeedSelectionsFromEnv_ExplicitSelectionsWin(t *testing.T) {
+	t.Setenv("AINATIVE_PROVIDER", "azure")
+	t.Setenv("AINATIVE_AZURE_API_KEY", "env-key")
+
+	wizard := NewWizard(context.Background(), WizardConfig{})
+	wizard.SetSelections(map[string]interface{}{
+		"provider": "anthropic",
+	})
+	wizard.seedSelectionsFromEnv()
+
+	selections := wizard.Selections()
+	assert.Equal(t, "anthropic", selections["provider"])
+	assert.Equal(t, "env-key", selections["azure_api_key"])
+}
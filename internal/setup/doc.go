@@ -35,6 +35,12 @@
 //	export AINATIVE_ANTHROPIC_MODEL=claude-3-5-sonnet-20241022
 //	ainative-code setup --non-interactive
 //
+// AWS Bedrock uses AINATIVE_BEDROCK_REGION, AINATIVE_BEDROCK_ACCESS_KEY_ID,
+// AINATIVE_BEDROCK_SECRET_ACCESS_KEY, and optionally
+// AINATIVE_BEDROCK_SESSION_TOKEN and AINATIVE_BEDROCK_MODEL. Azure OpenAI
+// uses AINATIVE_AZURE_ENDPOINT, AINATIVE_AZURE_API_KEY,
+// AINATIVE_AZURE_DEPLOYMENT, and optionally AINATIVE_AZURE_API_VERSION.
+//
 // # Validation
 //
 // The wizard validates API keys and credentials by default:
@@ -43,6 +49,8 @@
 //   - OpenAI: Validates key format and tests API access
 //   - Google: Checks API key validity
 //   - Ollama: Verifies server connectivity and model availability
+//   - Bedrock: Signs a list-foundation-models call to verify AWS credentials
+//   - Azure: Tests the endpoint, api-key, and deployment name
 //
 // Validation can be skipped for faster setup:
 //
@@ -0,0 +1,63 @@
+package setup
+
+import "os"
+
+// envSelectionKeys maps AINATIVE_* environment variables to wizard selection
+// keys for non-interactive mode. Boolean selections are handled separately.
+var envSelectionKeys = map[string]string{
+	"AINATIVE_PROVIDER":                  "provider",
+	"AINATIVE_ANTHROPIC_API_KEY":         "anthropic_api_key",
+	"AINATIVE_ANTHROPIC_MODEL":           "anthropic_model",
+	"AINATIVE_OPENAI_API_KEY":            "openai_api_key",
+	"AINATIVE_OPENAI_MODEL":              "openai_model",
+	"AINATIVE_GOOGLE_API_KEY":            "google_api_key",
+	"AINATIVE_GOOGLE_MODEL":              "google_model",
+	"AINATIVE_OLLAMA_URL":                "ollama_url",
+	"AINATIVE_OLLAMA_MODEL":              "ollama_model",
+	"AINATIVE_META_LLAMA_API_KEY":        "meta_llama_api_key",
+	"AINATIVE_META_LLAMA_MODEL":          "meta_llama_model",
+	"AINATIVE_BEDROCK_REGION":            "bedrock_region",
+	"AINATIVE_BEDROCK_ACCESS_KEY_ID":     "bedrock_access_key_id",
+	"AINATIVE_BEDROCK_SECRET_ACCESS_KEY": "bedrock_secret_access_key",
+	"AINATIVE_BEDROCK_SESSION_TOKEN":     "bedrock_session_token",
+	"AINATIVE_BEDROCK_MODEL":             "bedrock_model",
+	"AINATIVE_AZURE_ENDPOINT":            "azure_endpoint",
+	"AINATIVE_AZURE_API_KEY":             "azure_api_key",
+	"AINATIVE_AZURE_DEPLOYMENT":          "azure_deployment",
+	"AINATIVE_AZURE_API_VERSION":         "azure_api_version",
+}
+
+// envBoolSelectionKeys maps environment variables to boolean selections
+var envBoolSelectionKeys = map[string]string{
+	"AINATIVE_EXTENDED_THINKING": "extended_thinking",
+}
+
+// SelectionsFromEnv reads wizard selections from AINATIVE_* environment
+// variables. Only set, non-empty variables produce selections.
+func SelectionsFromEnv() map[string]interface{} {
+	selections := make(map[string]interface{})
+
+	for envVar, key := range envSelectionKeys {
+		if value := os.Getenv(envVar); value != "" {
+			selections[key] = value
+		}
+	}
+
+	for envVar, key := range envBoolSelectionKeys {
+		if value := os.Getenv(envVar); value != "" {
+			selections[key] = value == "true" || value == "1"
+		}
+	}
+
+	return selections
+}
+
+// seedSelectionsFromEnv fills in selections from environment variables
+// without overriding values that were set explicitly
+func (w *Wizard) seedSelectionsFromEnv() {
+	for key, value := range SelectionsFromEnv() {
+		if _, present := w.userSelections[key]; !present {
+			w.userSelections[key] = value
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package setup
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// OllamaModelNotInstalledError indicates the selected model is not present
+// on the Ollama server
+type OllamaModelNotInstalledError struct {
+	BaseURL   string
+	Model     string
+	Installed []string
+}
+
+func (e *OllamaModelNotInstalledError) Error() string {
+	msg := fmt.Sprintf("Ollama model '%s' is not installed; run 'ollama pull %s' to download it", e.Model, e.Model)
+	if len(e.Installed) > 0 {
+		msg += fmt.Sprintf(" (installed models: %s)", strings.Join(e.Installed, ", "))
+	}
+	return msg
+}
+
+// ollamaTagsResponse is the /api/tags response shape
+type ollamaTagsResponse struct {
+	Models []struct {
+		Name string `json:"name"`
+	} `json:"models"`
+}
+
+// ollamaPullProgress is one line of the streaming /api/pull response
+type ollamaPullProgress struct {
+	Status    string `json:"status"`
+	Error     string `json:"error"`
+	Completed int64  `json:"completed"`
+	Total     int64  `json:"total"`
+}
+
+// ListOllamaModels returns the names of models installed on an Ollama server
+func (v *Validator) ListOllamaModels(ctx context.Context, baseURL string) ([]string, error) {
+	tagsURL := fmt.Sprintf("%s/api/tags", strings.TrimRight(baseURL, "/"))
+	req, err := http.NewRequestWithContext(ctx, "GET", tagsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Ollama server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Ollama server returned status %d", resp.StatusCode)
+	}
+
+	var tags ollamaTagsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tags); err != nil {
+		return nil, fmt.Errorf("failed to parse Ollama response: %w", err)
+	}
+
+	names := make([]string, 0, len(tags.Models))
+	for _, m := range tags.Models {
+		names = append(names, m.Name)
+	}
+
+	return names, nil
+}
+
+// ollamaModelInstalled reports whether the model is in the installed list.
+// A bare name like "llama2" matches any tag of that model ("llama2:latest").
+func ollamaModelInstalled(installed []string, model string) bool {
+	for _, name := range installed {
+		if name == model {
+			return true
+		}
+		if !strings.Contains(model, ":") && strings.HasPrefix(name, model+":") {
+			return true
+		}
+	}
+	return false
+}
+
+// PullOllamaModel pulls a model on the Ollama server, invoking progress for
+// every status line of the streaming response
+func (v *Validator) PullOllamaModel(ctx context.Context, baseURL, model string, progress func(status string, completed, total int64)) error {
+	body, err := json.Marshal(map[string]string{"name": model})
+	if err != nil {
+		return fmt.Errorf("failed to marshal pull request: %w", err)
+	}
+
+	pullURL := fmt.Sprintf("%s/api/pull", strings.TrimRight(baseURL, "/"))
+	req, err := http.NewRequestWithContext(ctx, "POST", pullURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Pulls can take a long time; bypass the short validation timeout
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Ollama server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Ollama server returned status %d", resp.StatusCode)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var p ollamaPullProgress
+		if err := json.Unmarshal(line, &p); err != nil {
+			continue
+		}
+		if p.Error != "" {
+			return fmt.Errorf("model pull failed: %s", p.Error)
+		}
+		if progress != nil {
+			progress(p.Status, p.Completed, p.Total)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read pull progress: %w", err)
+	}
+
+	return nil
+}
+
+// resolveMissingOllamaModel interactively offers to pull the missing model
+// or to pick one of the installed models instead
+func (w *Wizard) resolveMissingOllamaModel(e *OllamaModelNotInstalledError) error {
+	fmt.Printf("\nModel '%s' is not installed on the Ollama server.\n", e.Model)
+
+	if len(e.Installed) > 0 {
+		fmt.Println("\nInstalled models:")
+		for i, name := range e.Installed {
+			fmt.Printf("  %d. %s\n", i+1, name)
+		}
+		fmt.Printf("\nPull '%s' now? [y/N], or enter a number to use an installed model: ", e.Model)
+	} else {
+		fmt.Printf("\nPull '%s' now? [y/N]: ", e.Model)
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	answer, err := reader.ReadString('\n')
+	if err != nil {
+		return e
+	}
+	answer = strings.TrimSpace(answer)
+
+	// Picking an installed model replaces the selection
+	if idx, err := strconv.Atoi(answer); err == nil && idx >= 1 && idx <= len(e.Installed) {
+		w.userSelections["ollama_model"] = e.Installed[idx-1]
+		fmt.Printf("Using installed model '%s'.\n", e.Installed[idx-1])
+		return nil
+	}
+
+	if !strings.EqualFold(answer, "y") {
+		return e
+	}
+
+	fmt.Printf("Pulling '%s'...\n", e.Model)
+	validator := NewValidator()
+	err = validator.PullOllamaModel(w.ctx, e.BaseURL, e.Model, func(status string, completed, total int64) {
+		if total > 0 {
+			fmt.Printf("\r%s: %d%% (%d/%d bytes)    ", status, completed*100/total, completed, total)
+		} else {
+			fmt.Printf("\r%s    ", status)
+		}
+	})
+	fmt.Println()
+
+	if err != nil {
+		return fmt.Errorf("failed to pull model '%s': %w", e.Model, err)
+	}
+
+	fmt.Printf("Model '%s' pulled successfully.\n", e.Model)
+	return nil
+}
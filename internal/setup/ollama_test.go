@@ -0,0 +1,166 @@
+package setup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockOllamaServer serves /api/tags with the given installed models and a
+// streaming /api/pull that succeeds unless pullError is set
+func mockOllamaServer(t *testing.T, installed []string, pullError string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tags":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"models":[`)
+			for i, name := range installed {
+				if i > 0 {
+					fmt.Fprint(w, ",")
+				}
+				fmt.Fprintf(w, `{"name":%q}`, name)
+			}
+			fmt.Fprint(w, `]}`)
+
+		case "/api/pull":
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			if pullError != "" {
+				fmt.Fprintf(w, `{"error":%q}`+"\n", pullError)
+				return
+			}
+			fmt.Fprintln(w, `{"status":"pulling manifest"}`)
+			fmt.Fprintln(w, `{"status":"downloading","completed":512,"total":1024}`)
+			fmt.Fprintln(w, `{"status":"downloading","completed":1024,"total":1024}`)
+			fmt.Fprintln(w, `{"status":"success"}`)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestListOllamaModels(t *testing.T) {
+	server := mockOllamaServer(t, []string{"llama2:latest", "codellama:13b"}, "")
+	defer server.Close()
+
+	validator := NewValidator()
+	models, err := validator.ListOllamaModels(context.Background(), server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"llama2:latest", "codellama:13b"}, models)
+}
+
+func TestListOllamaModels_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	validator := NewValidator()
+	_, err := validator.ListOllamaModels(context.Background(), server.URL)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 500")
+}
+
+func TestOllamaModelInstalled(t *testing.T) {
+	installed := []string{"llama2:latest", "codellama:13b"}
+
+	// Exact tag match
+	assert.True(t, ollamaModelInstalled(installed, "llama2:latest"))
+	assert.True(t, ollamaModelInstalled(installed, "codellama:13b"))
+
+	// Bare name matches any tag
+	assert.True(t, ollamaModelInstalled(installed, "llama2"))
+	assert.True(t, ollamaModelInstalled(installed, "codellama"))
+
+	// Missing model and wrong tag
+	assert.False(t, ollamaModelInstalled(installed, "mistral"))
+	assert.False(t, ollamaModelInstalled(installed, "llama2:7b"))
+}
+
+func TestValidateOllamaModel_Installed(t *testing.T) {
+	server := mockOllamaServer(t, []string{"llama2:latest"}, "")
+	defer server.Close()
+
+	validator := NewValidator()
+	err := validator.ValidateOllamaModel(context.Background(), server.URL, "llama2")
+	assert.NoError(t, err)
+}
+
+func TestValidateOllamaModel_Missing(t *testing.T) {
+	server := mockOllamaServer(t, []string{"llama2:latest"}, "")
+	defer server.Close()
+
+	validator := NewValidator()
+	err := validator.ValidateOllamaModel(context.Background(), server.URL, "mistral")
+	require.Error(t, err)
+
+	// The error names the model, the pull command, and installed models
+	assert.Contains(t, err.Error(), "'mistral'")
+	assert.Contains(t, err.Error(), "ollama pull mistral")
+	assert.Contains(t, err.Error(), "llama2:latest")
+
+	missing, ok := err.(*OllamaModelNotInstalledError)
+	require.True(t, ok)
+	assert.Equal(t, "mistral", missing.Model)
+	assert.Equal(t, []string{"llama2:latest"}, missing.Installed)
+}
+
+func TestValidateOllamaModel_ListingFailureIsSoft(t *testing.T) {
+	validator := NewValidator()
+
+	// Unreachable server: validation stays soft since connectivity was
+	// checked separately
+	err := validator.ValidateOllamaModel(context.Background(), "http://127.0.0.1:1", "llama2")
+	assert.NoError(t, err)
+}
+
+func TestPullOllamaModel(t *testing.T) {
+	server := mockOllamaServer(t, nil, "")
+	defer server.Close()
+
+	var statuses []string
+	var lastCompleted, lastTotal int64
+
+	validator := NewValidator()
+	err := validator.PullOllamaModel(context.Background(), server.URL, "mistral", func(status string, completed, total int64) {
+		statuses = append(statuses, status)
+		lastCompleted, lastTotal = completed, total
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"pulling manifest", "downloading", "downloading", "success"}, statuses)
+	assert.Equal(t, int64(0), lastCompleted) // Final "success" line has no byte counts
+	assert.Equal(t, int64(0), lastTotal)
+}
+
+func TestPullOllamaModel_Error(t *testing.T) {
+	server := mockOllamaServer(t, nil, "model not found")
+	defer server.Close()
+
+	validator := NewValidator()
+	err := validator.PullOllamaModel(context.Background(), server.URL, "bogus", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "model not found")
+}
+
+func TestValidateProviderConfig_OllamaMissingModel(t *testing.T) {
+	server := mockOllamaServer(t, []string{"llama2:latest"}, "")
+	defer server.Close()
+
+	validator := NewValidator()
+	err := validator.ValidateProviderConfig(context.Background(), "ollama", map[string]interface{}{
+		"ollama_url":   server.URL,
+		"ollama_model": "mistral",
+	})
+
+	// Non-interactive callers get the descriptive error directly
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ollama pull mistral")
+}
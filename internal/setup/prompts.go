@@ -45,6 +45,7 @@ type PromptModel struct {
 	textInput   textinput.Model
 	cursor      int
 	err         error
+	section     string // When set, only this section's steps are shown
 }
 
 // NewPromptModel creates a new prompt model
@@ -627,6 +628,12 @@ func (m PromptModel) nextStep() (tea.Model, tea.Cmd) {
 		return m, tea.Quit
 	}
 
+	// In section mode, stop once the flow leaves the section's steps
+	if m.section != "" && !stepInSection(m.section, m.currentStep) {
+		m.currentStep = StepComplete
+		return m, tea.Quit
+	}
+
 	// Focus textinput if transitioning to a text input step
 	if m.isTextInputStep() {
 		m.textInput.Focus()
@@ -639,6 +646,79 @@ func (m PromptModel) nextStep() (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// NewSectionPromptModel creates a prompt model that runs only the steps of
+// a single wizard section, starting from the section's first step. The
+// given selections seed the model so prompts reflect the current
+// configuration.
+func NewSectionPromptModel(section string, selections map[string]interface{}) PromptModel {
+	m := NewPromptModel()
+	m.section = section
+
+	for key, value := range selections {
+		m.Selections[key] = value
+	}
+
+	m.currentStep = sectionStartStep(section, m.Selections)
+	return m
+}
+
+// sectionStartStep returns the first step of the given section. The model
+// section starts at the model step for the currently selected provider.
+func sectionStartStep(section string, selections map[string]interface{}) Step {
+	switch section {
+	case SectionProvider:
+		return StepProvider
+
+	case SectionModel:
+		provider, _ := selections["provider"].(string)
+		switch provider {
+		case "openai":
+			return StepOpenAIModel
+		case "google":
+			return StepGoogleModel
+		case "ollama":
+			return StepOllamaModel
+		case "meta_llama":
+			return StepMetaLlamaModel
+		default:
+			return StepAnthropicModel
+		}
+
+	case SectionPlatform:
+		return StepAINativeLogin
+
+	case SectionTheme:
+		return StepColorScheme
+
+	default:
+		return StepProvider
+	}
+}
+
+// stepInSection reports whether a step belongs to the given wizard section
+func stepInSection(section string, step Step) bool {
+	switch section {
+	case SectionProvider:
+		return step >= StepProvider && step <= StepMetaLlamaModel
+
+	case SectionModel:
+		switch step {
+		case StepAnthropicModel, StepExtendedThinking, StepOpenAIModel,
+			StepGoogleModel, StepOllamaModel, StepMetaLlamaModel:
+			return true
+		}
+		return false
+
+	case SectionPlatform:
+		return step >= StepAINativeLogin && step <= StepZeroDBEndpoint
+
+	case SectionTheme:
+		return step == StepColorScheme
+	}
+
+	return false
+}
+
 func (m PromptModel) isTextInputStep() bool {
 	return m.currentStep == StepAnthropicAPIKey ||
 		m.currentStep == StepOpenAIAPIKey ||
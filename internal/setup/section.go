@@ -0,0 +1,189 @@
+package setup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"gopkg.in/yaml.v3"
+
+	"github.com/AINative-studio/ainative-code/internal/config"
+)
+
+// Wizard section names accepted by WizardConfig.Section
+const (
+	SectionProvider = "provider"
+	SectionModel    = "model"
+	SectionPlatform = "platform"
+	SectionTheme    = "theme"
+)
+
+// validSections lists the wizard sections that can be reconfigured on
+// their own
+var validSections = []string{SectionProvider, SectionModel, SectionPlatform, SectionTheme}
+
+// IsValidSection reports whether name is a reconfigurable wizard section
+func IsValidSection(name string) bool {
+	for _, s := range validSections {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+// runSectionSetup reconfigures a single wizard section, loading the
+// existing configuration as defaults and writing back only the changes
+// for that section. The initialization marker is left untouched.
+func (w *Wizard) runSectionSetup() (*WizardResult, error) {
+	section := w.config.Section
+	if !IsValidSection(section) {
+		return nil, fmt.Errorf("unknown section '%s' (valid sections: %s)", section, strings.Join(validSections, ", "))
+	}
+
+	// Section mode adjusts an existing configuration; there is nothing to
+	// merge into on a fresh install
+	cfg, err := w.loadExistingConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	// Seed selections from the existing configuration so prompts show
+	// current values and untouched settings survive the merge
+	w.seedSelectionsFromConfig(cfg)
+
+	// Run only the prompts for this section
+	if w.config.InteractiveMode {
+		model := NewSectionPromptModel(section, w.userSelections)
+
+		p := tea.NewProgram(model, tea.WithAltScreen())
+		finalModel, err := p.Run()
+		if err != nil {
+			return nil, fmt.Errorf("failed to run section setup: %w", err)
+		}
+
+		if m, ok := finalModel.(PromptModel); ok {
+			w.userSelections = m.Selections
+		}
+	}
+
+	// Merge section changes into the loaded configuration
+	w.mergeSectionConfiguration(cfg, section)
+	w.result.Config = cfg
+
+	// Validate configuration
+	if !w.config.SkipValidation {
+		if err := w.validateConfiguration(); err != nil {
+			return nil, fmt.Errorf("configuration validation failed: %w", err)
+		}
+		w.result.ValidationPass = true
+	}
+
+	// Write configuration file; the initialization marker is preserved so
+	// this doesn't count as a fresh first-run
+	if err := w.writeConfiguration(); err != nil {
+		return nil, fmt.Errorf("failed to write configuration: %w", err)
+	}
+
+	return w.result, nil
+}
+
+// loadExistingConfiguration reads the configuration file that section mode
+// merges into
+func (w *Wizard) loadExistingConfiguration() (*config.Config, error) {
+	configPath := w.config.ConfigPath
+	if configPath == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get home directory: %w", err)
+		}
+		configPath = filepath.Join(homeDir, ".ainative-code.yaml")
+	}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no existing configuration at %s; run 'ainative-code setup' first", configPath)
+		}
+		return nil, fmt.Errorf("failed to read existing configuration: %w", err)
+	}
+
+	cfg := &config.Config{}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse existing configuration: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// seedSelectionsFromConfig fills user selections from the existing
+// configuration without overwriting selections that were already set
+func (w *Wizard) seedSelectionsFromConfig(cfg *config.Config) {
+	seed := func(key string, value interface{}) {
+		if _, exists := w.userSelections[key]; !exists {
+			w.userSelections[key] = value
+		}
+	}
+
+	if cfg.LLM.DefaultProvider != "" {
+		seed("provider", cfg.LLM.DefaultProvider)
+	}
+
+	if cfg.LLM.Anthropic != nil {
+		seed("anthropic_api_key", cfg.LLM.Anthropic.APIKey)
+		seed("anthropic_model", cfg.LLM.Anthropic.Model)
+		if cfg.LLM.Anthropic.ExtendedThinking != nil {
+			seed("extended_thinking", cfg.LLM.Anthropic.ExtendedThinking.Enabled)
+		}
+	}
+	if cfg.LLM.OpenAI != nil {
+		seed("openai_api_key", cfg.LLM.OpenAI.APIKey)
+		seed("openai_model", cfg.LLM.OpenAI.Model)
+	}
+	if cfg.LLM.Google != nil {
+		seed("google_api_key", cfg.LLM.Google.APIKey)
+		seed("google_model", cfg.LLM.Google.Model)
+	}
+	if cfg.LLM.Ollama != nil {
+		seed("ollama_url", cfg.LLM.Ollama.BaseURL)
+		seed("ollama_model", cfg.LLM.Ollama.Model)
+	}
+	if cfg.LLM.MetaLlama != nil {
+		seed("meta_llama_api_key", cfg.LLM.MetaLlama.APIKey)
+		seed("meta_llama_model", cfg.LLM.MetaLlama.Model)
+	}
+
+	if cfg.Platform.Authentication.Method == "api_key" {
+		seed("ainative_login", true)
+		seed("ainative_api_key", cfg.Platform.Authentication.APIKey)
+	}
+	if cfg.Services.Strapi != nil && cfg.Services.Strapi.Enabled {
+		seed("strapi_enabled", true)
+		seed("strapi_url", cfg.Services.Strapi.Endpoint)
+		seed("strapi_api_key", cfg.Services.Strapi.APIKey)
+	}
+	if cfg.Services.ZeroDB != nil && cfg.Services.ZeroDB.Enabled {
+		seed("zerodb_enabled", true)
+		seed("zerodb_project_id", cfg.Services.ZeroDB.ProjectID)
+		seed("zerodb_endpoint", cfg.Services.ZeroDB.Endpoint)
+	}
+}
+
+// mergeSectionConfiguration applies only the selections belonging to the
+// given section onto the loaded configuration
+func (w *Wizard) mergeSectionConfiguration(cfg *config.Config, section string) {
+	switch section {
+	case SectionProvider, SectionModel:
+		w.applyProviderSelections(cfg)
+
+	case SectionPlatform:
+		w.applyPlatformSelections(cfg)
+
+	case SectionTheme:
+		// The color scheme selection is recorded in userSelections and
+		// applied by the TUI at startup; nothing to merge into the
+		// configuration file, matching the full wizard
+	}
+}
@@ -0,0 +1,231 @@
+package setup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/AINative-studio/ainative-code/internal/config"
+)
+
+// writeExistingConfig marshals a config to a temp file and returns its path
+func writeExistingConfig(t *testing.T, cfg *config.Config) string {
+	t.Helper()
+
+	data, err := yaml.Marshal(cfg)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	return path
+}
+
+// anthropicBaseConfig returns a config resembling a completed first run
+func anthropicBaseConfig() *config.Config {
+	return &config.Config{
+		App: config.AppConfig{
+			Name:        "ainative-code",
+			Version:     "0.1.0",
+			Environment: "development",
+		},
+		LLM: config.LLMConfig{
+			DefaultProvider: "anthropic",
+			Anthropic: &config.AnthropicConfig{
+				APIKey:        "sk-ant-existing",
+				Model:         "claude-sonnet-4-5-20250929",
+				MaxTokens:     8192, // User-tuned, must survive reconfiguration
+				Temperature:   0.5,
+				Timeout:       30000000000,
+				RetryAttempts: 3,
+				APIVersion:    "2023-06-01",
+			},
+		},
+		Logging: config.LoggingConfig{
+			Level:  "debug",
+			Format: "json",
+			Output: "stdout",
+		},
+	}
+}
+
+func TestIsValidSection(t *testing.T) {
+	for _, name := range []string{"provider", "model", "platform", "theme"} {
+		assert.True(t, IsValidSection(name), "section %s should be valid", name)
+	}
+	assert.False(t, IsValidSection("logging"))
+	assert.False(t, IsValidSection(""))
+}
+
+func TestSectionSetup_UnknownSection(t *testing.T) {
+	wizard := NewWizard(context.Background(), WizardConfig{
+		Section:         "bogus",
+		InteractiveMode: false,
+		SkipValidation:  true,
+	})
+
+	_, err := wizard.Run()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown section 'bogus'")
+}
+
+func TestSectionSetup_RequiresExistingConfig(t *testing.T) {
+	wizard := NewWizard(context.Background(), WizardConfig{
+		ConfigPath:      filepath.Join(t.TempDir(), "missing.yaml"),
+		Section:         SectionModel,
+		InteractiveMode: false,
+		SkipValidation:  true,
+	})
+
+	_, err := wizard.Run()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no existing configuration")
+}
+
+func TestSectionSetup_ModelChangePreservesRest(t *testing.T) {
+	configPath := writeExistingConfig(t, anthropicBaseConfig())
+
+	wizard := NewWizard(context.Background(), WizardConfig{
+		ConfigPath:      configPath,
+		Section:         SectionModel,
+		InteractiveMode: false,
+		SkipValidation:  true,
+	})
+	wizard.SetSelections(map[string]interface{}{
+		"anthropic_model": "claude-opus-4-1",
+	})
+
+	result, err := wizard.Run()
+	require.NoError(t, err)
+	require.NotNil(t, result.Config.LLM.Anthropic)
+
+	// Requested change applied
+	assert.Equal(t, "claude-opus-4-1", result.Config.LLM.Anthropic.Model)
+
+	// Unrelated settings untouched
+	assert.Equal(t, "sk-ant-existing", result.Config.LLM.Anthropic.APIKey)
+	assert.Equal(t, 8192, result.Config.LLM.Anthropic.MaxTokens)
+	assert.Equal(t, "anthropic", result.Config.LLM.DefaultProvider)
+	assert.Equal(t, "debug", result.Config.Logging.Level)
+
+	// Not a fresh first-run
+	assert.False(t, result.MarkerCreated)
+
+	// Changes persisted to the same file
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	written := &config.Config{}
+	require.NoError(t, yaml.Unmarshal(data, written))
+	assert.Equal(t, "claude-opus-4-1", written.LLM.Anthropic.Model)
+}
+
+func TestSectionSetup_ProviderSwitchKeepsOldBlock(t *testing.T) {
+	configPath := writeExistingConfig(t, anthropicBaseConfig())
+
+	wizard := NewWizard(context.Background(), WizardConfig{
+		ConfigPath:      configPath,
+		Section:         SectionProvider,
+		InteractiveMode: false,
+		SkipValidation:  true,
+	})
+	wizard.SetSelections(map[string]interface{}{
+		"provider":       "openai",
+		"openai_api_key": "sk-openai-new",
+	})
+
+	result, err := wizard.Run()
+	require.NoError(t, err)
+
+	assert.Equal(t, "openai", result.Config.LLM.DefaultProvider)
+	require.NotNil(t, result.Config.LLM.OpenAI)
+	assert.Equal(t, "sk-openai-new", result.Config.LLM.OpenAI.APIKey)
+	assert.Equal(t, "gpt-4-turbo-preview", result.Config.LLM.OpenAI.Model)
+
+	// The previous provider block survives for easy switching back
+	require.NotNil(t, result.Config.LLM.Anthropic)
+	assert.Equal(t, "sk-ant-existing", result.Config.LLM.Anthropic.APIKey)
+}
+
+func TestSectionSetup_PlatformAddsService(t *testing.T) {
+	configPath := writeExistingConfig(t, anthropicBaseConfig())
+
+	wizard := NewWizard(context.Background(), WizardConfig{
+		ConfigPath:      configPath,
+		Section:         SectionPlatform,
+		InteractiveMode: false,
+		SkipValidation:  true,
+	})
+	wizard.SetSelections(map[string]interface{}{
+		"strapi_enabled": true,
+		"strapi_url":     "http://localhost:1337",
+		"strapi_api_key": "strapi-token",
+	})
+
+	result, err := wizard.Run()
+	require.NoError(t, err)
+
+	require.NotNil(t, result.Config.Services.Strapi)
+	assert.True(t, result.Config.Services.Strapi.Enabled)
+	assert.Equal(t, "http://localhost:1337", result.Config.Services.Strapi.Endpoint)
+
+	// Provider configuration untouched
+	assert.Equal(t, "anthropic", result.Config.LLM.DefaultProvider)
+	assert.Equal(t, "sk-ant-existing", result.Config.LLM.Anthropic.APIKey)
+}
+
+func TestSeedSelectionsFromConfig(t *testing.T) {
+	wizard := NewWizard(context.Background(), WizardConfig{
+		InteractiveMode: false,
+		SkipValidation:  true,
+	})
+	// Explicit selections are not overwritten by seeds
+	wizard.SetSelections(map[string]interface{}{
+		"anthropic_model": "claude-opus-4-1",
+	})
+
+	wizard.seedSelectionsFromConfig(anthropicBaseConfig())
+
+	assert.Equal(t, "anthropic", wizard.userSelections["provider"])
+	assert.Equal(t, "sk-ant-existing", wizard.userSelections["anthropic_api_key"])
+	assert.Equal(t, "claude-opus-4-1", wizard.userSelections["anthropic_model"])
+}
+
+func TestSectionPromptModelStartSteps(t *testing.T) {
+	tests := []struct {
+		section  string
+		provider string
+		expected Step
+	}{
+		{SectionProvider, "", StepProvider},
+		{SectionModel, "anthropic", StepAnthropicModel},
+		{SectionModel, "openai", StepOpenAIModel},
+		{SectionModel, "ollama", StepOllamaModel},
+		{SectionPlatform, "", StepAINativeLogin},
+		{SectionTheme, "", StepColorScheme},
+	}
+
+	for _, tt := range tests {
+		selections := map[string]interface{}{}
+		if tt.provider != "" {
+			selections["provider"] = tt.provider
+		}
+
+		model := NewSectionPromptModel(tt.section, selections)
+		assert.Equal(t, tt.expected, model.currentStep, "section %s provider %s", tt.section, tt.provider)
+	}
+}
+
+func TestSectionPromptModelStopsAtBoundary(t *testing.T) {
+	// The theme section has a single step; advancing past it completes
+	model := NewSectionPromptModel(SectionTheme, nil)
+	model.Selections["color_scheme"] = "dark"
+
+	updated, _ := model.nextStep()
+	m, ok := updated.(PromptModel)
+	require.True(t, ok)
+	assert.Equal(t, StepComplete, m.currentStep)
+}
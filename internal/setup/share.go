@@ -0,0 +1,96 @@
+package setup
+
+import (
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// redactedValue marks a secret that was stripped from an exported selections
+// file; imports must prompt for it instead of writing a blank
+const redactedValue = "<redacted>"
+
+// secretSelectionKeys lists the selections that hold credentials
+var secretSelectionKeys = []string{
+	"anthropic_api_key",
+	"openai_api_key",
+	"google_api_key",
+	"meta_llama_api_key",
+	"ainative_api_key",
+	"strapi_api_key",
+}
+
+// isSecretSelection reports whether a selection key holds a credential
+func isSecretSelection(key string) bool {
+	for _, k := range secretSelectionKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// ExportSelections serializes the wizard selections to shareable YAML.
+// With redactSecrets, credential values are replaced by a redaction marker
+// so the file can be passed around a team safely.
+func (w *Wizard) ExportSelections(redactSecrets bool) ([]byte, error) {
+	exported := make(map[string]interface{}, len(w.userSelections))
+	for key, value := range w.userSelections {
+		if redactSecrets && isSecretSelection(key) {
+			if s, ok := value.(string); ok && s != "" {
+				exported[key] = redactedValue
+				continue
+			}
+		}
+		exported[key] = value
+	}
+
+	data, err := yaml.Marshal(exported)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal selections: %w", err)
+	}
+
+	return data, nil
+}
+
+// ImportSelections pre-fills the wizard from an exported selections file.
+// Redacted secrets are dropped so the wizard prompts for them rather than
+// writing blanks.
+func (w *Wizard) ImportSelections(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("failed to read selections: %w", err)
+	}
+
+	imported := make(map[string]interface{})
+	if err := yaml.Unmarshal(data, &imported); err != nil {
+		return fmt.Errorf("failed to parse selections file: %w", err)
+	}
+
+	for key, value := range imported {
+		if s, ok := value.(string); ok && s == redactedValue {
+			continue
+		}
+		w.userSelections[key] = value
+	}
+
+	return nil
+}
+
+// LoadExistingSelections seeds the wizard selections from the existing
+// configuration file, for exporting a baseline to share
+func (w *Wizard) LoadExistingSelections() error {
+	cfg, err := w.loadExistingConfiguration()
+	if err != nil {
+		return err
+	}
+
+	w.seedSelectionsFromConfig(cfg)
+	return nil
+}
+
+// Selections returns the current wizard selections
+func (w *Wizard) Selections() map[string]interface{} {
+	return w.userSelections
+}
@@ -0,0 +1,103 @@
+package setup
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestExportSelections_RedactsSecrets(t *testing.T) {
+	wizard := NewWizard(context.Background(), WizardConfig{})
+	wizard.SetSelections(map[string]interface{}{
+		"provider":          "anthropic",
+		"anthropic_api_key": "sk-ant-secret",
+		"anthropic_model":   "claude-sonnet-4-5-20250929",
+		"extended_thinking": true,
+		"color_scheme":      "dark",
+	})
+
+	data, err := wizard.ExportSelections(true)
+	require.NoError(t, err)
+
+	exported := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal(data, &exported))
+
+	// Secret replaced by marker, everything else intact
+	assert.Equal(t, redactedValue, exported["anthropic_api_key"])
+	assert.Equal(t, "anthropic", exported["provider"])
+	assert.Equal(t, "claude-sonnet-4-5-20250929", exported["anthropic_model"])
+	assert.Equal(t, true, exported["extended_thinking"])
+	assert.NotContains(t, string(data), "sk-ant-secret")
+}
+
+func TestExportSelections_WithoutRedaction(t *testing.T) {
+	wizard := NewWizard(context.Background(), WizardConfig{})
+	wizard.SetSelections(map[string]interface{}{
+		"provider":          "anthropic",
+		"anthropic_api_key": "sk-ant-secret",
+	})
+
+	data, err := wizard.ExportSelections(false)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "sk-ant-secret")
+}
+
+func TestImportSelections(t *testing.T) {
+	exported := `provider: anthropic
+anthropic_api_key: <redacted>
+anthropic_model: claude-opus-4-1
+extended_thinking: true
+color_scheme: dark
+`
+
+	wizard := NewWizard(context.Background(), WizardConfig{})
+	require.NoError(t, wizard.ImportSelections(strings.NewReader(exported)))
+
+	selections := wizard.Selections()
+	assert.Equal(t, "anthropic", selections["provider"])
+	assert.Equal(t, "claude-opus-4-1", selections["anthropic_model"])
+	assert.Equal(t, true, selections["extended_thinking"])
+
+	// Redacted secrets are dropped so the wizard prompts for them
+	_, present := selections["anthropic_api_key"]
+	assert.False(t, present, "redacted secret must not be imported")
+}
+
+func TestImportSelections_InvalidYAML(t *testing.T) {
+	wizard := NewWizard(context.Background(), WizardConfig{})
+	err := wizard.ImportSelections(strings.NewReader("{not: [valid"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse")
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	source := NewWizard(context.Background(), WizardConfig{})
+	source.SetSelections(map[string]interface{}{
+		"provider":       "ollama",
+		"ollama_url":     "http://localhost:11434",
+		"ollama_model":   "codellama",
+		"strapi_enabled": true,
+		"strapi_url":     "http://localhost:1337",
+		"strapi_api_key": "strapi-secret",
+		"ainative_login": false,
+	})
+
+	data, err := source.ExportSelections(true)
+	require.NoError(t, err)
+
+	target := NewWizard(context.Background(), WizardConfig{})
+	require.NoError(t, target.ImportSelections(strings.NewReader(string(data))))
+
+	selections := target.Selections()
+	assert.Equal(t, "ollama", selections["provider"])
+	assert.Equal(t, "codellama", selections["ollama_model"])
+	assert.Equal(t, true, selections["strapi_enabled"])
+	assert.Equal(t, "http://localhost:1337", selections["strapi_url"])
+
+	_, present := selections["strapi_api_key"]
+	assert.False(t, present, "redacted secret must not survive the round trip")
+}
@@ -7,6 +7,9 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"github.com/AINative-studio/ainative-code/internal/provider/azure"
+	"github.com/AINative-studio/ainative-code/internal/provider/bedrock"
 )
 
 // Validator handles validation of API keys and connections
@@ -151,12 +154,102 @@ func (v *Validator) ValidateOllamaModel(ctx context.Context, baseURL, modelName
 		return fmt.Errorf("model name cannot be empty")
 	}
 
-	// This is a soft validation - we just check the format
-	// Actual model existence will be checked at runtime
 	if strings.Contains(modelName, " ") {
 		return fmt.Errorf("model name cannot contain spaces")
 	}
 
+	// Check the model is actually downloaded so the first chat doesn't fail.
+	// A listing failure is soft: connectivity was already validated and the
+	// model will be checked again at runtime.
+	installed, err := v.ListOllamaModels(ctx, baseURL)
+	if err != nil {
+		return nil
+	}
+
+	if !ollamaModelInstalled(installed, modelName) {
+		return &OllamaModelNotInstalledError{
+			BaseURL:   baseURL,
+			Model:     modelName,
+			Installed: installed,
+		}
+	}
+
+	return nil
+}
+
+// ValidateBedrockCredentials validates AWS Bedrock credentials and region by
+// calling the free list-foundation-models API. The endpoint parameter
+// overrides the AWS endpoint for testing; leave it empty in production.
+func (v *Validator) ValidateBedrockCredentials(ctx context.Context, region, accessKeyID, secretAccessKey, sessionToken, endpoint string) error {
+	if accessKeyID == "" {
+		return fmt.Errorf("AWS access key ID cannot be empty")
+	}
+	if secretAccessKey == "" {
+		return fmt.Errorf("AWS secret access key cannot be empty")
+	}
+
+	return bedrock.ValidateCredentials(ctx, bedrock.Config{
+		Region:       region,
+		AccessKey:    accessKeyID,
+		SecretKey:    secretAccessKey,
+		SessionToken: sessionToken,
+		Endpoint:     endpoint,
+		HTTPClient:   v.httpClient,
+	})
+}
+
+// ValidateAzureOpenAI validates an Azure OpenAI endpoint, API key, and
+// deployment by issuing a minimal completion request against the deployment
+func (v *Validator) ValidateAzureOpenAI(ctx context.Context, endpoint, apiKey, deployment, apiVersion string) error {
+	if endpoint == "" {
+		return fmt.Errorf("Azure OpenAI endpoint cannot be empty")
+	}
+	if apiKey == "" {
+		return fmt.Errorf("Azure OpenAI API key cannot be empty")
+	}
+	if deployment == "" {
+		return fmt.Errorf("Azure OpenAI deployment name cannot be empty")
+	}
+
+	parsedURL, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid Azure endpoint URL: %w", err)
+	}
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return fmt.Errorf("Azure endpoint must use http or https scheme")
+	}
+
+	if apiVersion == "" {
+		apiVersion = azure.DefaultAPIVersion
+	}
+
+	testURL := fmt.Sprintf("%s/openai/deployments/%s/chat/completions?api-version=%s",
+		strings.TrimRight(endpoint, "/"), deployment, apiVersion)
+
+	body := strings.NewReader(`{"messages":[{"role":"user","content":"ping"}],"max_tokens":1}`)
+	req, err := http.NewRequestWithContext(ctx, "POST", testURL, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("api-key", apiKey)
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Azure OpenAI endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("Azure OpenAI authentication failed: invalid API key")
+	case resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("Azure OpenAI deployment '%s' not found at %s", deployment, endpoint)
+	case resp.StatusCode >= 500:
+		return fmt.Errorf("Azure OpenAI server error: status %d", resp.StatusCode)
+	}
+
+	// 2xx, 400, and 429 all prove the endpoint, key, and deployment exist
 	return nil
 }
 
@@ -232,6 +325,36 @@ func (v *Validator) ValidateProviderConfig(ctx context.Context, provider string,
 		}
 		return v.ValidateOllamaModel(ctx, baseURL, modelName)
 
+	case "bedrock":
+		accessKeyID, ok := selections["bedrock_access_key_id"].(string)
+		if !ok || accessKeyID == "" {
+			return fmt.Errorf("AWS access key ID is required")
+		}
+		secretAccessKey, ok := selections["bedrock_secret_access_key"].(string)
+		if !ok || secretAccessKey == "" {
+			return fmt.Errorf("AWS secret access key is required")
+		}
+		region, _ := selections["bedrock_region"].(string)
+		sessionToken, _ := selections["bedrock_session_token"].(string)
+		endpoint, _ := selections["bedrock_endpoint"].(string)
+		return v.ValidateBedrockCredentials(ctx, region, accessKeyID, secretAccessKey, sessionToken, endpoint)
+
+	case "azure":
+		endpoint, ok := selections["azure_endpoint"].(string)
+		if !ok || endpoint == "" {
+			return fmt.Errorf("Azure OpenAI endpoint is required")
+		}
+		apiKey, ok := selections["azure_api_key"].(string)
+		if !ok || apiKey == "" {
+			return fmt.Errorf("Azure OpenAI API key is required")
+		}
+		deployment, ok := selections["azure_deployment"].(string)
+		if !ok || deployment == "" {
+			return fmt.Errorf("Azure OpenAI deployment name is required")
+		}
+		apiVersion, _ := selections["azure_api_version"].(string)
+		return v.ValidateAzureOpenAI(ctx, endpoint, apiKey, deployment, apiVersion)
+
 	case "meta_llama", "meta":
 		apiKey, ok := selections["meta_llama_api_key"].(string)
 		if !ok || apiKey == "" {
@@ -2,6 +2,7 @@ package setup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -20,6 +21,7 @@ type WizardConfig struct {
 	SkipValidation  bool
 	InteractiveMode bool
 	Force           bool
+	Section         string // When set, reconfigure only this wizard section
 }
 
 // WizardResult represents the output of the wizard
@@ -53,6 +55,11 @@ func NewWizard(ctx context.Context, cfg WizardConfig) *Wizard {
 
 // Run executes the setup wizard flow
 func (w *Wizard) Run() (*WizardResult, error) {
+	// Single-section reconfiguration has its own flow
+	if w.config.Section != "" {
+		return w.runSectionSetup()
+	}
+
 	// Check if already initialized (skip if force flag is set)
 	if !w.config.Force && w.checkAlreadyInitialized() {
 		return w.result, nil
@@ -66,6 +73,9 @@ func (w *Wizard) Run() (*WizardResult, error) {
 		if err := w.runInteractiveSetup(); err != nil {
 			return nil, fmt.Errorf("interactive setup failed: %w", err)
 		}
+	} else {
+		// Non-interactive mode takes its answers from AINATIVE_* env vars
+		w.seedSelectionsFromEnv()
 	}
 
 	// Build configuration from selections
@@ -152,6 +162,11 @@ func (w *Wizard) showWelcome() {
 func (w *Wizard) runInteractiveSetup() error {
 	model := NewPromptModel()
 
+	// Pre-fill prompts from imported or previously seeded selections
+	for key, value := range w.userSelections {
+		model.Selections[key] = value
+	}
+
 	// Run bubble tea program
 	p := tea.NewProgram(model, tea.WithAltScreen())
 	finalModel, err := p.Run()
@@ -211,43 +226,46 @@ func (w *Wizard) buildConfiguration() error {
 		},
 	}
 
+	w.applyProviderSelections(cfg)
+	w.applyPlatformSelections(cfg)
+
+	w.result.Config = cfg
+	return nil
+}
+
+// applyProviderSelections applies the provider and model selections to the
+// configuration, creating the provider block with defaults when it doesn't
+// exist yet and otherwise only updating the selected credentials and model
+func (w *Wizard) applyProviderSelections(cfg *config.Config) {
 	// Set default provider
 	if provider, ok := w.userSelections["provider"].(string); ok {
 		cfg.LLM.DefaultProvider = provider
-	} else {
+	} else if cfg.LLM.DefaultProvider == "" {
 		cfg.LLM.DefaultProvider = "anthropic"
 	}
 
 	// Configure Anthropic
 	if cfg.LLM.DefaultProvider == "anthropic" {
-		apiKey := ""
-		if key, ok := w.userSelections["anthropic_api_key"].(string); ok {
-			apiKey = key
-		}
-
-		model := "claude-sonnet-4-5-20250929"
-		if m, ok := w.userSelections["anthropic_model"].(string); ok && m != "" {
-			model = m
+		if cfg.LLM.Anthropic == nil {
+			cfg.LLM.Anthropic = &config.AnthropicConfig{
+				Model:         "claude-sonnet-4-5-20250929",
+				MaxTokens:     4096,
+				Temperature:   0.7,
+				TopP:          1.0,
+				TopK:          0,
+				Timeout:       30000000000, // 30s
+				RetryAttempts: 3,
+				APIVersion:    "2023-06-01",
+			}
 		}
 
-		extendedThinking := false
-		if et, ok := w.userSelections["extended_thinking"].(bool); ok {
-			extendedThinking = et
+		if key, ok := w.userSelections["anthropic_api_key"].(string); ok {
+			cfg.LLM.Anthropic.APIKey = key
 		}
-
-		cfg.LLM.Anthropic = &config.AnthropicConfig{
-			APIKey:        apiKey,
-			Model:         model,
-			MaxTokens:     4096,
-			Temperature:   0.7,
-			TopP:          1.0,
-			TopK:          0,
-			Timeout:       30000000000, // 30s
-			RetryAttempts: 3,
-			APIVersion:    "2023-06-01",
+		if m, ok := w.userSelections["anthropic_model"].(string); ok && m != "" {
+			cfg.LLM.Anthropic.Model = m
 		}
-
-		if extendedThinking {
+		if et, ok := w.userSelections["extended_thinking"].(bool); ok && et {
 			cfg.LLM.Anthropic.ExtendedThinking = &config.ExtendedThinkingConfig{
 				Enabled:    true,
 				AutoExpand: false,
@@ -258,104 +276,159 @@ func (w *Wizard) buildConfiguration() error {
 
 	// Configure OpenAI
 	if cfg.LLM.DefaultProvider == "openai" {
-		apiKey := ""
-		if key, ok := w.userSelections["openai_api_key"].(string); ok {
-			apiKey = key
+		if cfg.LLM.OpenAI == nil {
+			cfg.LLM.OpenAI = &config.OpenAIConfig{
+				Model:            "gpt-4-turbo-preview",
+				MaxTokens:        4096,
+				Temperature:      0.7,
+				TopP:             1.0,
+				FrequencyPenalty: 0.0,
+				PresencePenalty:  0.0,
+				Timeout:          30000000000, // 30s
+				RetryAttempts:    3,
+			}
 		}
 
-		model := "gpt-4-turbo-preview"
-		if m, ok := w.userSelections["openai_model"].(string); ok && m != "" {
-			model = m
+		if key, ok := w.userSelections["openai_api_key"].(string); ok {
+			cfg.LLM.OpenAI.APIKey = key
 		}
-
-		cfg.LLM.OpenAI = &config.OpenAIConfig{
-			APIKey:           apiKey,
-			Model:            model,
-			MaxTokens:        4096,
-			Temperature:      0.7,
-			TopP:             1.0,
-			FrequencyPenalty: 0.0,
-			PresencePenalty:  0.0,
-			Timeout:          30000000000, // 30s
-			RetryAttempts:    3,
+		if m, ok := w.userSelections["openai_model"].(string); ok && m != "" {
+			cfg.LLM.OpenAI.Model = m
 		}
 	}
 
 	// Configure Google Gemini
 	if cfg.LLM.DefaultProvider == "google" {
-		apiKey := ""
-		if key, ok := w.userSelections["google_api_key"].(string); ok {
-			apiKey = key
+		if cfg.LLM.Google == nil {
+			cfg.LLM.Google = &config.GoogleConfig{
+				Model:         "gemini-pro",
+				MaxTokens:     4096,
+				Temperature:   0.7,
+				TopP:          1.0,
+				TopK:          40,
+				Timeout:       30000000000, // 30s
+				RetryAttempts: 3,
+			}
 		}
 
-		model := "gemini-pro"
-		if m, ok := w.userSelections["google_model"].(string); ok && m != "" {
-			model = m
+		if key, ok := w.userSelections["google_api_key"].(string); ok {
+			cfg.LLM.Google.APIKey = key
 		}
-
-		cfg.LLM.Google = &config.GoogleConfig{
-			APIKey:        apiKey,
-			Model:         model,
-			MaxTokens:     4096,
-			Temperature:   0.7,
-			TopP:          1.0,
-			TopK:          40,
-			Timeout:       30000000000, // 30s
-			RetryAttempts: 3,
+		if m, ok := w.userSelections["google_model"].(string); ok && m != "" {
+			cfg.LLM.Google.Model = m
 		}
 	}
 
 	// Configure Ollama
 	if cfg.LLM.DefaultProvider == "ollama" {
-		baseURL := "http://localhost:11434"
-		if url, ok := w.userSelections["ollama_url"].(string); ok && url != "" {
-			baseURL = url
+		if cfg.LLM.Ollama == nil {
+			cfg.LLM.Ollama = &config.OllamaConfig{
+				BaseURL:       "http://localhost:11434",
+				Model:         "llama2",
+				MaxTokens:     4096,
+				Temperature:   0.7,
+				TopP:          1.0,
+				TopK:          40,
+				Timeout:       120000000000, // 120s
+				RetryAttempts: 1,
+				KeepAlive:     "5m",
+			}
 		}
 
-		model := "llama2"
+		if url, ok := w.userSelections["ollama_url"].(string); ok && url != "" {
+			cfg.LLM.Ollama.BaseURL = url
+		}
 		if m, ok := w.userSelections["ollama_model"].(string); ok && m != "" {
-			model = m
+			cfg.LLM.Ollama.Model = m
+		}
+	}
+
+	// Configure AWS Bedrock
+	if cfg.LLM.DefaultProvider == "bedrock" {
+		if cfg.LLM.Bedrock == nil {
+			cfg.LLM.Bedrock = &config.BedrockConfig{
+				Region:        "us-east-1",
+				Model:         "anthropic.claude-3-5-sonnet-20241022-v2:0",
+				MaxTokens:     4096,
+				Temperature:   0.7,
+				TopP:          1.0,
+				Timeout:       30000000000, // 30s
+				RetryAttempts: 3,
+			}
 		}
 
-		cfg.LLM.Ollama = &config.OllamaConfig{
-			BaseURL:       baseURL,
-			Model:         model,
-			MaxTokens:     4096,
-			Temperature:   0.7,
-			TopP:          1.0,
-			TopK:          40,
-			Timeout:       120000000000, // 120s
-			RetryAttempts: 1,
-			KeepAlive:     "5m",
+		if region, ok := w.userSelections["bedrock_region"].(string); ok && region != "" {
+			cfg.LLM.Bedrock.Region = region
+		}
+		if key, ok := w.userSelections["bedrock_access_key_id"].(string); ok {
+			cfg.LLM.Bedrock.AccessKeyID = key
+		}
+		if secret, ok := w.userSelections["bedrock_secret_access_key"].(string); ok {
+			cfg.LLM.Bedrock.SecretAccessKey = secret
+		}
+		if token, ok := w.userSelections["bedrock_session_token"].(string); ok && token != "" {
+			cfg.LLM.Bedrock.SessionToken = token
+		}
+		if m, ok := w.userSelections["bedrock_model"].(string); ok && m != "" {
+			cfg.LLM.Bedrock.Model = m
+		}
+	}
+
+	// Configure Azure OpenAI
+	if cfg.LLM.DefaultProvider == "azure" {
+		if cfg.LLM.Azure == nil {
+			cfg.LLM.Azure = &config.AzureConfig{
+				APIVersion:    "2024-02-15-preview",
+				MaxTokens:     4096,
+				Temperature:   0.7,
+				TopP:          1.0,
+				Timeout:       30000000000, // 30s
+				RetryAttempts: 3,
+			}
+		}
+
+		if endpoint, ok := w.userSelections["azure_endpoint"].(string); ok && endpoint != "" {
+			cfg.LLM.Azure.Endpoint = endpoint
+		}
+		if key, ok := w.userSelections["azure_api_key"].(string); ok {
+			cfg.LLM.Azure.APIKey = key
+		}
+		if deployment, ok := w.userSelections["azure_deployment"].(string); ok && deployment != "" {
+			cfg.LLM.Azure.DeploymentName = deployment
+		}
+		if version, ok := w.userSelections["azure_api_version"].(string); ok && version != "" {
+			cfg.LLM.Azure.APIVersion = version
 		}
 	}
 
 	// Configure Meta Llama
 	if cfg.LLM.DefaultProvider == "meta_llama" {
-		apiKey := ""
-		if key, ok := w.userSelections["meta_llama_api_key"].(string); ok {
-			apiKey = key
+		if cfg.LLM.MetaLlama == nil {
+			cfg.LLM.MetaLlama = &config.MetaLlamaConfig{
+				Model:            "Llama-4-Maverick-17B-128E-Instruct-FP8",
+				MaxTokens:        4096,
+				Temperature:      0.7,
+				TopP:             0.9,
+				Timeout:          60000000000, // 60s
+				RetryAttempts:    3,
+				BaseURL:          "https://api.llama.com/compat/v1",
+				PresencePenalty:  0.0,
+				FrequencyPenalty: 0.0,
+			}
 		}
 
-		model := "Llama-4-Maverick-17B-128E-Instruct-FP8"
-		if m, ok := w.userSelections["meta_llama_model"].(string); ok && m != "" {
-			model = m
+		if key, ok := w.userSelections["meta_llama_api_key"].(string); ok {
+			cfg.LLM.MetaLlama.APIKey = key
 		}
-
-		cfg.LLM.MetaLlama = &config.MetaLlamaConfig{
-			APIKey:           apiKey,
-			Model:            model,
-			MaxTokens:        4096,
-			Temperature:      0.7,
-			TopP:             0.9,
-			Timeout:          60000000000, // 60s
-			RetryAttempts:    3,
-			BaseURL:          "https://api.llama.com/compat/v1",
-			PresencePenalty:  0.0,
-			FrequencyPenalty: 0.0,
+		if m, ok := w.userSelections["meta_llama_model"].(string); ok && m != "" {
+			cfg.LLM.MetaLlama.Model = m
 		}
 	}
+}
 
+// applyPlatformSelections applies the AINative platform and service
+// selections to the configuration
+func (w *Wizard) applyPlatformSelections(cfg *config.Config) {
 	// AINative platform login (optional)
 	if loginEnabled, ok := w.userSelections["ainative_login"].(bool); ok && loginEnabled {
 		if apiKey, ok := w.userSelections["ainative_api_key"].(string); ok {
@@ -366,55 +439,46 @@ func (w *Wizard) buildConfiguration() error {
 
 	// Configure Strapi (optional)
 	if strapiEnabled, ok := w.userSelections["strapi_enabled"].(bool); ok && strapiEnabled {
-		strapiURL := ""
-		if url, ok := w.userSelections["strapi_url"].(string); ok {
-			strapiURL = url
+		if cfg.Services.Strapi == nil {
+			cfg.Services.Strapi = &config.StrapiConfig{
+				Timeout:       30000000000, // 30s
+				RetryAttempts: 3,
+			}
 		}
+		cfg.Services.Strapi.Enabled = true
 
-		strapiAPIKey := ""
-		if key, ok := w.userSelections["strapi_api_key"].(string); ok {
-			strapiAPIKey = key
+		if url, ok := w.userSelections["strapi_url"].(string); ok {
+			cfg.Services.Strapi.Endpoint = url
 		}
-
-		cfg.Services.Strapi = &config.StrapiConfig{
-			Enabled:       true,
-			Endpoint:      strapiURL,
-			APIKey:        strapiAPIKey,
-			Timeout:       30000000000, // 30s
-			RetryAttempts: 3,
+		if key, ok := w.userSelections["strapi_api_key"].(string); ok {
+			cfg.Services.Strapi.APIKey = key
 		}
 	}
 
 	// Configure ZeroDB (optional)
 	if zeroDBEnabled, ok := w.userSelections["zerodb_enabled"].(bool); ok && zeroDBEnabled {
-		projectID := ""
-		if pid, ok := w.userSelections["zerodb_project_id"].(string); ok {
-			projectID = pid
+		if cfg.Services.ZeroDB == nil {
+			cfg.Services.ZeroDB = &config.ZeroDBConfig{
+				Database:        "default",
+				SSL:             true,
+				SSLMode:         "require",
+				MaxConnections:  10,
+				IdleConnections: 5,
+				ConnMaxLifetime: 3600000000000, // 1h
+				Timeout:         30000000000,   // 30s
+				RetryAttempts:   3,
+				RetryDelay:      1000000000, // 1s
+			}
 		}
+		cfg.Services.ZeroDB.Enabled = true
 
-		endpoint := ""
-		if ep, ok := w.userSelections["zerodb_endpoint"].(string); ok && ep != "" {
-			endpoint = ep
+		if pid, ok := w.userSelections["zerodb_project_id"].(string); ok {
+			cfg.Services.ZeroDB.ProjectID = pid
 		}
-
-		cfg.Services.ZeroDB = &config.ZeroDBConfig{
-			Enabled:         true,
-			ProjectID:       projectID,
-			Endpoint:        endpoint,
-			Database:        "default",
-			SSL:             true,
-			SSLMode:         "require",
-			MaxConnections:  10,
-			IdleConnections: 5,
-			ConnMaxLifetime: 3600000000000, // 1h
-			Timeout:         30000000000,   // 30s
-			RetryAttempts:   3,
-			RetryDelay:      1000000000, // 1s
+		if ep, ok := w.userSelections["zerodb_endpoint"].(string); ok && ep != "" {
+			cfg.Services.ZeroDB.Endpoint = ep
 		}
 	}
-
-	w.result.Config = cfg
-	return nil
 }
 
 // validateConfiguration validates the built configuration
@@ -429,7 +493,22 @@ func (w *Wizard) validateConfiguration() error {
 	if !w.config.SkipValidation {
 		validator := NewValidator()
 		provider := w.userSelections["provider"].(string)
-		return validator.ValidateProviderConfig(w.ctx, provider, w.userSelections)
+		err := validator.ValidateProviderConfig(w.ctx, provider, w.userSelections)
+
+		// A missing Ollama model can be resolved interactively by pulling
+		// it or picking an installed one; non-interactive runs surface the
+		// descriptive error as-is
+		var missing *OllamaModelNotInstalledError
+		if errors.As(err, &missing) && w.config.InteractiveMode {
+			if resolveErr := w.resolveMissingOllamaModel(missing); resolveErr != nil {
+				return resolveErr
+			}
+			// Re-apply selections in case an installed model was chosen
+			w.applyProviderSelections(w.result.Config)
+			return nil
+		}
+
+		return err
 	}
 
 	return nil
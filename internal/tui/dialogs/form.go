@@ -0,0 +1,370 @@
+package dialogs
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// FormFieldType defines the kind of input a form field renders
+type FormFieldType int
+
+const (
+	FieldText FormFieldType = iota
+	FieldPassword
+	FieldSelect
+)
+
+// FormField describes a single labeled field in a form dialog
+type FormField struct {
+	Key          string // Result key in GetValues
+	Label        string
+	Type         FormFieldType
+	Placeholder  string
+	DefaultValue string
+	Options      []string           // Choices for FieldSelect
+	Validator    func(string) error // Optional per-field validation
+}
+
+// FormDialogConfig contains configuration for a form dialog
+type FormDialogConfig struct {
+	ID          string
+	Title       string
+	Description string
+	Fields      []FormField
+}
+
+// FormDialog represents a dialog composing several labeled fields with
+// Tab/Shift+Tab navigation and per-field validation
+type FormDialog struct {
+	id          string
+	title       string
+	description string
+	fields      []FormField
+	inputs      map[string]textinput.Model // Text/password inputs by field key
+	selectIdx   map[string]int             // Selected option index for select fields
+	focusTrap   *FocusTrap
+	fieldErrors map[string]string
+	values      map[string]string
+	submitted   bool
+	closing     bool
+	width       int
+	height      int
+}
+
+// submitFocusID is the focus trap ID for the submit button
+const submitFocusID = "form-submit"
+
+// NewFormDialog creates a new form dialog
+func NewFormDialog(config FormDialogConfig) *FormDialog {
+	if config.ID == "" {
+		config.ID = "form-dialog"
+	}
+
+	inputs := make(map[string]textinput.Model)
+	selectIdx := make(map[string]int)
+	focusIDs := make([]string, 0, len(config.Fields)+1)
+
+	for _, field := range config.Fields {
+		focusIDs = append(focusIDs, field.Key)
+
+		switch field.Type {
+		case FieldSelect:
+			idx := 0
+			for i, opt := range field.Options {
+				if opt == field.DefaultValue {
+					idx = i
+					break
+				}
+			}
+			selectIdx[field.Key] = idx
+
+		default:
+			ti := textinput.New()
+			ti.Placeholder = field.Placeholder
+			ti.CharLimit = 256
+			ti.Width = 36
+			ti.SetValue(field.DefaultValue)
+			if field.Type == FieldPassword {
+				ti.EchoMode = textinput.EchoPassword
+			}
+			inputs[field.Key] = ti
+		}
+	}
+	focusIDs = append(focusIDs, submitFocusID)
+
+	trap := NewFocusTrap()
+	trap.SetFocusableElements(focusIDs)
+	trap.Activate()
+
+	d := &FormDialog{
+		id:          config.ID,
+		title:       config.Title,
+		description: config.Description,
+		fields:      config.Fields,
+		inputs:      inputs,
+		selectIdx:   selectIdx,
+		focusTrap:   trap,
+		fieldErrors: make(map[string]string),
+		values:      nil,
+		submitted:   false,
+		closing:     false,
+		width:       80,
+		height:      24,
+	}
+	d.syncFocus()
+
+	return d
+}
+
+// Init initializes the dialog
+func (d *FormDialog) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update handles messages
+func (d *FormDialog) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		key := msg.String()
+
+		// Tab/Shift+Tab cycle focus through fields and the submit button
+		if handled, _ := d.focusTrap.HandleKey(key); handled {
+			d.syncFocus()
+			return d, nil
+		}
+
+		switch key {
+		case "enter":
+			// Enter on the last field or submit button submits the form;
+			// on earlier fields it advances focus
+			focused := d.focusTrap.GetCurrentFocusID()
+			if focused == submitFocusID || d.isLastField(focused) {
+				return d, d.submit()
+			}
+			d.focusTrap.HandleKey("tab")
+			d.syncFocus()
+			return d, nil
+
+		case "esc":
+			// Cancel
+			d.values = nil
+			d.closing = true
+			return d, nil
+		}
+
+		// Route remaining keys to the focused field
+		focused := d.focusTrap.GetCurrentFocusID()
+		if field := d.fieldByKey(focused); field != nil {
+			delete(d.fieldErrors, field.Key)
+
+			if field.Type == FieldSelect {
+				switch key {
+				case "left", "up", "k", "h":
+					d.cycleSelect(field, -1)
+				case "right", "down", "j", "l", " ":
+					d.cycleSelect(field, 1)
+				}
+				return d, nil
+			}
+
+			input := d.inputs[field.Key]
+			input, cmd = input.Update(msg)
+			d.inputs[field.Key] = input
+			return d, cmd
+		}
+	}
+
+	return d, nil
+}
+
+// submit validates all fields, recording inline errors, and closes the
+// dialog only when everything is valid
+func (d *FormDialog) submit() tea.Cmd {
+	d.fieldErrors = make(map[string]string)
+
+	values := make(map[string]string, len(d.fields))
+	for _, field := range d.fields {
+		value := d.fieldValue(&field)
+		if field.Validator != nil {
+			if err := field.Validator(value); err != nil {
+				d.fieldErrors[field.Key] = err.Error()
+				continue
+			}
+		}
+		values[field.Key] = value
+	}
+
+	if len(d.fieldErrors) > 0 {
+		return nil // Block submission, errors render inline
+	}
+
+	d.values = values
+	d.submitted = true
+	d.closing = true
+	return nil
+}
+
+// fieldValue returns the current value of a field
+func (d *FormDialog) fieldValue(field *FormField) string {
+	if field.Type == FieldSelect {
+		if len(field.Options) == 0 {
+			return ""
+		}
+		return field.Options[d.selectIdx[field.Key]]
+	}
+	return strings.TrimSpace(d.inputs[field.Key].Value())
+}
+
+// fieldByKey returns the field with the given key, or nil
+func (d *FormDialog) fieldByKey(key string) *FormField {
+	for i := range d.fields {
+		if d.fields[i].Key == key {
+			return &d.fields[i]
+		}
+	}
+	return nil
+}
+
+// isLastField returns true if key is the final field in the form
+func (d *FormDialog) isLastField(key string) bool {
+	return len(d.fields) > 0 && d.fields[len(d.fields)-1].Key == key
+}
+
+// cycleSelect moves a select field's choice by delta, wrapping around
+func (d *FormDialog) cycleSelect(field *FormField, delta int) {
+	if len(field.Options) == 0 {
+		return
+	}
+	idx := (d.selectIdx[field.Key] + delta + len(field.Options)) % len(field.Options)
+	d.selectIdx[field.Key] = idx
+}
+
+// syncFocus focuses the text input matching the focus trap and blurs the rest
+func (d *FormDialog) syncFocus() {
+	focused := d.focusTrap.GetCurrentFocusID()
+	for key, input := range d.inputs {
+		if key == focused {
+			input.Focus()
+		} else {
+			input.Blur()
+		}
+		d.inputs[key] = input
+	}
+}
+
+// View renders the dialog
+func (d *FormDialog) View() string {
+	var content strings.Builder
+
+	// Title
+	content.WriteString(DialogTitleStyle.Render(d.title))
+	content.WriteString("\n\n")
+
+	// Description
+	if d.description != "" {
+		desc := DialogDescriptionStyle.Width(40).Render(d.description)
+		content.WriteString(desc)
+		content.WriteString("\n\n")
+	}
+
+	focused := d.focusTrap.GetCurrentFocusID()
+
+	// Fields
+	for _, field := range d.fields {
+		label := field.Label
+		if field.Key == focused {
+			label = "▶ " + label
+		} else {
+			label = "  " + label
+		}
+		content.WriteString(HelpTextStyle.Render(label))
+		content.WriteString("\n")
+
+		if field.Type == FieldSelect {
+			choice := "(no options)"
+			if len(field.Options) > 0 {
+				choice = "◀ " + field.Options[d.selectIdx[field.Key]] + " ▶"
+			}
+			if field.Key == focused {
+				content.WriteString(InputFieldFocusedStyle.Render(choice))
+			} else {
+				content.WriteString(InputFieldStyle.Render(choice))
+			}
+		} else {
+			inputView := d.inputs[field.Key].View()
+			if field.Key == focused {
+				content.WriteString(InputFieldFocusedStyle.Render(inputView))
+			} else {
+				content.WriteString(InputFieldStyle.Render(inputView))
+			}
+		}
+		content.WriteString("\n")
+
+		// Inline validation error
+		if errMsg, ok := d.fieldErrors[field.Key]; ok {
+			content.WriteString(ErrorTextStyle.Width(40).Render("⚠ " + errMsg))
+			content.WriteString("\n")
+		}
+	}
+
+	// Submit button
+	content.WriteString("\n")
+	if focused == submitFocusID {
+		content.WriteString(ButtonActiveStyle.Render("[ Submit ]"))
+	} else {
+		content.WriteString(ButtonInactiveStyle.Render("[ Submit ]"))
+	}
+
+	// Help text
+	helpText := HelpTextStyle.Width(44).Render("Tab next field • Enter submit • ESC cancel")
+	content.WriteString("\n\n")
+	content.WriteString(helpText)
+
+	// Wrap in dialog box
+	return RenderDialogBox(content.String(), 48)
+}
+
+// ID returns the dialog ID
+func (d *FormDialog) ID() string {
+	return d.id
+}
+
+// SetSize updates the dialog dimensions
+func (d *FormDialog) SetSize(width, height int) {
+	d.width = width
+	d.height = height
+}
+
+// IsClosing returns true if the dialog is requesting to be closed
+func (d *FormDialog) IsClosing() bool {
+	return d.closing
+}
+
+// Result returns the dialog result
+func (d *FormDialog) Result() interface{} {
+	return d.GetValues()
+}
+
+// GetValues returns the validated field values keyed by field key, or
+// nil if the form was cancelled or not yet submitted
+func (d *FormDialog) GetValues() map[string]string {
+	if !d.submitted {
+		return nil
+	}
+	return d.values
+}
+
+// FieldError returns the current validation error for a field, if any
+func (d *FormDialog) FieldError(key string) string {
+	return d.fieldErrors[key]
+}
+
+// FocusedField returns the key of the currently focused field or the
+// submit button ID
+func (d *FormDialog) FocusedField() string {
+	return d.focusTrap.GetCurrentFocusID()
+}
@@ -0,0 +1,248 @@
+package dialogs_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/AINative-studio/ainative-code/internal/tui/dialogs"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func formFields() []dialogs.FormField {
+	return []dialogs.FormField{
+		{Key: "url", Label: "Server URL", Type: dialogs.FieldText, Placeholder: "https://..."},
+		{Key: "token", Label: "API Token", Type: dialogs.FieldPassword},
+		{Key: "region", Label: "Region", Type: dialogs.FieldSelect, Options: []string{"us-east", "eu-west"}},
+	}
+}
+
+func typeString(d *dialogs.FormDialog, s string) {
+	for _, r := range s {
+		d.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+func TestNewFormDialog(t *testing.T) {
+	dialog := dialogs.NewFormDialog(dialogs.FormDialogConfig{
+		ID:     "test",
+		Title:  "Configure",
+		Fields: formFields(),
+	})
+
+	if dialog.ID() != "test" {
+		t.Errorf("Expected ID 'test', got '%s'", dialog.ID())
+	}
+	if dialog.IsClosing() {
+		t.Error("New dialog should not be closing")
+	}
+	if dialog.FocusedField() != "url" {
+		t.Errorf("Expected first field focused, got '%s'", dialog.FocusedField())
+	}
+	if dialog.GetValues() != nil {
+		t.Error("Values should be nil before submission")
+	}
+}
+
+func TestFormDialogTabNavigation(t *testing.T) {
+	dialog := dialogs.NewFormDialog(dialogs.FormDialogConfig{
+		ID:     "test",
+		Title:  "Configure",
+		Fields: formFields(),
+	})
+
+	// Tab moves forward through fields to the submit button
+	dialog.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if dialog.FocusedField() != "token" {
+		t.Errorf("Expected 'token' focused, got '%s'", dialog.FocusedField())
+	}
+
+	dialog.Update(tea.KeyMsg{Type: tea.KeyTab})
+	dialog.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if dialog.FocusedField() != "form-submit" {
+		t.Errorf("Expected submit focused, got '%s'", dialog.FocusedField())
+	}
+
+	// Shift+Tab moves back
+	dialog.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	if dialog.FocusedField() != "region" {
+		t.Errorf("Expected 'region' focused, got '%s'", dialog.FocusedField())
+	}
+}
+
+func TestFormDialogSubmit(t *testing.T) {
+	dialog := dialogs.NewFormDialog(dialogs.FormDialogConfig{
+		ID:     "test",
+		Title:  "Configure",
+		Fields: formFields(),
+	})
+
+	// Fill in the text field, enter advances to the next field
+	typeString(dialog, "https://example.com")
+	dialog.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if dialog.FocusedField() != "token" {
+		t.Errorf("Enter should advance focus, got '%s'", dialog.FocusedField())
+	}
+
+	typeString(dialog, "secret")
+	dialog.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Cycle the select field to the second option
+	dialog.Update(tea.KeyMsg{Type: tea.KeyRight})
+
+	// Enter on the last field submits
+	dialog.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !dialog.IsClosing() {
+		t.Fatal("Dialog should be closing after submit")
+	}
+
+	values := dialog.GetValues()
+	if values == nil {
+		t.Fatal("Expected values after submit")
+	}
+	if values["url"] != "https://example.com" {
+		t.Errorf("Unexpected url: %q", values["url"])
+	}
+	if values["token"] != "secret" {
+		t.Errorf("Unexpected token: %q", values["token"])
+	}
+	if values["region"] != "eu-west" {
+		t.Errorf("Unexpected region: %q", values["region"])
+	}
+}
+
+func TestFormDialogValidationBlocksSubmit(t *testing.T) {
+	fields := []dialogs.FormField{
+		{
+			Key:   "url",
+			Label: "Server URL",
+			Type:  dialogs.FieldText,
+			Validator: func(value string) error {
+				if !strings.HasPrefix(value, "https://") {
+					return fmt.Errorf("URL must start with https://")
+				}
+				return nil
+			},
+		},
+	}
+
+	dialog := dialogs.NewFormDialog(dialogs.FormDialogConfig{
+		ID:     "test",
+		Title:  "Configure",
+		Fields: fields,
+	})
+
+	typeString(dialog, "not-a-url")
+	dialog.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if dialog.IsClosing() {
+		t.Error("Invalid form should not close")
+	}
+	if dialog.FieldError("url") == "" {
+		t.Error("Expected an inline error on the url field")
+	}
+	if dialog.GetValues() != nil {
+		t.Error("Values should be nil while invalid")
+	}
+
+	// Error renders inline
+	if !strings.Contains(dialog.View(), "URL must start with https://") {
+		t.Error("Expected inline error in view")
+	}
+
+	// Typing clears the error; a valid value then submits
+	for i := 0; i < len("not-a-url"); i++ {
+		dialog.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	if dialog.FieldError("url") != "" {
+		t.Error("Editing the field should clear its error")
+	}
+
+	typeString(dialog, "https://example.com")
+	dialog.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if !dialog.IsClosing() {
+		t.Error("Valid form should close")
+	}
+	if dialog.GetValues()["url"] != "https://example.com" {
+		t.Errorf("Unexpected url: %q", dialog.GetValues()["url"])
+	}
+}
+
+func TestFormDialogPasswordMasked(t *testing.T) {
+	dialog := dialogs.NewFormDialog(dialogs.FormDialogConfig{
+		ID:    "test",
+		Title: "Configure",
+		Fields: []dialogs.FormField{
+			{Key: "token", Label: "API Token", Type: dialogs.FieldPassword},
+		},
+	})
+
+	typeString(dialog, "secret")
+
+	if strings.Contains(dialog.View(), "secret") {
+		t.Error("Password field should mask its value")
+	}
+}
+
+func TestFormDialogCancel(t *testing.T) {
+	dialog := dialogs.NewFormDialog(dialogs.FormDialogConfig{
+		ID:     "test",
+		Title:  "Configure",
+		Fields: formFields(),
+	})
+
+	typeString(dialog, "partial")
+	dialog.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if !dialog.IsClosing() {
+		t.Error("Dialog should be closing after ESC")
+	}
+	if dialog.GetValues() != nil {
+		t.Error("Values should be nil after cancel")
+	}
+}
+
+func TestFormDialogSelectWrapAround(t *testing.T) {
+	dialog := dialogs.NewFormDialog(dialogs.FormDialogConfig{
+		ID:    "test",
+		Title: "Configure",
+		Fields: []dialogs.FormField{
+			{Key: "region", Label: "Region", Type: dialogs.FieldSelect, Options: []string{"us-east", "eu-west"}},
+		},
+	})
+
+	// Cycling left from the first option wraps to the last
+	dialog.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	dialog.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if dialog.GetValues()["region"] != "eu-west" {
+		t.Errorf("Expected wrap-around to 'eu-west', got %q", dialog.GetValues()["region"])
+	}
+}
+
+func TestFormDialogSubmitButton(t *testing.T) {
+	dialog := dialogs.NewFormDialog(dialogs.FormDialogConfig{
+		ID:     "test",
+		Title:  "Configure",
+		Fields: formFields(),
+	})
+
+	typeString(dialog, "https://example.com")
+
+	// Tab all the way to the submit button and press Enter
+	dialog.Update(tea.KeyMsg{Type: tea.KeyTab})
+	dialog.Update(tea.KeyMsg{Type: tea.KeyTab})
+	dialog.Update(tea.KeyMsg{Type: tea.KeyTab})
+	dialog.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if !dialog.IsClosing() {
+		t.Error("Submit button should submit the form")
+	}
+	if dialog.GetValues()["url"] != "https://example.com" {
+		t.Errorf("Unexpected url: %q", dialog.GetValues()["url"])
+	}
+	if dialog.GetValues()["region"] != "us-east" {
+		t.Errorf("Expected default region, got %q", dialog.GetValues()["region"])
+	}
+}
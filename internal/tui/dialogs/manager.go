@@ -1,6 +1,7 @@
 package dialogs
 
 import (
+	"sort"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -17,6 +18,7 @@ type DialogManager struct {
 	focusTrap     *FocusTrap        // Focus trap for current modal
 	nextZIndex    int               // Next auto-assigned z-index
 	baseZIndex    int               // Base z-index (default: 100)
+	appCommands   []Command         // App-provided command palette entries
 }
 
 // NewDialogManager creates a new dialog manager
@@ -64,6 +66,11 @@ func (dm *DialogManager) Update(msg tea.Msg) tea.Cmd {
 		dm.Clear()
 		return nil
 
+	case CommandPaletteMsg:
+		// Open the command palette over the current command list
+		palette := NewCommandPalette(dm.PaletteCommands())
+		return dm.OpenModal(palette, DefaultModalConfig())
+
 	case tea.KeyMsg:
 		keyStr := msg.String()
 
@@ -99,6 +106,19 @@ func (dm *DialogManager) Update(msg tea.Msg) tea.Cmd {
 			}
 		}
 
+	case tea.MouseMsg:
+		// A left click on the backdrop closes the top modal when
+		// CloseOnBackdrop is enabled; clicks on the modal content are
+		// forwarded to it below
+		if len(dm.stack) > 0 &&
+			msg.Action == tea.MouseActionPress &&
+			msg.Button == tea.MouseButtonLeft {
+			topModal := dm.stack[len(dm.stack)-1]
+			if topModal.ShouldCloseOnBackdrop() && !topModal.ContainsPoint(msg.X, msg.Y) {
+				return dm.CloseTop()
+			}
+		}
+
 	case tea.WindowSizeMsg:
 		dm.SetSize(msg.Width, msg.Height)
 		// Propagate resize to all modals
@@ -436,6 +456,56 @@ func (dm *DialogManager) GetShortcutManager() *ShortcutManager {
 	return dm.shortcuts
 }
 
+// EnableCommandPalette registers Ctrl+K to open the command palette and
+// stores the app-provided commands shown in it. Registered shortcuts are
+// added to the palette automatically; see PaletteCommands.
+func (dm *DialogManager) EnableCommandPalette(commands []Command) {
+	dm.appCommands = commands
+	dm.shortcuts.RegisterCommandPalette(nil)
+}
+
+// PaletteCommands returns the current command palette entries: the
+// app-provided commands followed by one entry per registered shortcut
+// (excluding Ctrl+K itself, which opens the palette).
+func (dm *DialogManager) PaletteCommands() []Command {
+	commands := make([]Command, 0, len(dm.appCommands)+dm.shortcuts.GetShortcutCount())
+	commands = append(commands, dm.appCommands...)
+
+	keys := dm.shortcuts.GetShortcutKeys()
+	sort.Strings(keys)
+	for _, key := range keys {
+		if key == "ctrl+k" {
+			continue
+		}
+		handler := dm.shortcuts.GetShortcut(key)
+		commands = append(commands, Command{
+			Label:    shortcutLabel(key),
+			Shortcut: key,
+			Action: func() tea.Msg {
+				return handler()
+			},
+		})
+	}
+
+	return commands
+}
+
+// shortcutLabel returns a palette label for a registered shortcut key
+func shortcutLabel(key string) string {
+	switch key {
+	case "ctrl+p":
+		return "Open file picker"
+	case "ctrl+f":
+		return "Search"
+	case "ctrl+,":
+		return "Open settings"
+	case "f1", "ctrl+?":
+		return "Show help"
+	default:
+		return "Run " + key
+	}
+}
+
 // GetFocusTrap returns the focus trap
 func (dm *DialogManager) GetFocusTrap() *FocusTrap {
 	return dm.focusTrap
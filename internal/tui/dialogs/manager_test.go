@@ -1940,3 +1940,96 @@ func TestSelectDialogClearSearch(t *testing.T) {
 		t.Error("Second ESC should close dialog")
 	}
 }
+
+func TestDialogManagerBackdropClick(t *testing.T) {
+	dm := dialogs.NewDialogManager()
+	dm.SetSize(80, 24)
+
+	dialog := dialogs.NewConfirmDialog(dialogs.ConfirmDialogConfig{
+		ID:          "backdrop-test",
+		Title:       "Test",
+		Description: "Click outside to close",
+	})
+
+	config := dialogs.DefaultModalConfig()
+	config.CloseOnBackdrop = true
+	dm.Update(dialogs.OpenModalMsg{Dialog: dialog, Config: config})
+
+	if dm.GetCount() != 1 {
+		t.Fatalf("Expected 1 dialog, got %d", dm.GetCount())
+	}
+
+	// A click inside the modal bounds does not close it
+	modal := dm.GetTopModal()
+	x, y, width, height := modal.Bounds()
+	dm.Update(tea.MouseMsg{
+		X:      x + width/2,
+		Y:      y + height/2,
+		Action: tea.MouseActionPress,
+		Button: tea.MouseButtonLeft,
+	})
+	if dm.GetCount() != 1 {
+		t.Error("Click inside the modal should not close it")
+	}
+
+	// A click on the backdrop closes it
+	dm.Update(tea.MouseMsg{
+		X:      0,
+		Y:      0,
+		Action: tea.MouseActionPress,
+		Button: tea.MouseButtonLeft,
+	})
+	if dm.GetCount() != 0 {
+		t.Error("Backdrop click should close the modal")
+	}
+}
+
+func TestDialogManagerBackdropClickDisabled(t *testing.T) {
+	dm := dialogs.NewDialogManager()
+	dm.SetSize(80, 24)
+
+	dialog := dialogs.NewConfirmDialog(dialogs.ConfirmDialogConfig{
+		ID:          "backdrop-disabled",
+		Title:       "Test",
+		Description: "Backdrop clicks ignored",
+	})
+
+	// DefaultModalConfig leaves CloseOnBackdrop false
+	dm.Update(dialogs.OpenDialogMsg{Dialog: dialog})
+
+	dm.Update(tea.MouseMsg{
+		X:      0,
+		Y:      0,
+		Action: tea.MouseActionPress,
+		Button: tea.MouseButtonLeft,
+	})
+	if dm.GetCount() != 1 {
+		t.Error("Backdrop click should be ignored when CloseOnBackdrop is false")
+	}
+}
+
+func TestDialogManagerBackdropClickNonPress(t *testing.T) {
+	dm := dialogs.NewDialogManager()
+	dm.SetSize(80, 24)
+
+	dialog := dialogs.NewConfirmDialog(dialogs.ConfirmDialogConfig{
+		ID:          "backdrop-motion",
+		Title:       "Test",
+		Description: "Only presses close",
+	})
+
+	config := dialogs.DefaultModalConfig()
+	config.CloseOnBackdrop = true
+	dm.Update(dialogs.OpenModalMsg{Dialog: dialog, Config: config})
+
+	// Mouse motion over the backdrop does not close the modal
+	dm.Update(tea.MouseMsg{
+		X:      0,
+		Y:      0,
+		Action: tea.MouseActionMotion,
+		Button: tea.MouseButtonNone,
+	})
+	if dm.GetCount() != 1 {
+		t.Error("Mouse motion should not close the modal")
+	}
+}
@@ -233,6 +233,19 @@ func (m *Modal) CalculatePosition(containerWidth, containerHeight int) {
 	}
 }
 
+// Bounds returns the modal's rendered rectangle (x, y, width, height)
+func (m *Modal) Bounds() (int, int, int, int) {
+	view := m.View()
+	return m.x, m.y, lipgloss.Width(view), lipgloss.Height(view)
+}
+
+// ContainsPoint reports whether the screen coordinates fall inside the
+// modal's rendered bounds
+func (m *Modal) ContainsPoint(x, y int) bool {
+	mx, my, width, height := m.Bounds()
+	return x >= mx && x < mx+width && y >= my && y < my+height
+}
+
 // ShouldCloseOnEsc returns true if ESC should close this modal
 func (m *Modal) ShouldCloseOnEsc() bool {
 	return m.config.CloseOnEsc
@@ -0,0 +1,418 @@
+package dialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// MultiSelectDialog represents a list selection dialog allowing multiple
+// choices, with search
+type MultiSelectDialog struct {
+	id           string
+	title        string
+	description  string
+	options      []SelectOption
+	filteredOpts []SelectOption
+	searchInput  textinput.Model
+	selectedIdx  int
+	searchMode   bool
+	selected     map[string]bool // Toggled option values
+	confirmed    bool
+	closing      bool
+	width        int
+	height       int
+	maxVisible   int // Maximum visible items
+}
+
+// MultiSelectDialogConfig contains configuration for a multi-select dialog
+type MultiSelectDialogConfig struct {
+	ID              string
+	Title           string
+	Description     string
+	Options         []SelectOption
+	DefaultSelected []string // Values pre-selected when the dialog opens
+	Searchable      bool     // Enable search mode
+}
+
+// NewMultiSelectDialog creates a new multi-select dialog
+func NewMultiSelectDialog(config MultiSelectDialogConfig) *MultiSelectDialog {
+	if config.ID == "" {
+		config.ID = "multiselect-dialog"
+	}
+
+	// Create search input
+	ti := textinput.New()
+	ti.Placeholder = "Type to search..."
+	ti.CharLimit = 100
+	ti.Width = 36
+
+	// Start in search mode if searchable
+	if config.Searchable {
+		ti.Focus()
+	}
+
+	selected := make(map[string]bool)
+	for _, value := range config.DefaultSelected {
+		selected[value] = true
+	}
+
+	return &MultiSelectDialog{
+		id:           config.ID,
+		title:        config.Title,
+		description:  config.Description,
+		options:      config.Options,
+		filteredOpts: config.Options, // Initially show all
+		searchInput:  ti,
+		selectedIdx:  0,
+		searchMode:   config.Searchable,
+		selected:     selected,
+		confirmed:    false,
+		closing:      false,
+		width:        80,
+		height:       24,
+		maxVisible:   8, // Show max 8 items at a time
+	}
+}
+
+// Init initializes the dialog
+func (d *MultiSelectDialog) Init() tea.Cmd {
+	if d.searchMode {
+		return textinput.Blink
+	}
+	return nil
+}
+
+// Update handles messages
+func (d *MultiSelectDialog) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Keys shared by both modes
+		switch msg.String() {
+		case "enter":
+			// Confirm the current selection
+			d.confirmed = true
+			d.closing = true
+			return d, nil
+
+		case " ":
+			// Toggle the highlighted option
+			d.toggleCurrent()
+			return d, nil
+
+		case "ctrl+a":
+			// Select all options
+			d.selectAll()
+			return d, nil
+
+		case "ctrl+x":
+			// Deselect all options
+			d.selectNone()
+			return d, nil
+
+		case "down", "ctrl+n":
+			// Navigate down in filtered list
+			if len(d.filteredOpts) > 0 {
+				d.selectedIdx = (d.selectedIdx + 1) % len(d.filteredOpts)
+			}
+			return d, nil
+
+		case "up", "ctrl+p":
+			// Navigate up in filtered list
+			if len(d.filteredOpts) > 0 {
+				d.selectedIdx = (d.selectedIdx - 1 + len(d.filteredOpts)) % len(d.filteredOpts)
+			}
+			return d, nil
+		}
+
+		// If in search mode, handle search-specific keys
+		if d.searchMode {
+			switch msg.String() {
+			case "esc":
+				// Exit search mode or cancel dialog
+				if d.searchInput.Value() != "" {
+					// Clear search
+					d.searchInput.SetValue("")
+					d.filteredOpts = d.options
+					d.selectedIdx = 0
+					return d, nil
+				}
+				// Cancel dialog
+				d.confirmed = false
+				d.closing = true
+				return d, nil
+
+			case "tab":
+				// Exit search mode
+				d.searchMode = false
+				d.searchInput.Blur()
+				return d, nil
+
+			default:
+				// Update search input and filter options
+				d.searchInput, cmd = d.searchInput.Update(msg)
+				d.filterOptions()
+				// Reset selection to first item
+				d.selectedIdx = 0
+				return d, cmd
+			}
+		} else {
+			// Not in search mode - handle normal selection keys
+			switch msg.String() {
+			case "esc":
+				// Cancel
+				d.confirmed = false
+				d.closing = true
+				return d, nil
+
+			case "j":
+				// Move down
+				if len(d.filteredOpts) > 0 {
+					d.selectedIdx = (d.selectedIdx + 1) % len(d.filteredOpts)
+				}
+				return d, nil
+
+			case "k":
+				// Move up
+				if len(d.filteredOpts) > 0 {
+					d.selectedIdx = (d.selectedIdx - 1 + len(d.filteredOpts)) % len(d.filteredOpts)
+				}
+				return d, nil
+
+			case "a":
+				// Select all options
+				d.selectAll()
+				return d, nil
+
+			case "x", "n":
+				// Deselect all options
+				d.selectNone()
+				return d, nil
+
+			case "/":
+				// Enter search mode
+				d.searchMode = true
+				d.searchInput.Focus()
+				return d, textinput.Blink
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// toggleCurrent toggles the highlighted option's selected state
+func (d *MultiSelectDialog) toggleCurrent() {
+	if len(d.filteredOpts) == 0 || d.selectedIdx >= len(d.filteredOpts) {
+		return
+	}
+
+	value := d.filteredOpts[d.selectedIdx].Value
+	if d.selected[value] {
+		delete(d.selected, value)
+	} else {
+		d.selected[value] = true
+	}
+}
+
+// selectAll marks every option as selected
+func (d *MultiSelectDialog) selectAll() {
+	for _, opt := range d.options {
+		d.selected[opt.Value] = true
+	}
+}
+
+// selectNone clears all selections
+func (d *MultiSelectDialog) selectNone() {
+	d.selected = make(map[string]bool)
+}
+
+// filterOptions filters options based on search query
+func (d *MultiSelectDialog) filterOptions() {
+	query := strings.ToLower(strings.TrimSpace(d.searchInput.Value()))
+	if query == "" {
+		d.filteredOpts = d.options
+		return
+	}
+
+	filtered := make([]SelectOption, 0)
+	for _, opt := range d.options {
+		// Search in both label and description
+		if strings.Contains(strings.ToLower(opt.Label), query) ||
+			strings.Contains(strings.ToLower(opt.Description), query) {
+			filtered = append(filtered, opt)
+		}
+	}
+	d.filteredOpts = filtered
+}
+
+// View renders the dialog
+func (d *MultiSelectDialog) View() string {
+	var content strings.Builder
+
+	// Title
+	content.WriteString(DialogTitleStyle.Render(d.title))
+	content.WriteString("\n\n")
+
+	// Description
+	if d.description != "" {
+		desc := DialogDescriptionStyle.Width(40).Render(d.description)
+		content.WriteString(desc)
+		content.WriteString("\n\n")
+	}
+
+	// Search box (if in search mode)
+	if d.searchMode {
+		searchBox := d.searchInput.View()
+		searchStyled := InputFieldFocusedStyle.Render(searchBox)
+		content.WriteString(searchStyled)
+		content.WriteString("\n\n")
+	}
+
+	// Options list
+	if len(d.filteredOpts) == 0 {
+		noResults := ErrorTextStyle.Width(40).Render("No matching options")
+		content.WriteString(noResults)
+		content.WriteString("\n")
+	} else {
+		// Calculate visible range
+		startIdx := 0
+		endIdx := len(d.filteredOpts)
+		if len(d.filteredOpts) > d.maxVisible {
+			// Center the selected item
+			halfVisible := d.maxVisible / 2
+			startIdx = d.selectedIdx - halfVisible
+			if startIdx < 0 {
+				startIdx = 0
+			}
+			endIdx = startIdx + d.maxVisible
+			if endIdx > len(d.filteredOpts) {
+				endIdx = len(d.filteredOpts)
+				startIdx = endIdx - d.maxVisible
+				if startIdx < 0 {
+					startIdx = 0
+				}
+			}
+		}
+
+		// Show scroll indicator if needed
+		if startIdx > 0 {
+			scrollUp := HelpTextStyle.Render("  ▲ More above")
+			content.WriteString(scrollUp)
+			content.WriteString("\n")
+		}
+
+		// Render visible options
+		for i := startIdx; i < endIdx; i++ {
+			opt := d.filteredOpts[i]
+			prefix := "  "
+			if i == d.selectedIdx {
+				prefix = "▶ "
+			}
+
+			checkbox := "[ ] "
+			if d.selected[opt.Value] {
+				checkbox = "[x] "
+			}
+
+			var optText string
+			if opt.Description != "" {
+				optText = opt.Label + " - " + opt.Description
+			} else {
+				optText = opt.Label
+			}
+
+			// Truncate if too long
+			maxLen := 34
+			if len(optText) > maxLen {
+				optText = optText[:maxLen-3] + "..."
+			}
+
+			if i == d.selectedIdx {
+				line := ListItemSelectedStyle.Width(40).Render(prefix + checkbox + optText)
+				content.WriteString(line)
+			} else {
+				line := ListItemStyle.Width(40).Render(prefix + checkbox + optText)
+				content.WriteString(line)
+			}
+			content.WriteString("\n")
+		}
+
+		// Show scroll indicator if needed
+		if endIdx < len(d.filteredOpts) {
+			scrollDown := HelpTextStyle.Render("  ▼ More below")
+			content.WriteString(scrollDown)
+			content.WriteString("\n")
+		}
+	}
+
+	// Selected count
+	count := HelpTextStyle.Render(fmt.Sprintf("%d of %d selected", len(d.selected), len(d.options)))
+	content.WriteString("\n")
+	content.WriteString(count)
+
+	// Help text
+	var helpText string
+	if d.searchMode {
+		helpText = "↑↓ navigate • Space toggle • Enter confirm • Tab exit search • ESC cancel"
+	} else {
+		helpText = "↑↓ / jk navigate • Space toggle • a all • x none • Enter confirm • ESC cancel"
+	}
+	help := HelpTextStyle.Width(44).Render(helpText)
+	content.WriteString("\n")
+	content.WriteString(help)
+
+	// Wrap in dialog box
+	return RenderDialogBox(content.String(), 48)
+}
+
+// ID returns the dialog ID
+func (d *MultiSelectDialog) ID() string {
+	return d.id
+}
+
+// SetSize updates the dialog dimensions
+func (d *MultiSelectDialog) SetSize(width, height int) {
+	d.width = width
+	d.height = height
+	// Adjust max visible based on height
+	d.maxVisible = (height / 3) - 4
+	if d.maxVisible < 3 {
+		d.maxVisible = 3
+	}
+}
+
+// IsClosing returns true if the dialog is requesting to be closed
+func (d *MultiSelectDialog) IsClosing() bool {
+	return d.closing
+}
+
+// Result returns the dialog result
+func (d *MultiSelectDialog) Result() interface{} {
+	return d.GetResults()
+}
+
+// GetResults returns the chosen values in option order, or nil if the
+// dialog was cancelled
+func (d *MultiSelectDialog) GetResults() []string {
+	if !d.confirmed {
+		return nil
+	}
+
+	results := make([]string, 0, len(d.selected))
+	for _, opt := range d.options {
+		if d.selected[opt.Value] {
+			results = append(results, opt.Value)
+		}
+	}
+	return results
+}
+
+// SelectedCount returns how many options are currently toggled on
+func (d *MultiSelectDialog) SelectedCount() int {
+	return len(d.selected)
+}
@@ -0,0 +1,240 @@
+package dialogs_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AINative-studio/ainative-code/internal/tui/dialogs"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func multiSelectOptions() []dialogs.SelectOption {
+	return []dialogs.SelectOption{
+		{Label: "Server 1", Value: "srv1", Description: "First server"},
+		{Label: "Server 2", Value: "srv2", Description: "Second server"},
+		{Label: "Server 3", Value: "srv3", Description: "Third server"},
+	}
+}
+
+func TestNewMultiSelectDialog(t *testing.T) {
+	dialog := dialogs.NewMultiSelectDialog(dialogs.MultiSelectDialogConfig{
+		ID:          "test",
+		Title:       "Enable Servers",
+		Description: "Choose servers",
+		Options:     multiSelectOptions(),
+	})
+
+	if dialog.ID() != "test" {
+		t.Errorf("Expected ID 'test', got '%s'", dialog.ID())
+	}
+
+	if dialog.IsClosing() {
+		t.Error("New dialog should not be closing")
+	}
+
+	if dialog.SelectedCount() != 0 {
+		t.Errorf("Expected no selections, got %d", dialog.SelectedCount())
+	}
+}
+
+func TestMultiSelectDialogDefaults(t *testing.T) {
+	dialog := dialogs.NewMultiSelectDialog(dialogs.MultiSelectDialogConfig{
+		Title:           "Test",
+		Options:         multiSelectOptions(),
+		DefaultSelected: []string{"srv1", "srv3"},
+	})
+
+	if dialog.ID() != "multiselect-dialog" {
+		t.Errorf("Expected default ID, got '%s'", dialog.ID())
+	}
+
+	if dialog.SelectedCount() != 2 {
+		t.Errorf("Expected 2 pre-selected options, got %d", dialog.SelectedCount())
+	}
+}
+
+func TestMultiSelectDialogToggle(t *testing.T) {
+	dialog := dialogs.NewMultiSelectDialog(dialogs.MultiSelectDialogConfig{
+		ID:      "test",
+		Title:   "Test",
+		Options: multiSelectOptions(),
+	})
+
+	// Toggle the first option on
+	dialog.Update(tea.KeyMsg{Type: tea.KeySpace})
+	if dialog.SelectedCount() != 1 {
+		t.Errorf("Expected 1 selection after toggle, got %d", dialog.SelectedCount())
+	}
+
+	// Toggle it back off
+	dialog.Update(tea.KeyMsg{Type: tea.KeySpace})
+	if dialog.SelectedCount() != 0 {
+		t.Errorf("Expected 0 selections after re-toggle, got %d", dialog.SelectedCount())
+	}
+}
+
+func TestMultiSelectDialogNavigation(t *testing.T) {
+	dialog := dialogs.NewMultiSelectDialog(dialogs.MultiSelectDialogConfig{
+		ID:      "test",
+		Title:   "Test",
+		Options: multiSelectOptions(),
+	})
+
+	// Navigate and toggle the second and third options
+	dialog.Update(tea.KeyMsg{Type: tea.KeyDown})
+	dialog.Update(tea.KeyMsg{Type: tea.KeySpace})
+	dialog.Update(tea.KeyMsg{Type: tea.KeyDown})
+	dialog.Update(tea.KeyMsg{Type: tea.KeySpace})
+
+	if dialog.IsClosing() {
+		t.Error("Dialog should not be closing after navigation")
+	}
+
+	// Confirm
+	dialog.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !dialog.IsClosing() {
+		t.Error("Dialog should be closing after Enter")
+	}
+
+	results := dialog.GetResults()
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(results))
+	}
+	if results[0] != "srv2" || results[1] != "srv3" {
+		t.Errorf("Expected results in option order [srv2 srv3], got %v", results)
+	}
+}
+
+func TestMultiSelectDialogVimKeys(t *testing.T) {
+	dialog := dialogs.NewMultiSelectDialog(dialogs.MultiSelectDialogConfig{
+		ID:      "test",
+		Title:   "Test",
+		Options: multiSelectOptions(),
+	})
+
+	// j moves down, k moves up
+	dialog.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	dialog.Update(tea.KeyMsg{Type: tea.KeySpace})
+	dialog.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'k'}})
+	dialog.Update(tea.KeyMsg{Type: tea.KeySpace})
+	dialog.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	results := dialog.GetResults()
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(results))
+	}
+	if results[0] != "srv1" || results[1] != "srv2" {
+		t.Errorf("Expected [srv1 srv2], got %v", results)
+	}
+}
+
+func TestMultiSelectDialogSelectAllNone(t *testing.T) {
+	dialog := dialogs.NewMultiSelectDialog(dialogs.MultiSelectDialogConfig{
+		ID:      "test",
+		Title:   "Test",
+		Options: multiSelectOptions(),
+	})
+
+	// Select all with 'a'
+	dialog.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	if dialog.SelectedCount() != 3 {
+		t.Errorf("Expected all 3 selected, got %d", dialog.SelectedCount())
+	}
+
+	// Deselect all with 'x'
+	dialog.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	if dialog.SelectedCount() != 0 {
+		t.Errorf("Expected 0 selected, got %d", dialog.SelectedCount())
+	}
+
+	// ctrl+a also selects all
+	dialog.Update(tea.KeyMsg{Type: tea.KeyCtrlA})
+	if dialog.SelectedCount() != 3 {
+		t.Errorf("Expected all 3 selected via ctrl+a, got %d", dialog.SelectedCount())
+	}
+}
+
+func TestMultiSelectDialogCancel(t *testing.T) {
+	dialog := dialogs.NewMultiSelectDialog(dialogs.MultiSelectDialogConfig{
+		ID:      "test",
+		Title:   "Test",
+		Options: multiSelectOptions(),
+	})
+
+	// Toggle one option, then cancel
+	dialog.Update(tea.KeyMsg{Type: tea.KeySpace})
+	dialog.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if !dialog.IsClosing() {
+		t.Error("Dialog should be closing after ESC")
+	}
+
+	if dialog.GetResults() != nil {
+		t.Error("Results should be nil after cancel")
+	}
+}
+
+func TestMultiSelectDialogSearch(t *testing.T) {
+	dialog := dialogs.NewMultiSelectDialog(dialogs.MultiSelectDialogConfig{
+		ID:         "test",
+		Title:      "Test",
+		Options:    multiSelectOptions(),
+		Searchable: true,
+	})
+
+	// Type to filter down to the second server
+	dialog.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
+
+	// Toggle the filtered option and confirm
+	dialog.Update(tea.KeyMsg{Type: tea.KeySpace})
+	dialog.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	results := dialog.GetResults()
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 result, got %d", len(results))
+	}
+	if results[0] != "srv2" {
+		t.Errorf("Expected 'srv2', got '%s'", results[0])
+	}
+}
+
+func TestMultiSelectDialogView(t *testing.T) {
+	dialog := dialogs.NewMultiSelectDialog(dialogs.MultiSelectDialogConfig{
+		ID:              "test",
+		Title:           "Enable Servers",
+		Options:         multiSelectOptions(),
+		DefaultSelected: []string{"srv1"},
+	})
+
+	view := dialog.View()
+
+	if !strings.Contains(view, "Enable Servers") {
+		t.Error("View should contain the title")
+	}
+	if !strings.Contains(view, "[x]") {
+		t.Error("View should render a checked checkbox")
+	}
+	if !strings.Contains(view, "[ ]") {
+		t.Error("View should render unchecked checkboxes")
+	}
+	if !strings.Contains(view, "1 of 3 selected") {
+		t.Error("View should show the selected count")
+	}
+}
+
+func TestMultiSelectDialogEmptyOptions(t *testing.T) {
+	dialog := dialogs.NewMultiSelectDialog(dialogs.MultiSelectDialogConfig{
+		ID:      "test",
+		Title:   "Test",
+		Options: []dialogs.SelectOption{},
+	})
+
+	// Toggling with no options should not panic
+	dialog.Update(tea.KeyMsg{Type: tea.KeySpace})
+	dialog.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	results := dialog.GetResults()
+	if len(results) != 0 {
+		t.Errorf("Expected no results, got %v", results)
+	}
+}
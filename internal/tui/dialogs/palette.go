@@ -0,0 +1,307 @@
+package dialogs
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Command represents an entry in the command palette
+type Command struct {
+	Label    string  // Human-readable command name
+	Shortcut string  // Optional shortcut hint shown next to the label
+	Action   tea.Cmd // Executed when the command is selected
+}
+
+// CommandPalette is a searchable, fuzzy-filtered list of commands.
+// It is opened via Ctrl+K (see ShortcutManager.RegisterCommandPalette)
+// and executes the selected command's action on Enter.
+type CommandPalette struct {
+	id          string
+	commands    []Command
+	filtered    []Command
+	searchInput textinput.Model
+	selectedIdx int
+	result      *Command
+	closing     bool
+	width       int
+	height      int
+	maxVisible  int // Maximum visible items
+}
+
+// NewCommandPalette creates a command palette over the given commands
+func NewCommandPalette(commands []Command) *CommandPalette {
+	ti := textinput.New()
+	ti.Placeholder = "Type a command..."
+	ti.CharLimit = 100
+	ti.Width = 36
+	ti.Focus()
+
+	return &CommandPalette{
+		id:          "command-palette",
+		commands:    commands,
+		filtered:    commands, // Initially show all
+		searchInput: ti,
+		selectedIdx: 0,
+		result:      nil,
+		closing:     false,
+		width:       80,
+		height:      24,
+		maxVisible:  8, // Show max 8 items at a time
+	}
+}
+
+// Init initializes the palette
+func (p *CommandPalette) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update handles messages
+func (p *CommandPalette) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "enter":
+			// Execute the selected command's action
+			if len(p.filtered) > 0 && p.selectedIdx < len(p.filtered) {
+				selected := p.filtered[p.selectedIdx]
+				p.result = &selected
+				p.closing = true
+				return p, selected.Action
+			}
+			return p, nil
+
+		case "esc":
+			// Clear search first, then cancel
+			if p.searchInput.Value() != "" {
+				p.searchInput.SetValue("")
+				p.filtered = p.commands
+				p.selectedIdx = 0
+				return p, nil
+			}
+			p.result = nil
+			p.closing = true
+			return p, nil
+
+		case "down", "ctrl+n":
+			// Navigate down with wrap-around
+			if len(p.filtered) > 0 {
+				p.selectedIdx = (p.selectedIdx + 1) % len(p.filtered)
+			}
+			return p, nil
+
+		case "up", "ctrl+p":
+			// Navigate up with wrap-around
+			if len(p.filtered) > 0 {
+				p.selectedIdx = (p.selectedIdx - 1 + len(p.filtered)) % len(p.filtered)
+			}
+			return p, nil
+
+		default:
+			// Update search input and re-filter
+			p.searchInput, cmd = p.searchInput.Update(msg)
+			p.filterCommands()
+			// Reset selection to best match
+			p.selectedIdx = 0
+			return p, cmd
+		}
+	}
+
+	return p, nil
+}
+
+// filterCommands fuzzy-filters commands against the search query,
+// best matches first
+func (p *CommandPalette) filterCommands() {
+	query := strings.TrimSpace(p.searchInput.Value())
+	if query == "" {
+		p.filtered = p.commands
+		return
+	}
+
+	filtered := make([]Command, 0)
+	for _, c := range p.commands {
+		if FuzzyMatch(query, c.Label) {
+			filtered = append(filtered, c)
+		}
+	}
+
+	// Rank prefix matches above substring matches above subsequence matches
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return fuzzyScore(query, filtered[i].Label) > fuzzyScore(query, filtered[j].Label)
+	})
+
+	p.filtered = filtered
+}
+
+// FuzzyMatch reports whether query matches candidate as a case-insensitive
+// subsequence: every query character must appear in candidate, in order,
+// but not necessarily adjacent. An empty query matches everything.
+func FuzzyMatch(query, candidate string) bool {
+	q := []rune(strings.ToLower(query))
+
+	i := 0
+	for _, r := range strings.ToLower(candidate) {
+		if i >= len(q) {
+			break
+		}
+		if r == q[i] {
+			i++
+		}
+	}
+	return i >= len(q)
+}
+
+// fuzzyScore ranks how well query matches candidate; higher is better
+func fuzzyScore(query, candidate string) int {
+	q := strings.ToLower(query)
+	c := strings.ToLower(candidate)
+
+	switch {
+	case c == q:
+		return 3
+	case strings.HasPrefix(c, q):
+		return 2
+	case strings.Contains(c, q):
+		return 1
+	default:
+		return 0 // Subsequence match only
+	}
+}
+
+// View renders the palette
+func (p *CommandPalette) View() string {
+	var content strings.Builder
+
+	// Title
+	content.WriteString(DialogTitleStyle.Render("Command Palette"))
+	content.WriteString("\n\n")
+
+	// Search box
+	searchStyled := InputFieldFocusedStyle.Render(p.searchInput.View())
+	content.WriteString(searchStyled)
+	content.WriteString("\n\n")
+
+	// Command list
+	if len(p.filtered) == 0 {
+		noResults := ErrorTextStyle.Width(40).Render("No matching commands")
+		content.WriteString(noResults)
+		content.WriteString("\n")
+	} else {
+		// Calculate visible range, centering the selected item
+		startIdx := 0
+		endIdx := len(p.filtered)
+		if len(p.filtered) > p.maxVisible {
+			halfVisible := p.maxVisible / 2
+			startIdx = p.selectedIdx - halfVisible
+			if startIdx < 0 {
+				startIdx = 0
+			}
+			endIdx = startIdx + p.maxVisible
+			if endIdx > len(p.filtered) {
+				endIdx = len(p.filtered)
+				startIdx = endIdx - p.maxVisible
+				if startIdx < 0 {
+					startIdx = 0
+				}
+			}
+		}
+
+		// Show scroll indicator if needed
+		if startIdx > 0 {
+			content.WriteString(HelpTextStyle.Render("  ▲ More above"))
+			content.WriteString("\n")
+		}
+
+		// Render visible commands with shortcut hints right-aligned
+		for i := startIdx; i < endIdx; i++ {
+			c := p.filtered[i]
+			prefix := "  "
+			if i == p.selectedIdx {
+				prefix = "▶ "
+			}
+
+			line := prefix + c.Label
+			if c.Shortcut != "" {
+				// Right-align the shortcut hint within the item width
+				pad := 38 - len(line) - len(c.Shortcut)
+				if pad < 1 {
+					pad = 1
+				}
+				line += strings.Repeat(" ", pad) + c.Shortcut
+			}
+
+			// Truncate if too long
+			maxLen := 38
+			if len(line) > maxLen {
+				line = line[:maxLen-3] + "..."
+			}
+
+			if i == p.selectedIdx {
+				content.WriteString(ListItemSelectedStyle.Width(40).Render(line))
+			} else {
+				content.WriteString(ListItemStyle.Width(40).Render(line))
+			}
+			content.WriteString("\n")
+		}
+
+		// Show scroll indicator if needed
+		if endIdx < len(p.filtered) {
+			content.WriteString(HelpTextStyle.Render("  ▼ More below"))
+			content.WriteString("\n")
+		}
+	}
+
+	// Help text
+	help := HelpTextStyle.Width(44).Render("↑↓ navigate • Enter run • ESC close")
+	content.WriteString("\n")
+	content.WriteString(help)
+
+	// Wrap in dialog box
+	return RenderDialogBox(content.String(), 48)
+}
+
+// ID returns the dialog ID
+func (p *CommandPalette) ID() string {
+	return p.id
+}
+
+// SetSize updates the palette dimensions
+func (p *CommandPalette) SetSize(width, height int) {
+	p.width = width
+	p.height = height
+	// Adjust max visible based on height
+	p.maxVisible = (height / 3) - 4
+	if p.maxVisible < 3 {
+		p.maxVisible = 3
+	}
+}
+
+// IsClosing returns true if the palette is requesting to be closed
+func (p *CommandPalette) IsClosing() bool {
+	return p.closing
+}
+
+// Result returns the selected command (nil if cancelled)
+func (p *CommandPalette) Result() interface{} {
+	return p.result
+}
+
+// GetSelected returns the executed command (convenience method)
+func (p *CommandPalette) GetSelected() *Command {
+	return p.result
+}
+
+// FilteredCommands returns the commands matching the current query
+func (p *CommandPalette) FilteredCommands() []Command {
+	return p.filtered
+}
+
+// SelectedIndex returns the index of the highlighted command
+func (p *CommandPalette) SelectedIndex() int {
+	return p.selectedIdx
+}
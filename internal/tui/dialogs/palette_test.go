@@ -0,0 +1,232 @@
+package dialogs_test
+
+import (
+	"testing"
+
+	"github.com/AINative-studio/ainative-code/internal/tui/dialogs"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type paletteActionMsg struct {
+	name string
+}
+
+func paletteCommands() []dialogs.Command {
+	return []dialogs.Command{
+		{Label: "Open file picker", Shortcut: "ctrl+p", Action: func() tea.Msg { return paletteActionMsg{"file-picker"} }},
+		{Label: "Search", Shortcut: "ctrl+f", Action: func() tea.Msg { return paletteActionMsg{"search"} }},
+		{Label: "Open settings", Shortcut: "ctrl+,", Action: func() tea.Msg { return paletteActionMsg{"settings"} }},
+		{Label: "Show help", Shortcut: "f1", Action: func() tea.Msg { return paletteActionMsg{"help"} }},
+	}
+}
+
+func typeIntoPalette(palette *dialogs.CommandPalette, text string) *dialogs.CommandPalette {
+	for _, r := range text {
+		model, _ := palette.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		palette = model.(*dialogs.CommandPalette)
+	}
+	return palette
+}
+
+func TestNewCommandPalette(t *testing.T) {
+	palette := dialogs.NewCommandPalette(paletteCommands())
+
+	if palette == nil {
+		t.Fatal("NewCommandPalette returned nil")
+	}
+
+	if palette.ID() != "command-palette" {
+		t.Errorf("Expected ID 'command-palette', got '%s'", palette.ID())
+	}
+
+	if palette.IsClosing() {
+		t.Error("New palette should not be closing")
+	}
+
+	if len(palette.FilteredCommands()) != 4 {
+		t.Errorf("Expected 4 commands initially, got %d", len(palette.FilteredCommands()))
+	}
+
+	view := palette.View()
+	if view == "" {
+		t.Error("View should not be empty")
+	}
+}
+
+func TestFuzzyMatch(t *testing.T) {
+	tests := []struct {
+		query     string
+		candidate string
+		want      bool
+	}{
+		{"", "anything", true},
+		{"search", "Search", true},
+		{"ofp", "Open file picker", true},
+		{"picker", "Open file picker", true},
+		{"OPEN", "open settings", true},
+		{"xyz", "Open file picker", false},
+		{"reckip", "Open file picker", false}, // Out of order
+	}
+
+	for _, tt := range tests {
+		got := dialogs.FuzzyMatch(tt.query, tt.candidate)
+		if got != tt.want {
+			t.Errorf("FuzzyMatch(%q, %q) = %v, want %v", tt.query, tt.candidate, got, tt.want)
+		}
+	}
+}
+
+func TestCommandPaletteFiltering(t *testing.T) {
+	palette := dialogs.NewCommandPalette(paletteCommands())
+
+	palette = typeIntoPalette(palette, "open")
+
+	filtered := palette.FilteredCommands()
+	if len(filtered) != 2 {
+		t.Fatalf("Expected 2 matches for 'open', got %d", len(filtered))
+	}
+
+	// Prefix matches should rank first
+	if filtered[0].Label != "Open file picker" && filtered[0].Label != "Open settings" {
+		t.Errorf("Expected an 'Open ...' command first, got '%s'", filtered[0].Label)
+	}
+
+	// No matches
+	palette = dialogs.NewCommandPalette(paletteCommands())
+	palette = typeIntoPalette(palette, "zzz")
+	if len(palette.FilteredCommands()) != 0 {
+		t.Errorf("Expected no matches for 'zzz', got %d", len(palette.FilteredCommands()))
+	}
+}
+
+func TestCommandPaletteNavigation(t *testing.T) {
+	palette := dialogs.NewCommandPalette(paletteCommands())
+
+	if palette.SelectedIndex() != 0 {
+		t.Errorf("Expected initial selection 0, got %d", palette.SelectedIndex())
+	}
+
+	// Move down twice
+	model, _ := palette.Update(tea.KeyMsg{Type: tea.KeyDown})
+	palette = model.(*dialogs.CommandPalette)
+	model, _ = palette.Update(tea.KeyMsg{Type: tea.KeyDown})
+	palette = model.(*dialogs.CommandPalette)
+
+	if palette.SelectedIndex() != 2 {
+		t.Errorf("Expected selection 2 after two downs, got %d", palette.SelectedIndex())
+	}
+
+	// Move up once
+	model, _ = palette.Update(tea.KeyMsg{Type: tea.KeyUp})
+	palette = model.(*dialogs.CommandPalette)
+
+	if palette.SelectedIndex() != 1 {
+		t.Errorf("Expected selection 1 after up, got %d", palette.SelectedIndex())
+	}
+
+	// Wrap around upward
+	model, _ = palette.Update(tea.KeyMsg{Type: tea.KeyUp})
+	palette = model.(*dialogs.CommandPalette)
+	model, _ = palette.Update(tea.KeyMsg{Type: tea.KeyUp})
+	palette = model.(*dialogs.CommandPalette)
+
+	if palette.SelectedIndex() != 3 {
+		t.Errorf("Expected wrap-around to 3, got %d", palette.SelectedIndex())
+	}
+}
+
+func TestCommandPaletteExecutesActionOnEnter(t *testing.T) {
+	palette := dialogs.NewCommandPalette(paletteCommands())
+
+	// Filter down to the search command and run it
+	palette = typeIntoPalette(palette, "search")
+
+	model, cmd := palette.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	palette = model.(*dialogs.CommandPalette)
+
+	if !palette.IsClosing() {
+		t.Error("Palette should close after Enter")
+	}
+
+	selected := palette.GetSelected()
+	if selected == nil || selected.Label != "Search" {
+		t.Fatalf("Expected 'Search' to be selected, got %v", selected)
+	}
+
+	if cmd == nil {
+		t.Fatal("Enter should return the command's action")
+	}
+	msg := cmd()
+	action, ok := msg.(paletteActionMsg)
+	if !ok || action.name != "search" {
+		t.Errorf("Expected paletteActionMsg{search}, got %v", msg)
+	}
+}
+
+func TestCommandPaletteEscape(t *testing.T) {
+	palette := dialogs.NewCommandPalette(paletteCommands())
+	palette = typeIntoPalette(palette, "open")
+
+	// First ESC clears the query
+	model, _ := palette.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	palette = model.(*dialogs.CommandPalette)
+
+	if palette.IsClosing() {
+		t.Error("First ESC should clear the query, not close")
+	}
+	if len(palette.FilteredCommands()) != 4 {
+		t.Errorf("Expected all commands after clearing, got %d", len(palette.FilteredCommands()))
+	}
+
+	// Second ESC closes without a result
+	model, _ = palette.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	palette = model.(*dialogs.CommandPalette)
+
+	if !palette.IsClosing() {
+		t.Error("Second ESC should close the palette")
+	}
+	if palette.GetSelected() != nil {
+		t.Error("Cancelled palette should have no selected command")
+	}
+}
+
+func TestDialogManagerCommandPalette(t *testing.T) {
+	dm := dialogs.NewDialogManager()
+	dm.GetShortcutManager().RegisterFilePicker(nil)
+
+	appCmd := dialogs.Command{Label: "Toggle theme", Action: func() tea.Msg { return paletteActionMsg{"theme"} }}
+	dm.EnableCommandPalette([]dialogs.Command{appCmd})
+
+	if !dm.GetShortcutManager().HasShortcut("ctrl+k") {
+		t.Error("EnableCommandPalette should register ctrl+k")
+	}
+
+	commands := dm.PaletteCommands()
+	if len(commands) != 2 {
+		t.Fatalf("Expected 2 palette commands (app + file picker), got %d", len(commands))
+	}
+	if commands[0].Label != "Toggle theme" {
+		t.Errorf("Expected app command first, got '%s'", commands[0].Label)
+	}
+	if commands[1].Shortcut != "ctrl+p" {
+		t.Errorf("Expected ctrl+p shortcut entry, got '%s'", commands[1].Shortcut)
+	}
+
+	// Ctrl+K should yield a CommandPaletteMsg which opens the palette
+	cmd := dm.Update(tea.KeyMsg{Type: tea.KeyCtrlK})
+	if cmd == nil {
+		t.Fatal("ctrl+k should produce a command")
+	}
+	msg := cmd()
+	if _, ok := msg.(dialogs.CommandPaletteMsg); !ok {
+		t.Fatalf("Expected CommandPaletteMsg, got %T", msg)
+	}
+
+	dm.Update(msg)
+	if !dm.HasDialogs() {
+		t.Error("CommandPaletteMsg should open the palette dialog")
+	}
+	if dm.GetTop().ID() != "command-palette" {
+		t.Errorf("Expected top dialog 'command-palette', got '%s'", dm.GetTop().ID())
+	}
+}
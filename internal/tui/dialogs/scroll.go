@@ -0,0 +1,203 @@
+package dialogs
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ScrollDialog renders arbitrary multi-line content in a scrollable
+// viewport, for long text like stack traces or diffs that would be
+// truncated by other dialogs
+type ScrollDialog struct {
+	id         string
+	title      string
+	lines      []string
+	offset     int // Index of the first visible line
+	viewHeight int // Number of visible content lines
+	closing    bool
+	width      int
+	height     int
+}
+
+// ScrollDialogConfig contains configuration for a scroll dialog
+type ScrollDialogConfig struct {
+	ID      string
+	Title   string
+	Content string // Multi-line content to display
+}
+
+// NewScrollDialog creates a new scrollable content dialog
+func NewScrollDialog(config ScrollDialogConfig) *ScrollDialog {
+	if config.ID == "" {
+		config.ID = "scroll-dialog"
+	}
+
+	return &ScrollDialog{
+		id:         config.ID,
+		title:      config.Title,
+		lines:      strings.Split(config.Content, "\n"),
+		offset:     0,
+		viewHeight: 12,
+		closing:    false,
+		width:      80,
+		height:     24,
+	}
+}
+
+// Init initializes the dialog
+func (d *ScrollDialog) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages
+func (d *ScrollDialog) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			d.scrollBy(-1)
+
+		case "down", "j":
+			d.scrollBy(1)
+
+		case "pgup", "b":
+			d.scrollBy(-d.viewHeight)
+
+		case "pgdown", "f", " ":
+			d.scrollBy(d.viewHeight)
+
+		case "home", "g":
+			d.offset = 0
+
+		case "end", "G":
+			d.offset = d.maxOffset()
+
+		case "esc", "enter", "q":
+			d.closing = true
+		}
+	}
+
+	return d, nil
+}
+
+// scrollBy moves the viewport by delta lines, clamping at the bounds
+func (d *ScrollDialog) scrollBy(delta int) {
+	d.offset += delta
+	if d.offset < 0 {
+		d.offset = 0
+	}
+	if max := d.maxOffset(); d.offset > max {
+		d.offset = max
+	}
+}
+
+// maxOffset returns the largest valid scroll offset
+func (d *ScrollDialog) maxOffset() int {
+	max := len(d.lines) - d.viewHeight
+	if max < 0 {
+		return 0
+	}
+	return max
+}
+
+// ScrollOffset returns the index of the first visible line
+func (d *ScrollDialog) ScrollOffset() int {
+	return d.offset
+}
+
+// AtBottom returns true if the viewport shows the last line
+func (d *ScrollDialog) AtBottom() bool {
+	return d.offset >= d.maxOffset()
+}
+
+// View renders the dialog
+func (d *ScrollDialog) View() string {
+	var content strings.Builder
+
+	// Title
+	content.WriteString(DialogTitleStyle.Render(d.title))
+	content.WriteString("\n\n")
+
+	// Visible content window with a scrollbar column
+	textWidth := 50
+	end := d.offset + d.viewHeight
+	if end > len(d.lines) {
+		end = len(d.lines)
+	}
+
+	for i := d.offset; i < end; i++ {
+		line := d.lines[i]
+		if len(line) > textWidth {
+			line = line[:textWidth-3] + "..."
+		}
+		// Pad so the scrollbar column lines up
+		line += strings.Repeat(" ", textWidth-len(line))
+		content.WriteString(line)
+		content.WriteString(d.scrollbarChar(i))
+		content.WriteString("\n")
+	}
+
+	// Help text
+	helpText := HelpTextStyle.Width(textWidth).Render("↑↓ scroll • PgUp/PgDn page • g/G top/bottom • ESC close")
+	content.WriteString("\n")
+	content.WriteString(helpText)
+
+	// Wrap in dialog box
+	return RenderDialogBox(content.String(), textWidth+6)
+}
+
+// scrollbarChar returns the scrollbar cell for the given content line,
+// drawing a thumb proportional to the visible fraction
+func (d *ScrollDialog) scrollbarChar(lineIdx int) string {
+	if len(d.lines) <= d.viewHeight {
+		return " " // Everything fits, no scrollbar
+	}
+
+	// Thumb size proportional to visible fraction, at least one cell
+	thumbSize := d.viewHeight * d.viewHeight / len(d.lines)
+	if thumbSize < 1 {
+		thumbSize = 1
+	}
+
+	// Thumb position proportional to scroll progress
+	thumbStart := d.offset * (d.viewHeight - thumbSize) / d.maxOffset()
+
+	row := lineIdx - d.offset
+	if row >= thumbStart && row < thumbStart+thumbSize {
+		return "█"
+	}
+	return "░"
+}
+
+// ID returns the dialog ID
+func (d *ScrollDialog) ID() string {
+	return d.id
+}
+
+// SetSize updates the dialog dimensions
+func (d *ScrollDialog) SetSize(width, height int) {
+	d.width = width
+	d.height = height
+
+	// Leave room for the title, help text, and dialog chrome
+	d.viewHeight = height - 8
+	if d.viewHeight < 3 {
+		d.viewHeight = 3
+	}
+
+	// Keep the offset valid for the new window size
+	if d.offset > d.maxOffset() {
+		d.offset = d.maxOffset()
+	}
+}
+
+// IsClosing returns true if the dialog is requesting to be closed
+func (d *ScrollDialog) IsClosing() bool {
+	return d.closing
+}
+
+// Result returns the dialog result
+func (d *ScrollDialog) Result() interface{} {
+	return nil
+}
@@ -0,0 +1,183 @@
+package dialogs_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/AINative-studio/ainative-code/internal/tui/dialogs"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func longContent(lines int) string {
+	var sb strings.Builder
+	for i := 0; i < lines; i++ {
+		fmt.Fprintf(&sb, "line %d\n", i)
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func TestNewScrollDialog(t *testing.T) {
+	dialog := dialogs.NewScrollDialog(dialogs.ScrollDialogConfig{
+		ID:      "test",
+		Title:   "Stack Trace",
+		Content: longContent(5),
+	})
+
+	if dialog.ID() != "test" {
+		t.Errorf("Expected ID 'test', got '%s'", dialog.ID())
+	}
+	if dialog.IsClosing() {
+		t.Error("New dialog should not be closing")
+	}
+	if dialog.ScrollOffset() != 0 {
+		t.Errorf("Expected offset 0, got %d", dialog.ScrollOffset())
+	}
+}
+
+func TestScrollDialogScrolling(t *testing.T) {
+	dialog := dialogs.NewScrollDialog(dialogs.ScrollDialogConfig{
+		ID:      "test",
+		Title:   "Test",
+		Content: longContent(40),
+	})
+
+	dialog.Update(tea.KeyMsg{Type: tea.KeyDown})
+	dialog.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if dialog.ScrollOffset() != 2 {
+		t.Errorf("Expected offset 2, got %d", dialog.ScrollOffset())
+	}
+
+	dialog.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if dialog.ScrollOffset() != 1 {
+		t.Errorf("Expected offset 1, got %d", dialog.ScrollOffset())
+	}
+}
+
+func TestScrollDialogClampsAtTop(t *testing.T) {
+	dialog := dialogs.NewScrollDialog(dialogs.ScrollDialogConfig{
+		ID:      "test",
+		Title:   "Test",
+		Content: longContent(40),
+	})
+
+	// Scrolling up past the top clamps to 0
+	dialog.Update(tea.KeyMsg{Type: tea.KeyUp})
+	dialog.Update(tea.KeyMsg{Type: tea.KeyPgUp})
+	if dialog.ScrollOffset() != 0 {
+		t.Errorf("Expected offset clamped at 0, got %d", dialog.ScrollOffset())
+	}
+}
+
+func TestScrollDialogClampsAtBottom(t *testing.T) {
+	dialog := dialogs.NewScrollDialog(dialogs.ScrollDialogConfig{
+		ID:      "test",
+		Title:   "Test",
+		Content: longContent(40),
+	})
+
+	// Paging down repeatedly stops at the last page
+	for i := 0; i < 20; i++ {
+		dialog.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	}
+
+	if !dialog.AtBottom() {
+		t.Error("Expected viewport at the bottom")
+	}
+
+	bottom := dialog.ScrollOffset()
+	dialog.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if dialog.ScrollOffset() != bottom {
+		t.Errorf("Expected offset clamped at %d, got %d", bottom, dialog.ScrollOffset())
+	}
+
+	// The last line is visible at the bottom
+	if !strings.Contains(dialog.View(), "line 39") {
+		t.Error("Expected last line visible at bottom")
+	}
+}
+
+func TestScrollDialogHomeEnd(t *testing.T) {
+	dialog := dialogs.NewScrollDialog(dialogs.ScrollDialogConfig{
+		ID:      "test",
+		Title:   "Test",
+		Content: longContent(40),
+	})
+
+	dialog.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+	if !dialog.AtBottom() {
+		t.Error("G should jump to the bottom")
+	}
+
+	dialog.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	if dialog.ScrollOffset() != 0 {
+		t.Errorf("g should jump to the top, got offset %d", dialog.ScrollOffset())
+	}
+}
+
+func TestScrollDialogShortContent(t *testing.T) {
+	dialog := dialogs.NewScrollDialog(dialogs.ScrollDialogConfig{
+		ID:      "test",
+		Title:   "Test",
+		Content: "just one line",
+	})
+
+	// Content that fits is never scrollable
+	dialog.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	if dialog.ScrollOffset() != 0 {
+		t.Errorf("Short content should not scroll, got offset %d", dialog.ScrollOffset())
+	}
+
+	view := dialog.View()
+	if !strings.Contains(view, "just one line") {
+		t.Error("Expected content in view")
+	}
+	if strings.Contains(view, "░") {
+		t.Error("No scrollbar should render when content fits")
+	}
+}
+
+func TestScrollDialogScrollbar(t *testing.T) {
+	dialog := dialogs.NewScrollDialog(dialogs.ScrollDialogConfig{
+		ID:      "test",
+		Title:   "Test",
+		Content: longContent(40),
+	})
+
+	view := dialog.View()
+	if !strings.Contains(view, "█") || !strings.Contains(view, "░") {
+		t.Error("Expected scrollbar thumb and track for long content")
+	}
+}
+
+func TestScrollDialogClose(t *testing.T) {
+	dialog := dialogs.NewScrollDialog(dialogs.ScrollDialogConfig{
+		ID:      "test",
+		Title:   "Test",
+		Content: longContent(40),
+	})
+
+	dialog.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !dialog.IsClosing() {
+		t.Error("Dialog should be closing after ESC")
+	}
+}
+
+func TestScrollDialogResize(t *testing.T) {
+	dialog := dialogs.NewScrollDialog(dialogs.ScrollDialogConfig{
+		ID:      "test",
+		Title:   "Test",
+		Content: longContent(40),
+	})
+
+	// Jump to the bottom, then grow the window; offset stays valid
+	dialog.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+	dialog.SetSize(100, 50)
+
+	if dialog.ScrollOffset() > 40 {
+		t.Errorf("Offset out of range after resize: %d", dialog.ScrollOffset())
+	}
+	if !dialog.AtBottom() {
+		t.Error("Expected still at bottom after growing the viewport")
+	}
+}
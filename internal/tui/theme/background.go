@@ -0,0 +1,98 @@
+package theme
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/muesli/termenv"
+)
+
+// luminanceDarkThreshold is the relative luminance below which a terminal
+// background is considered dark
+const luminanceDarkThreshold = 0.5
+
+// DetectBackgroundLuminance queries the terminal background color (OSC 11)
+// and returns its relative luminance in [0, 1]. The second return value is
+// false when the terminal does not answer the query, e.g. when output is
+// not a TTY or the query times out.
+func DetectBackgroundLuminance() (float64, bool) {
+	output := termenv.NewOutput(os.Stdout)
+
+	// termenv only issues the OSC query on a real TTY and answers with an
+	// RGB color; anything else means detection failed
+	bg := output.BackgroundColor()
+	rgb, ok := bg.(termenv.RGBColor)
+	if !ok {
+		return 0, false
+	}
+
+	r, g, b, ok := hexToRGB(string(rgb))
+	if !ok {
+		return 0, false
+	}
+
+	return relativeLuminance(r, g, b), true
+}
+
+// relativeLuminance computes the perceived brightness of an RGB color
+// using the ITU-R BT.709 coefficients
+func relativeLuminance(r, g, b int) float64 {
+	return (0.2126*float64(r) + 0.7152*float64(g) + 0.0722*float64(b)) / 255.0
+}
+
+// themeNameForLuminance returns the built-in theme name suited to a
+// terminal background of the given luminance
+func themeNameForLuminance(luminance float64) string {
+	if luminance > luminanceDarkThreshold {
+		return "Light"
+	}
+	return "Dark"
+}
+
+// AutoSelect picks the Light or Dark built-in theme based on the detected
+// terminal background when no explicit theme has been saved. Detection
+// failure falls back to Dark.
+func (tm *ThemeManager) AutoSelect() error {
+	// A saved selection always wins
+	if tm.hasSavedTheme() {
+		return nil
+	}
+
+	luminance, ok := DetectBackgroundLuminance()
+
+	tm.mu.Lock()
+	tm.bgLuminance = luminance
+	tm.bgDetected = ok
+	tm.mu.Unlock()
+
+	name := "Dark"
+	if ok {
+		name = themeNameForLuminance(luminance)
+	}
+
+	if err := tm.SetTheme(name); err != nil {
+		return fmt.Errorf("auto-select failed: %w", err)
+	}
+
+	return nil
+}
+
+// BackgroundLuminance returns the terminal background luminance measured by
+// the last AutoSelect call. The second return value is false when detection
+// did not succeed.
+func (tm *ThemeManager) BackgroundLuminance() (float64, bool) {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	return tm.bgLuminance, tm.bgDetected
+}
+
+// hasSavedTheme reports whether a persisted theme selection exists
+func (tm *ThemeManager) hasSavedTheme() bool {
+	data, err := os.ReadFile(tm.getConfigPath())
+	if err != nil {
+		return false
+	}
+
+	return len(data) > 0
+}
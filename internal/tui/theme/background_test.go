@@ -0,0 +1,108 @@
+package theme
+
+import (
+	"testing"
+)
+
+// TestRelativeLuminance tests perceived brightness calculation
+func TestRelativeLuminance(t *testing.T) {
+	tests := []struct {
+		name    string
+		r, g, b int
+		min     float64
+		max     float64
+	}{
+		{"black", 0, 0, 0, 0.0, 0.01},
+		{"white", 255, 255, 255, 0.99, 1.0},
+		{"green is brighter than blue", 0, 255, 0, 0.7, 0.72},
+		{"pure blue is dim", 0, 0, 255, 0.07, 0.08},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := relativeLuminance(tt.r, tt.g, tt.b)
+			if got < tt.min || got > tt.max {
+				t.Errorf("Expected luminance in [%f, %f], got %f", tt.min, tt.max, got)
+			}
+		})
+	}
+}
+
+// TestThemeNameForLuminance tests the luminance-to-theme decision
+func TestThemeNameForLuminance(t *testing.T) {
+	tests := []struct {
+		name     string
+		hex      string
+		expected string
+	}{
+		{"black background", "#000000", "Dark"},
+		{"solarized dark background", "#002b36", "Dark"},
+		{"dark gray background", "#2e2e2e", "Dark"},
+		{"white background", "#ffffff", "Light"},
+		{"solarized light background", "#fdf6e3", "Light"},
+		{"light gray background", "#d0d0d0", "Light"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, g, b, ok := hexToRGB(tt.hex)
+			if !ok {
+				t.Fatalf("Failed to parse %s", tt.hex)
+			}
+
+			got := themeNameForLuminance(relativeLuminance(r, g, b))
+			if got != tt.expected {
+				t.Errorf("Expected '%s' for %s, got '%s'", tt.expected, tt.hex, got)
+			}
+		})
+	}
+}
+
+// TestAutoSelectFallsBackToDark tests that AutoSelect uses Dark when the
+// terminal background cannot be detected
+func TestAutoSelectFallsBackToDark(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	manager := NewThemeManager()
+	if err := RegisterBuiltinThemes(manager); err != nil {
+		t.Fatalf("RegisterBuiltinThemes failed: %v", err)
+	}
+
+	// Test output is not a TTY, so detection fails and Dark is selected
+	if err := manager.AutoSelect(); err != nil {
+		t.Fatalf("AutoSelect failed: %v", err)
+	}
+
+	if manager.CurrentTheme().Name != "Dark" {
+		t.Errorf("Expected fallback theme 'Dark', got '%s'", manager.CurrentTheme().Name)
+	}
+
+	if _, detected := manager.BackgroundLuminance(); detected {
+		t.Error("Expected detection to fail outside a TTY")
+	}
+}
+
+// TestAutoSelectRespectsSavedTheme tests that a saved selection wins
+func TestAutoSelectRespectsSavedTheme(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	manager := NewThemeManager()
+	if err := RegisterBuiltinThemes(manager); err != nil {
+		t.Fatalf("RegisterBuiltinThemes failed: %v", err)
+	}
+
+	if err := manager.SetTheme("Light"); err != nil {
+		t.Fatalf("SetTheme failed: %v", err)
+	}
+	if err := manager.SaveConfig(); err != nil {
+		t.Fatalf("SaveConfig failed: %v", err)
+	}
+
+	if err := manager.AutoSelect(); err != nil {
+		t.Fatalf("AutoSelect failed: %v", err)
+	}
+
+	if manager.CurrentTheme().Name != "Light" {
+		t.Errorf("Saved theme should win, got '%s'", manager.CurrentTheme().Name)
+	}
+}
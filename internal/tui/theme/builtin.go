@@ -188,12 +188,76 @@ func LightTheme() *Theme {
 	return NewTheme("Light", colors)
 }
 
+// HighContrastTheme returns the accessibility theme for low-vision users
+// Pure black background with maximum-contrast foreground and status colors,
+// every text pair clears the WCAG AA 4.5:1 ratio
+func HighContrastTheme() *Theme {
+	colors := ColorPalette{
+		// Base colors - Maximum contrast black and white
+		Background: lipgloss.Color("#000000"),
+		Foreground: lipgloss.Color("#ffffff"),
+
+		// Semantic colors - Bright, saturated hues
+		Primary:   lipgloss.Color("#ffff00"), // Yellow
+		Secondary: lipgloss.Color("#00ffff"), // Cyan
+		Accent:    lipgloss.Color("#ff00ff"), // Magenta
+
+		// Status colors
+		Success: lipgloss.Color("#00ff00"), // Bright green
+		Warning: lipgloss.Color("#ffff00"), // Yellow
+		Error:   lipgloss.Color("#ff5555"), // Bright red
+		Info:    lipgloss.Color("#55aaff"), // Bright blue
+
+		// UI element colors
+		Border:    lipgloss.Color("#ffffff"), // White borders
+		Selection: lipgloss.Color("#ffff00"), // Yellow selection
+		Cursor:    lipgloss.Color("#ffffff"), // White cursor
+		Highlight: lipgloss.Color("#00ffff"), // Cyan highlight
+		Muted:     lipgloss.Color("#c0c0c0"), // Light gray, still readable
+		Disabled:  lipgloss.Color("#a0a0a0"), // Gray
+
+		// Component-specific
+		StatusBar:      lipgloss.Color("#000000"), // Black
+		DialogBackdrop: lipgloss.Color("#000000"), // Opaque black
+		ButtonActive:   lipgloss.Color("#ffff00"), // Yellow
+		ButtonInactive: lipgloss.Color("#303030"), // Dark gray
+		InputBorder:    lipgloss.Color("#ffffff"), // White
+		InputFocus:     lipgloss.Color("#ffff00"), // Yellow
+
+		// Code syntax highlighting - Bright, distinguishable hues
+		CodeKeyword:  lipgloss.Color("#ff00ff"), // Magenta
+		CodeString:   lipgloss.Color("#00ff00"), // Green
+		CodeComment:  lipgloss.Color("#c0c0c0"), // Light gray
+		CodeFunction: lipgloss.Color("#55aaff"), // Blue
+		CodeNumber:   lipgloss.Color("#ffaa00"), // Orange
+		CodeType:     lipgloss.Color("#ffff00"), // Yellow
+		CodeVariable: lipgloss.Color("#ff5555"), // Red
+		CodeOperator: lipgloss.Color("#00ffff"), // Cyan
+
+		// Thinking blocks
+		ThinkingBorder:     lipgloss.Color("#ffffff"), // White
+		ThinkingBackground: lipgloss.Color("#000000"), // Black
+		ThinkingText:       lipgloss.Color("#ffffff"), // White
+		ThinkingHeader:     lipgloss.Color("#ffff00"), // Yellow
+
+		// Help system
+		HelpTitle:    lipgloss.Color("#ffff00"), // Yellow
+		HelpCategory: lipgloss.Color("#00ffff"), // Cyan
+		HelpKey:      lipgloss.Color("#00ff00"), // Green
+		HelpDesc:     lipgloss.Color("#ffffff"), // White
+		HelpHint:     lipgloss.Color("#c0c0c0"), // Light gray
+	}
+
+	return NewTheme("HighContrast", colors)
+}
+
 // GetAllBuiltinThemes returns all built-in themes
 func GetAllBuiltinThemes() []*Theme {
 	return []*Theme{
 		AINativeTheme(), // Default first
 		DarkTheme(),
 		LightTheme(),
+		HighContrastTheme(),
 	}
 }
 
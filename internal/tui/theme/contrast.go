@@ -0,0 +1,67 @@
+package theme
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// MinContrastRatio is the WCAG AA minimum contrast ratio for normal text
+const MinContrastRatio = 4.5
+
+// ContrastRatio computes the WCAG contrast ratio between two colors, from
+// 1 (identical) to 21 (black on white). Colors that cannot be parsed as
+// hex yield a ratio of 0.
+func (t *Theme) ContrastRatio(fg, bg lipgloss.Color) float64 {
+	fr, fgreen, fb, ok := hexToRGB(string(fg))
+	if !ok {
+		return 0
+	}
+	br, bgreen, bb, ok := hexToRGB(string(bg))
+	if !ok {
+		return 0
+	}
+
+	lf := wcagLuminance(fr, fgreen, fb)
+	lb := wcagLuminance(br, bgreen, bb)
+
+	lighter, darker := lf, lb
+	if darker > lighter {
+		lighter, darker = darker, lighter
+	}
+
+	return (lighter + 0.05) / (darker + 0.05)
+}
+
+// ValidateContrast extends Validate with a WCAG check, failing when the
+// foreground/background contrast ratio falls below minRatio. Pass
+// MinContrastRatio for the AA normal-text threshold.
+func (t *Theme) ValidateContrast(minRatio float64) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+
+	ratio := t.ContrastRatio(t.Colors.Foreground, t.Colors.Background)
+	if ratio < minRatio {
+		return ErrInvalidTheme{
+			Reason: fmt.Sprintf("foreground/background contrast ratio %.2f:1 is below %.1f:1", ratio, minRatio),
+		}
+	}
+
+	return nil
+}
+
+// wcagLuminance computes the WCAG relative luminance of an sRGB color
+func wcagLuminance(r, g, b int) float64 {
+	return 0.2126*linearize(r) + 0.7152*linearize(g) + 0.0722*linearize(b)
+}
+
+// linearize converts an 8-bit sRGB channel to its linear-light value
+func linearize(v int) float64 {
+	c := float64(v) / 255.0
+	if c <= 0.03928 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
@@ -0,0 +1,75 @@
+package theme
+
+import (
+	"math"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TestContrastRatio tests the WCAG contrast ratio for known color pairs
+func TestContrastRatio(t *testing.T) {
+	theme := AINativeTheme()
+
+	tests := []struct {
+		name     string
+		fg       string
+		bg       string
+		expected float64
+	}{
+		{"black on white", "#000000", "#ffffff", 21.0},
+		{"white on black", "#ffffff", "#000000", 21.0},
+		{"identical colors", "#808080", "#808080", 1.0},
+		{"mid gray on white", "#767676", "#ffffff", 4.54},
+		{"red on white", "#ff0000", "#ffffff", 4.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := theme.ContrastRatio(lipgloss.Color(tt.fg), lipgloss.Color(tt.bg))
+			if math.Abs(got-tt.expected) > 0.05 {
+				t.Errorf("Expected ratio %.2f for %s on %s, got %.2f", tt.expected, tt.fg, tt.bg, got)
+			}
+		})
+	}
+}
+
+// TestContrastRatioUnparsableColor tests that non-hex colors yield zero
+func TestContrastRatioUnparsableColor(t *testing.T) {
+	theme := AINativeTheme()
+
+	if got := theme.ContrastRatio(lipgloss.Color("12"), lipgloss.Color("#ffffff")); got != 0 {
+		t.Errorf("Expected 0 for unparsable foreground, got %.2f", got)
+	}
+}
+
+// TestValidateContrast tests the optional WCAG validation
+func TestValidateContrast(t *testing.T) {
+	// High contrast passes
+	if err := HighContrastTheme().ValidateContrast(MinContrastRatio); err != nil {
+		t.Errorf("HighContrast theme should pass WCAG AA: %v", err)
+	}
+
+	// Low contrast fails with a descriptive error
+	colors := AINativeTheme().Colors
+	colors.Foreground = lipgloss.Color("#222222")
+	colors.Background = lipgloss.Color("#0f0f1a")
+	muddy := NewTheme("Muddy", colors)
+
+	err := muddy.ValidateContrast(MinContrastRatio)
+	if err == nil {
+		t.Fatal("Expected low-contrast theme to fail validation")
+	}
+	if _, ok := err.(ErrInvalidTheme); !ok {
+		t.Errorf("Expected ErrInvalidTheme, got %T", err)
+	}
+}
+
+// TestBuiltinThemesMeetContrast tests that all built-in themes pass WCAG AA
+func TestBuiltinThemesMeetContrast(t *testing.T) {
+	for _, theme := range GetAllBuiltinThemes() {
+		if err := theme.ValidateContrast(MinContrastRatio); err != nil {
+			t.Errorf("Theme '%s' fails contrast validation: %v", theme.Name, err)
+		}
+	}
+}
@@ -15,6 +15,11 @@ type ThemeManager struct {
 	listeners []ThemeChangeListener
 	mu        sync.RWMutex
 	configDir string
+	profile   ColorProfile
+
+	// Terminal background measured by AutoSelect
+	bgLuminance float64
+	bgDetected  bool
 }
 
 // ThemeChangeListener is notified when the theme changes
@@ -37,6 +42,32 @@ func NewThemeManager() *ThemeManager {
 		themes:    make(map[string]*Theme),
 		listeners: make([]ThemeChangeListener, 0),
 		configDir: configDir,
+		profile:   DetectColorProfile(),
+	}
+}
+
+// ColorProfile returns the terminal color profile the manager applies to
+// active themes
+func (tm *ThemeManager) ColorProfile() ColorProfile {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	return tm.profile
+}
+
+// SetColorProfile overrides the detected color profile and re-applies it to
+// the current theme
+func (tm *ThemeManager) SetColorProfile(profile ColorProfile) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	tm.profile = profile
+	if tm.current != nil {
+		// Re-derive from the registered original so switching profiles
+		// never compounds a previous downsample
+		if original, exists := tm.themes[tm.current.Name]; exists {
+			tm.current = original.Downsample(profile)
+		}
 	}
 }
 
@@ -62,7 +93,7 @@ func (tm *ThemeManager) RegisterTheme(theme *Theme) error {
 
 	// If this is the first theme, set it as current
 	if tm.current == nil {
-		tm.current = theme
+		tm.current = theme.Downsample(tm.profile)
 	}
 
 	return nil
@@ -97,7 +128,7 @@ func (tm *ThemeManager) SetTheme(name string) error {
 	}
 
 	oldTheme := tm.current
-	tm.current = newTheme
+	tm.current = newTheme.Downsample(tm.profile)
 
 	// Make a copy of listeners to avoid holding lock during notifications
 	listeners := make([]ThemeChangeListener, len(tm.listeners))
@@ -0,0 +1,241 @@
+package theme
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ColorProfile describes the color capability of the terminal
+type ColorProfile int
+
+const (
+	// ProfileTrueColor supports 24-bit RGB colors
+	ProfileTrueColor ColorProfile = iota
+	// Profile256 supports the 256-color ANSI palette
+	Profile256
+	// Profile16 supports only the 16 basic ANSI colors
+	Profile16
+)
+
+// String returns a human-readable name for the profile
+func (p ColorProfile) String() string {
+	switch p {
+	case ProfileTrueColor:
+		return "truecolor"
+	case Profile256:
+		return "256"
+	case Profile16:
+		return "16"
+	default:
+		return "unknown"
+	}
+}
+
+// DetectColorProfile inspects the environment to determine the terminal's
+// color capability
+func DetectColorProfile() ColorProfile {
+	return detectProfile(os.Getenv("COLORTERM"), os.Getenv("TERM"))
+}
+
+// detectProfile determines the color profile from COLORTERM and TERM values
+func detectProfile(colorterm, term string) ColorProfile {
+	switch strings.ToLower(colorterm) {
+	case "truecolor", "24bit":
+		return ProfileTrueColor
+	}
+
+	if strings.Contains(term, "256color") {
+		return Profile256
+	}
+
+	return Profile16
+}
+
+// Downsample returns a variant of the theme whose palette is reduced to
+// colors the given profile can display. For ProfileTrueColor the theme is
+// returned unchanged. Results are cached per profile so style generation
+// only happens once.
+func (t *Theme) Downsample(profile ColorProfile) *Theme {
+	if profile == ProfileTrueColor {
+		return t
+	}
+
+	if cached, ok := t.downsampled[profile]; ok {
+		return cached
+	}
+
+	colors := t.Colors
+	mapPalette(&colors, func(c lipgloss.Color) lipgloss.Color {
+		return downsampleColor(c, profile)
+	})
+
+	reduced := NewTheme(t.Name, colors)
+	reduced.Borders = t.Borders
+	reduced.Spacing = t.Spacing
+
+	if t.downsampled == nil {
+		t.downsampled = make(map[ColorProfile]*Theme)
+	}
+	t.downsampled[profile] = reduced
+
+	return reduced
+}
+
+// mapPalette applies fn to every color in the palette in place
+func mapPalette(p *ColorPalette, fn func(lipgloss.Color) lipgloss.Color) {
+	colors := []*lipgloss.Color{
+		&p.Background, &p.Foreground,
+		&p.Primary, &p.Secondary, &p.Accent,
+		&p.Success, &p.Warning, &p.Error, &p.Info,
+		&p.Border, &p.Selection, &p.Cursor, &p.Highlight, &p.Muted, &p.Disabled,
+		&p.StatusBar, &p.DialogBackdrop, &p.ButtonActive, &p.ButtonInactive,
+		&p.InputBorder, &p.InputFocus,
+		&p.CodeKeyword, &p.CodeString, &p.CodeComment, &p.CodeFunction,
+		&p.CodeNumber, &p.CodeType, &p.CodeVariable, &p.CodeOperator,
+		&p.ThinkingBorder, &p.ThinkingBackground, &p.ThinkingText, &p.ThinkingHeader,
+		&p.HelpTitle, &p.HelpCategory, &p.HelpKey, &p.HelpDesc, &p.HelpHint,
+	}
+
+	for _, c := range colors {
+		*c = fn(*c)
+	}
+}
+
+// downsampleColor converts a hex color to the nearest color the profile
+// supports. Non-hex values (named colors, ANSI indices) pass through
+// unchanged.
+func downsampleColor(c lipgloss.Color, profile ColorProfile) lipgloss.Color {
+	r, g, b, ok := hexToRGB(string(c))
+	if !ok {
+		return c
+	}
+
+	switch profile {
+	case Profile256:
+		return lipgloss.Color(strconv.Itoa(ansi256Index(r, g, b)))
+	case Profile16:
+		return lipgloss.Color(strconv.Itoa(ansi16Index(r, g, b)))
+	default:
+		return c
+	}
+}
+
+// hexToRGB parses a "#rrggbb" or "#rgb" string into RGB components
+func hexToRGB(s string) (r, g, b int, ok bool) {
+	if !strings.HasPrefix(s, "#") {
+		return 0, 0, 0, false
+	}
+
+	hex := s[1:]
+	switch len(hex) {
+	case 3:
+		// Expand shorthand "#abc" to "#aabbcc"
+		hex = fmt.Sprintf("%c%c%c%c%c%c", hex[0], hex[0], hex[1], hex[1], hex[2], hex[2])
+	case 6:
+		// Already full form
+	default:
+		return 0, 0, 0, false
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+
+	return int(value >> 16), int(value >> 8 & 0xff), int(value & 0xff), true
+}
+
+// ansi256Index returns the index of the nearest color in the 256-color
+// ANSI palette, considering both the 6x6x6 color cube and the grayscale ramp
+func ansi256Index(r, g, b int) int {
+	// Nearest cell in the 6x6x6 cube (indices 16-231)
+	cr := cubeComponent(r)
+	cg := cubeComponent(g)
+	cb := cubeComponent(b)
+	cubeIdx := 16 + 36*cr + 6*cg + cb
+	cubeDist := colorDistance(r, g, b, cubeValue(cr), cubeValue(cg), cubeValue(cb))
+
+	// Nearest step on the grayscale ramp (indices 232-255, values 8-238)
+	gray := (r + g + b) / 3
+	grayStep := (gray - 8) / 10
+	if grayStep < 0 {
+		grayStep = 0
+	}
+	if grayStep > 23 {
+		grayStep = 23
+	}
+	grayValue := 8 + grayStep*10
+	grayDist := colorDistance(r, g, b, grayValue, grayValue, grayValue)
+
+	if grayDist < cubeDist {
+		return 232 + grayStep
+	}
+	return cubeIdx
+}
+
+// cubeComponent maps an 8-bit channel value to the nearest 6x6x6 cube step
+func cubeComponent(v int) int {
+	if v < 48 {
+		return 0
+	}
+	if v < 115 {
+		return 1
+	}
+	return (v - 35) / 40
+}
+
+// cubeValue returns the 8-bit channel value for a 6x6x6 cube step
+func cubeValue(step int) int {
+	if step == 0 {
+		return 0
+	}
+	return 55 + step*40
+}
+
+// ansi16ColorTable holds the RGB values of the 16 basic ANSI colors
+var ansi16ColorTable = [16][3]int{
+	{0, 0, 0},       // 0 black
+	{128, 0, 0},     // 1 red
+	{0, 128, 0},     // 2 green
+	{128, 128, 0},   // 3 yellow
+	{0, 0, 128},     // 4 blue
+	{128, 0, 128},   // 5 magenta
+	{0, 128, 128},   // 6 cyan
+	{192, 192, 192}, // 7 white
+	{128, 128, 128}, // 8 bright black
+	{255, 0, 0},     // 9 bright red
+	{0, 255, 0},     // 10 bright green
+	{255, 255, 0},   // 11 bright yellow
+	{0, 0, 255},     // 12 bright blue
+	{255, 0, 255},   // 13 bright magenta
+	{0, 255, 255},   // 14 bright cyan
+	{255, 255, 255}, // 15 bright white
+}
+
+// ansi16Index returns the index of the nearest basic ANSI color
+func ansi16Index(r, g, b int) int {
+	best := 0
+	bestDist := colorDistance(r, g, b, ansi16ColorTable[0][0], ansi16ColorTable[0][1], ansi16ColorTable[0][2])
+
+	for i := 1; i < len(ansi16ColorTable); i++ {
+		dist := colorDistance(r, g, b, ansi16ColorTable[i][0], ansi16ColorTable[i][1], ansi16ColorTable[i][2])
+		if dist < bestDist {
+			best = i
+			bestDist = dist
+		}
+	}
+
+	return best
+}
+
+// colorDistance returns the squared Euclidean distance between two RGB colors
+func colorDistance(r1, g1, b1, r2, g2, b2 int) int {
+	dr := r1 - r2
+	dg := g1 - g2
+	db := b1 - b2
+	return dr*dr + dg*dg + db*db
+}
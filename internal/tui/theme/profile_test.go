@@ -0,0 +1,166 @@
+package theme
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TestDetectProfile tests color profile detection from environment values
+func TestDetectProfile(t *testing.T) {
+	tests := []struct {
+		name      string
+		colorterm string
+		term      string
+		expected  ColorProfile
+	}{
+		{"COLORTERM truecolor", "truecolor", "xterm-256color", ProfileTrueColor},
+		{"COLORTERM 24bit", "24bit", "xterm", ProfileTrueColor},
+		{"COLORTERM uppercase", "TRUECOLOR", "xterm", ProfileTrueColor},
+		{"256color TERM", "", "xterm-256color", Profile256},
+		{"screen 256color", "", "screen-256color", Profile256},
+		{"plain xterm", "", "xterm", Profile16},
+		{"empty environment", "", "", Profile16},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := detectProfile(tt.colorterm, tt.term)
+			if got != tt.expected {
+				t.Errorf("Expected profile %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestAnsi256Index tests hex to ANSI-256 mapping for well-known colors
+func TestAnsi256Index(t *testing.T) {
+	tests := []struct {
+		name     string
+		hex      string
+		expected string
+	}{
+		{"pure red", "#ff0000", "196"},
+		{"pure green", "#00ff00", "46"},
+		{"pure blue", "#0000ff", "21"},
+		{"black", "#000000", "16"},
+		{"white", "#ffffff", "231"},
+		{"mid gray uses ramp", "#808080", "244"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := downsampleColor(lipgloss.Color(tt.hex), Profile256)
+			if string(got) != tt.expected {
+				t.Errorf("Expected index %s for %s, got %s", tt.expected, tt.hex, got)
+			}
+		})
+	}
+}
+
+// TestAnsi16Index tests hex to basic ANSI mapping
+func TestAnsi16Index(t *testing.T) {
+	tests := []struct {
+		name     string
+		hex      string
+		expected string
+	}{
+		{"pure red", "#ff0000", "9"},
+		{"pure blue", "#0000ff", "12"},
+		{"black", "#000000", "0"},
+		{"white", "#ffffff", "15"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := downsampleColor(lipgloss.Color(tt.hex), Profile16)
+			if string(got) != tt.expected {
+				t.Errorf("Expected index %s for %s, got %s", tt.expected, tt.hex, got)
+			}
+		})
+	}
+}
+
+// TestDownsampleColorPassthrough tests that non-hex values are unchanged
+func TestDownsampleColorPassthrough(t *testing.T) {
+	for _, value := range []string{"12", "red", "", "#zzz"} {
+		got := downsampleColor(lipgloss.Color(value), Profile256)
+		if string(got) != value {
+			t.Errorf("Expected %q unchanged, got %q", value, got)
+		}
+	}
+}
+
+// TestHexToRGB tests hex parsing including shorthand form
+func TestHexToRGB(t *testing.T) {
+	r, g, b, ok := hexToRGB("#1a2b3c")
+	if !ok || r != 0x1a || g != 0x2b || b != 0x3c {
+		t.Errorf("Unexpected parse of #1a2b3c: %d %d %d %v", r, g, b, ok)
+	}
+
+	r, g, b, ok = hexToRGB("#fff")
+	if !ok || r != 255 || g != 255 || b != 255 {
+		t.Errorf("Unexpected parse of #fff: %d %d %d %v", r, g, b, ok)
+	}
+
+	if _, _, _, ok := hexToRGB("not-a-color"); ok {
+		t.Error("Expected parse failure for non-hex value")
+	}
+}
+
+// TestThemeDownsample tests palette reduction and caching
+func TestThemeDownsample(t *testing.T) {
+	theme := AINativeTheme()
+
+	// TrueColor is a no-op
+	if theme.Downsample(ProfileTrueColor) != theme {
+		t.Error("TrueColor downsample should return the theme unchanged")
+	}
+
+	reduced := theme.Downsample(Profile256)
+	if reduced == theme {
+		t.Error("256-color downsample should return a new theme")
+	}
+	if reduced.Name != theme.Name {
+		t.Errorf("Downsample should preserve the name, got '%s'", reduced.Name)
+	}
+	if err := reduced.Validate(); err != nil {
+		t.Errorf("Downsampled theme should be valid: %v", err)
+	}
+
+	// All palette colors are ANSI indices, not hex
+	if _, _, _, ok := hexToRGB(string(reduced.Colors.Primary)); ok {
+		t.Errorf("Primary should no longer be hex, got '%s'", reduced.Colors.Primary)
+	}
+
+	// Second call returns the cached instance
+	if theme.Downsample(Profile256) != reduced {
+		t.Error("Downsample should cache and reuse the reduced theme")
+	}
+}
+
+// TestManagerAppliesProfile tests that the manager downsamples the active theme
+func TestManagerAppliesProfile(t *testing.T) {
+	manager := NewThemeManager()
+	manager.SetColorProfile(Profile256)
+
+	theme := AINativeTheme()
+	if err := manager.RegisterTheme(theme); err != nil {
+		t.Fatalf("RegisterTheme failed: %v", err)
+	}
+
+	current := manager.CurrentTheme()
+	if current.Name != "AINative" {
+		t.Errorf("Expected theme 'AINative', got '%s'", current.Name)
+	}
+	if _, _, _, ok := hexToRGB(string(current.Colors.Primary)); ok {
+		t.Errorf("Current theme should be downsampled, Primary is '%s'", current.Colors.Primary)
+	}
+
+	// Switching back to truecolor restores the original palette
+	manager.SetColorProfile(ProfileTrueColor)
+	current = manager.CurrentTheme()
+	if current.Colors.Primary != theme.Colors.Primary {
+		t.Errorf("Expected original Primary '%s', got '%s'", theme.Colors.Primary, current.Colors.Primary)
+	}
+}
@@ -11,6 +11,10 @@ type Theme struct {
 	Styles  StyleSet
 	Borders BorderSet
 	Spacing SpacingSet
+
+	// downsampled caches reduced-palette variants per color profile so
+	// style generation is not repeated
+	downsampled map[ColorProfile]*Theme
 }
 
 // ColorPalette contains all semantic colors used throughout the application
@@ -374,11 +374,11 @@ func TestThemeManagerPersistence(t *testing.T) {
 func TestBuiltinThemes(t *testing.T) {
 	themes := GetAllBuiltinThemes()
 
-	if len(themes) != 3 {
-		t.Errorf("Expected 3 built-in themes, got %d", len(themes))
+	if len(themes) != 4 {
+		t.Errorf("Expected 4 built-in themes, got %d", len(themes))
 	}
 
-	expectedNames := []string{"AINative", "Dark", "Light"}
+	expectedNames := []string{"AINative", "Dark", "Light", "HighContrast"}
 	for i, theme := range themes {
 		if theme.Name != expectedNames[i] {
 			t.Errorf("Expected theme %d to be '%s', got '%s'", i, expectedNames[i], theme.Name)
@@ -411,8 +411,8 @@ func TestRegisterBuiltinThemes(t *testing.T) {
 	}
 
 	themes := manager.ListThemes()
-	if len(themes) != 3 {
-		t.Errorf("Expected 3 themes registered, got %d", len(themes))
+	if len(themes) != 4 {
+		t.Errorf("Expected 4 themes registered, got %d", len(themes))
 	}
 
 	// Verify AINative is default
@@ -422,7 +422,7 @@ func TestRegisterBuiltinThemes(t *testing.T) {
 	}
 
 	// Verify all themes can be switched to
-	for _, name := range []string{"AINative", "Dark", "Light"} {
+	for _, name := range []string{"AINative", "Dark", "Light", "HighContrast"} {
 		err := manager.SetTheme(name)
 		if err != nil {
 			t.Errorf("Failed to switch to theme '%s': %v", name, err)
@@ -0,0 +1,177 @@
+package theme
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// themeFileDefinition is the on-disk representation of a custom theme
+type themeFileDefinition struct {
+	Name   string            `json:"name" yaml:"name"`
+	Colors map[string]string `json:"colors" yaml:"colors"`
+}
+
+// LoadThemeFile parses a theme definition from a YAML or JSON file,
+// validates it, and registers it with the manager. The format is chosen
+// from the file extension (.yaml, .yml, or .json).
+func (tm *ThemeManager) LoadThemeFile(path string) (*Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read theme file: %w", err)
+	}
+
+	var def themeFileDefinition
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &def); err != nil {
+			return nil, fmt.Errorf("failed to parse theme file %s: %w", path, err)
+		}
+	case ".json":
+		if err := json.Unmarshal(data, &def); err != nil {
+			return nil, fmt.Errorf("failed to parse theme file %s: %w", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported theme file format '%s' (expected .yaml, .yml, or .json)", filepath.Ext(path))
+	}
+
+	theme, err := themeFromDefinition(def)
+	if err != nil {
+		return nil, fmt.Errorf("invalid theme file %s: %w", path, err)
+	}
+
+	if err := tm.RegisterTheme(theme); err != nil {
+		return nil, err
+	}
+
+	return theme, nil
+}
+
+// LoadThemesFromDir loads every theme file in the given directory. A missing
+// directory is not an error. Files that fail to load are skipped and
+// reported in the returned error; valid files are still registered.
+func (tm *ThemeManager) LoadThemesFromDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read themes directory: %w", err)
+	}
+
+	var loadErrs []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+
+		if _, err := tm.LoadThemeFile(filepath.Join(dir, entry.Name())); err != nil {
+			loadErrs = append(loadErrs, err.Error())
+		}
+	}
+
+	if len(loadErrs) > 0 {
+		return fmt.Errorf("failed to load %d theme file(s): %s", len(loadErrs), strings.Join(loadErrs, "; "))
+	}
+
+	return nil
+}
+
+// LoadUserThemes loads custom themes from the default themes directory
+// under the application config directory
+func (tm *ThemeManager) LoadUserThemes() error {
+	return tm.LoadThemesFromDir(filepath.Join(tm.configDir, "themes"))
+}
+
+// themeFromDefinition builds a Theme from a parsed definition, checking
+// required colors and hex syntax
+func themeFromDefinition(def themeFileDefinition) (*Theme, error) {
+	if def.Name == "" {
+		return nil, ErrInvalidTheme{Reason: "theme name cannot be empty"}
+	}
+
+	// Unset optional colors inherit from the default palette so partial
+	// definitions still render sensibly
+	palette := AINativeTheme().Colors
+	fields := colorFieldMap(&palette)
+
+	for _, required := range []string{"background", "foreground", "primary"} {
+		if _, ok := def.Colors[required]; !ok {
+			return nil, ErrInvalidTheme{Reason: fmt.Sprintf("required color '%s' is missing", required)}
+		}
+	}
+
+	for key, value := range def.Colors {
+		field, ok := fields[key]
+		if !ok {
+			return nil, ErrInvalidTheme{Reason: fmt.Sprintf("unknown color '%s'", key)}
+		}
+		if _, _, _, ok := hexToRGB(value); !ok {
+			return nil, ErrInvalidTheme{Reason: fmt.Sprintf("color '%s' has malformed hex value '%s'", key, value)}
+		}
+		*field = lipgloss.Color(value)
+	}
+
+	return NewTheme(def.Name, palette), nil
+}
+
+// colorFieldMap maps theme-file color keys to palette fields
+func colorFieldMap(p *ColorPalette) map[string]*lipgloss.Color {
+	return map[string]*lipgloss.Color{
+		"background": &p.Background,
+		"foreground": &p.Foreground,
+		"primary":    &p.Primary,
+		"secondary":  &p.Secondary,
+		"accent":     &p.Accent,
+
+		"success": &p.Success,
+		"warning": &p.Warning,
+		"error":   &p.Error,
+		"info":    &p.Info,
+
+		"border":    &p.Border,
+		"selection": &p.Selection,
+		"cursor":    &p.Cursor,
+		"highlight": &p.Highlight,
+		"muted":     &p.Muted,
+		"disabled":  &p.Disabled,
+
+		"status_bar":      &p.StatusBar,
+		"dialog_backdrop": &p.DialogBackdrop,
+		"button_active":   &p.ButtonActive,
+		"button_inactive": &p.ButtonInactive,
+		"input_border":    &p.InputBorder,
+		"input_focus":     &p.InputFocus,
+
+		"code_keyword":  &p.CodeKeyword,
+		"code_string":   &p.CodeString,
+		"code_comment":  &p.CodeComment,
+		"code_function": &p.CodeFunction,
+		"code_number":   &p.CodeNumber,
+		"code_type":     &p.CodeType,
+		"code_variable": &p.CodeVariable,
+		"code_operator": &p.CodeOperator,
+
+		"thinking_border":     &p.ThinkingBorder,
+		"thinking_background": &p.ThinkingBackground,
+		"thinking_text":       &p.ThinkingText,
+		"thinking_header":     &p.ThinkingHeader,
+
+		"help_title":    &p.HelpTitle,
+		"help_category": &p.HelpCategory,
+		"help_key":      &p.HelpKey,
+		"help_desc":     &p.HelpDesc,
+		"help_hint":     &p.HelpHint,
+	}
+}
@@ -0,0 +1,192 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeThemeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write theme file: %v", err)
+	}
+	return path
+}
+
+// TestLoadThemeFileYAML tests loading a custom theme from YAML
+func TestLoadThemeFileYAML(t *testing.T) {
+	content := `name: Solarized
+colors:
+  background: "#002b36"
+  foreground: "#839496"
+  primary: "#268bd2"
+  success: "#859900"
+`
+	path := writeThemeFile(t, t.TempDir(), "solarized.yaml", content)
+
+	manager := NewThemeManager()
+	theme, err := manager.LoadThemeFile(path)
+	if err != nil {
+		t.Fatalf("LoadThemeFile failed: %v", err)
+	}
+
+	if theme.Name != "Solarized" {
+		t.Errorf("Expected name 'Solarized', got '%s'", theme.Name)
+	}
+	if theme.Colors.Background != "#002b36" {
+		t.Errorf("Unexpected background: '%s'", theme.Colors.Background)
+	}
+	if theme.Colors.Success != "#859900" {
+		t.Errorf("Unexpected success color: '%s'", theme.Colors.Success)
+	}
+	if !manager.HasTheme("Solarized") {
+		t.Error("Loaded theme should be registered")
+	}
+
+	// Unset optional colors inherit defaults
+	if theme.Colors.Error == "" {
+		t.Error("Unset optional colors should inherit defaults")
+	}
+}
+
+// TestLoadThemeFileJSON tests loading a custom theme from JSON
+func TestLoadThemeFileJSON(t *testing.T) {
+	content := `{
+  "name": "Custom",
+  "colors": {
+    "background": "#101010",
+    "foreground": "#e0e0e0",
+    "primary": "#ff8800"
+  }
+}`
+	path := writeThemeFile(t, t.TempDir(), "custom.json", content)
+
+	manager := NewThemeManager()
+	theme, err := manager.LoadThemeFile(path)
+	if err != nil {
+		t.Fatalf("LoadThemeFile failed: %v", err)
+	}
+
+	if theme.Name != "Custom" {
+		t.Errorf("Expected name 'Custom', got '%s'", theme.Name)
+	}
+	if theme.Colors.Primary != "#ff8800" {
+		t.Errorf("Unexpected primary: '%s'", theme.Colors.Primary)
+	}
+}
+
+// TestLoadThemeFileErrors tests error reporting for invalid theme files
+func TestLoadThemeFileErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		filename    string
+		content     string
+		errContains string
+	}{
+		{
+			name:        "missing required color",
+			filename:    "missing.yaml",
+			content:     "name: Broken\ncolors:\n  background: \"#000000\"\n  primary: \"#ff0000\"\n",
+			errContains: "required color 'foreground' is missing",
+		},
+		{
+			name:        "malformed hex value",
+			filename:    "badhex.yaml",
+			content:     "name: Broken\ncolors:\n  background: \"#000000\"\n  foreground: \"#gggggg\"\n  primary: \"#ff0000\"\n",
+			errContains: "malformed hex value",
+		},
+		{
+			name:        "unknown color key",
+			filename:    "unknown.yaml",
+			content:     "name: Broken\ncolors:\n  background: \"#000000\"\n  foreground: \"#ffffff\"\n  primary: \"#ff0000\"\n  sparkle: \"#123456\"\n",
+			errContains: "unknown color 'sparkle'",
+		},
+		{
+			name:        "missing name",
+			filename:    "noname.yaml",
+			content:     "colors:\n  background: \"#000000\"\n  foreground: \"#ffffff\"\n  primary: \"#ff0000\"\n",
+			errContains: "name cannot be empty",
+		},
+		{
+			name:        "unsupported extension",
+			filename:    "theme.toml",
+			content:     "name = \"Broken\"\n",
+			errContains: "unsupported theme file format",
+		},
+		{
+			name:        "invalid syntax",
+			filename:    "garbage.json",
+			content:     "{not json",
+			errContains: "failed to parse",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeThemeFile(t, t.TempDir(), tt.filename, tt.content)
+
+			manager := NewThemeManager()
+			_, err := manager.LoadThemeFile(path)
+			if err == nil {
+				t.Fatal("Expected error but got nil")
+			}
+			if !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("Expected error containing '%s', got '%v'", tt.errContains, err)
+			}
+		})
+	}
+}
+
+// TestLoadThemesFromDir tests scanning a directory of theme files
+func TestLoadThemesFromDir(t *testing.T) {
+	dir := t.TempDir()
+	writeThemeFile(t, dir, "one.yaml", "name: One\ncolors:\n  background: \"#000000\"\n  foreground: \"#ffffff\"\n  primary: \"#ff0000\"\n")
+	writeThemeFile(t, dir, "two.json", `{"name":"Two","colors":{"background":"#111111","foreground":"#eeeeee","primary":"#00ff00"}}`)
+	writeThemeFile(t, dir, "notes.txt", "not a theme")
+
+	manager := NewThemeManager()
+	if err := manager.LoadThemesFromDir(dir); err != nil {
+		t.Fatalf("LoadThemesFromDir failed: %v", err)
+	}
+
+	if !manager.HasTheme("One") || !manager.HasTheme("Two") {
+		t.Error("Expected both themes registered")
+	}
+	if manager.GetThemeCount() != 2 {
+		t.Errorf("Expected 2 themes, got %d", manager.GetThemeCount())
+	}
+}
+
+// TestLoadThemesFromDirPartialFailure tests that bad files don't block good ones
+func TestLoadThemesFromDirPartialFailure(t *testing.T) {
+	dir := t.TempDir()
+	writeThemeFile(t, dir, "good.yaml", "name: Good\ncolors:\n  background: \"#000000\"\n  foreground: \"#ffffff\"\n  primary: \"#ff0000\"\n")
+	writeThemeFile(t, dir, "bad.yaml", "name: Bad\ncolors:\n  background: \"#000000\"\n")
+
+	manager := NewThemeManager()
+	err := manager.LoadThemesFromDir(dir)
+	if err == nil {
+		t.Fatal("Expected error for the bad theme file")
+	}
+	if !strings.Contains(err.Error(), "1 theme file(s)") {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if !manager.HasTheme("Good") {
+		t.Error("Valid theme should still be registered")
+	}
+	if manager.HasTheme("Bad") {
+		t.Error("Invalid theme should not be registered")
+	}
+}
+
+// TestLoadThemesFromMissingDir tests that a missing directory is not an error
+func TestLoadThemesFromMissingDir(t *testing.T) {
+	manager := NewThemeManager()
+	if err := manager.LoadThemesFromDir(filepath.Join(t.TempDir(), "does-not-exist")); err != nil {
+		t.Errorf("Missing directory should not be an error, got %v", err)
+	}
+}
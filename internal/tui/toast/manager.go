@@ -2,11 +2,26 @@ package toast
 
 import (
 	"fmt"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
+// DefaultHistorySize is the default toast history capacity
+const DefaultHistorySize = 50
+
+// DefaultFocusKey is the key that cycles focus through visible toasts
+const DefaultFocusKey = "tab"
+
+// ToastRecord is a history entry for a toast that was shown
+type ToastRecord struct {
+	Type      ToastType
+	Title     string
+	Message   string
+	Timestamp time.Time
+}
+
 // ToastManager manages multiple toasts with queue
 type ToastManager struct {
 	toasts      []*Toast      // Currently visible toasts
@@ -18,6 +33,10 @@ type ToastManager struct {
 	screenWidth int           // Total screen width
 	screenHeight int          // Total screen height
 	enabled     bool          // Whether toasts are enabled
+	history     []ToastRecord // Ring buffer of shown toasts (oldest first)
+	historySize int           // Max history entries
+	focusedIndex int          // Index of the focused toast (-1 = none)
+	focusKey    string        // Key that cycles toast focus
 }
 
 // NewToastManager creates a new toast manager
@@ -30,6 +49,10 @@ func NewToastManager() *ToastManager {
 		width:     40,
 		height:    0,
 		enabled:   true,
+		history:     make([]ToastRecord, 0),
+		historySize: DefaultHistorySize,
+		focusedIndex: -1,
+		focusKey:     DefaultFocusKey,
 	}
 }
 
@@ -98,6 +121,9 @@ func (m *ToastManager) ShowToast(config ToastConfig) tea.Cmd {
 	toast := NewToast(config)
 	toast.SetWidth(m.width)
 
+	// Record in history regardless of whether the toast is queued
+	m.recordToast(config)
+
 	// If we have room, show immediately
 	if len(m.toasts) < m.maxToasts {
 		m.toasts = append(m.toasts, toast)
@@ -144,6 +170,51 @@ func (m *ToastManager) ShowLoading(message string) tea.Cmd {
 	return m.ShowToast(config)
 }
 
+// ShowProgress displays a determinate progress toast and returns its ID
+// so callers can drive it with SetProgress
+func (m *ToastManager) ShowProgress(message string) (string, tea.Cmd) {
+	if !m.enabled {
+		return "", nil
+	}
+
+	config := DefaultToastConfig(ToastProgress)
+	config.Message = message
+	config.Position = m.position
+
+	toast := NewToast(config)
+	width := m.width
+	if m.screenWidth > 0 {
+		width = CalculateToastWidth(m.screenWidth)
+	}
+	toast.SetWidth(width)
+
+	m.recordToast(config)
+
+	if len(m.toasts) < m.maxToasts {
+		m.toasts = append(m.toasts, toast)
+		return toast.ID(), toast.Init()
+	}
+
+	m.queue = append(m.queue, toast)
+	return toast.ID(), nil
+}
+
+// SetProgress updates the completion fraction of a progress toast by ID
+func (m *ToastManager) SetProgress(id string, fraction float64) {
+	for _, toast := range m.toasts {
+		if toast.ID() == id {
+			toast.SetProgress(fraction)
+			return
+		}
+	}
+	for _, toast := range m.queue {
+		if toast.ID() == id {
+			toast.SetProgress(fraction)
+			return
+		}
+	}
+}
+
 // DismissToast dismisses a specific toast by ID
 func (m *ToastManager) DismissToast(id string) tea.Cmd {
 	for _, toast := range m.toasts {
@@ -166,14 +237,140 @@ func (m *ToastManager) DismissAll() tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
+// PauseAll pauses the expiration timers of all visible toasts
+func (m *ToastManager) PauseAll() {
+	for _, toast := range m.toasts {
+		toast.Pause()
+	}
+}
+
+// ResumeAll resumes the expiration timers of all visible toasts
+func (m *ToastManager) ResumeAll() {
+	for _, toast := range m.toasts {
+		toast.Resume()
+	}
+}
+
 // RemoveToast removes a toast from the visible list
 func (m *ToastManager) RemoveToast(id string) {
 	for i, toast := range m.toasts {
 		if toast.ID() == id {
 			m.toasts = append(m.toasts[:i], m.toasts[i+1:]...)
+
+			// Keep the focused index pointing at the same toast
+			if i == m.focusedIndex {
+				m.focusedIndex = -1
+			} else if i < m.focusedIndex {
+				m.focusedIndex--
+			}
+			break
+		}
+	}
+}
+
+// SetFocusKey configures which key cycles focus through visible toasts
+func (m *ToastManager) SetFocusKey(key string) {
+	if key != "" {
+		m.focusKey = key
+	}
+}
+
+// GetFocusKey returns the key that cycles toast focus
+func (m *ToastManager) GetFocusKey() string {
+	return m.focusKey
+}
+
+// FocusNext moves keyboard focus to the next dismissible or actionable
+// toast, wrapping around to the first when the end is reached
+func (m *ToastManager) FocusNext() {
+	focusable := m.focusableIndices()
+	if len(focusable) == 0 {
+		m.clearFocus()
+		return
+	}
+
+	next := focusable[0]
+	for pos, idx := range focusable {
+		if idx == m.focusedIndex {
+			next = focusable[(pos+1)%len(focusable)]
 			break
 		}
 	}
+	m.setFocus(next)
+}
+
+// FocusedToast returns the toast that currently has keyboard focus, or nil
+func (m *ToastManager) FocusedToast() *Toast {
+	if m.focusedIndex < 0 || m.focusedIndex >= len(m.toasts) {
+		return nil
+	}
+	return m.toasts[m.focusedIndex]
+}
+
+// focusableIndices returns the indices of visible toasts that can be
+// focused: dismissible toasts and toasts with an action button
+func (m *ToastManager) focusableIndices() []int {
+	var indices []int
+	for i, toast := range m.toasts {
+		if toast.IsDismissed() {
+			continue
+		}
+		if toast.config.Dismissible || toast.config.Action != nil {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// setFocus moves focus to the toast at the given index
+func (m *ToastManager) setFocus(index int) {
+	for i, toast := range m.toasts {
+		toast.SetFocused(i == index)
+	}
+	m.focusedIndex = index
+}
+
+// clearFocus removes keyboard focus from all toasts
+func (m *ToastManager) clearFocus() {
+	for _, toast := range m.toasts {
+		toast.SetFocused(false)
+	}
+	m.focusedIndex = -1
+}
+
+// handleKey processes keyboard input for toast focus and activation
+func (m *ToastManager) handleKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case m.focusKey:
+		m.FocusNext()
+
+	case "enter":
+		toast := m.FocusedToast()
+		if toast == nil || toast.config.Action == nil {
+			return nil
+		}
+		action := toast.config.Action
+		m.clearFocus()
+
+		var cmds []tea.Cmd
+		if action.Command != nil {
+			cmds = append(cmds, action.Command)
+		}
+		if cmd := toast.Dismiss(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+		return tea.Batch(cmds...)
+
+	case "esc":
+		toast := m.FocusedToast()
+		if toast == nil {
+			return nil
+		}
+		m.clearFocus()
+		return toast.Dismiss()
+	}
+
+	return nil
 }
 
 // processQueue moves toasts from queue to visible list
@@ -241,6 +438,20 @@ func (m *ToastManager) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, cmd)
 		}
 
+	case tea.KeyMsg:
+		cmd := m.handleKey(msg)
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
+	case tea.BlurMsg:
+		// Pause expiration while the terminal is unfocused so toasts
+		// don't vanish while the user is away
+		m.PauseAll()
+
+	case tea.FocusMsg:
+		m.ResumeAll()
+
 	case ToastActionMsg:
 		// Execute action and dismiss toast
 		if msg.Action != nil && msg.Action.Command != nil {
@@ -356,6 +567,54 @@ func (m *ToastManager) positionToasts(content string) string {
 	)
 }
 
+// recordToast appends a history entry, evicting the oldest when full
+func (m *ToastManager) recordToast(config ToastConfig) {
+	if m.historySize <= 0 {
+		return
+	}
+
+	m.history = append(m.history, ToastRecord{
+		Type:      config.Type,
+		Title:     config.Title,
+		Message:   config.Message,
+		Timestamp: time.Now(),
+	})
+
+	if len(m.history) > m.historySize {
+		m.history = m.history[len(m.history)-m.historySize:]
+	}
+}
+
+// History returns a copy of the recorded toast history, oldest first
+func (m *ToastManager) History() []ToastRecord {
+	history := make([]ToastRecord, len(m.history))
+	copy(history, m.history)
+	return history
+}
+
+// ClearHistory removes all recorded history entries
+func (m *ToastManager) ClearHistory() {
+	m.history = make([]ToastRecord, 0)
+}
+
+// SetHistorySize caps the number of history entries kept, evicting the
+// oldest entries if the history is already larger
+func (m *ToastManager) SetHistorySize(n int) {
+	if n < 0 {
+		n = 0
+	}
+	m.historySize = n
+
+	if len(m.history) > n {
+		m.history = m.history[len(m.history)-n:]
+	}
+}
+
+// GetHistorySize returns the history capacity
+func (m *ToastManager) GetHistorySize() int {
+	return m.historySize
+}
+
 // ClearQueue clears all queued toasts
 func (m *ToastManager) ClearQueue() {
 	m.queue = make([]*Toast, 0)
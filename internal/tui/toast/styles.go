@@ -30,6 +30,9 @@ const (
 	LoadingBorderColor      = "13"  // Magenta
 	LoadingBackgroundColor  = "235" // Dark gray
 	LoadingTextColor        = "255" // White
+
+	// Focus highlight (bright white border)
+	FocusedBorderColor = "15"
 )
 
 // GetToastStyle returns themed style for toast type
@@ -61,7 +64,7 @@ func GetToastStyle(toastType ToastType, width int) lipgloss.Style {
 			BorderForeground(lipgloss.Color(ErrorBorderColor)).
 			Foreground(lipgloss.Color(ErrorTextColor))
 
-	case ToastLoading:
+	case ToastLoading, ToastProgress:
 		return base.
 			BorderForeground(lipgloss.Color(LoadingBorderColor)).
 			Foreground(lipgloss.Color(LoadingTextColor))
@@ -130,11 +133,12 @@ func GetActionStyle() lipgloss.Style {
 
 // ToastIcons maps toast types to their default icons
 var ToastIcons = map[ToastType]string{
-	ToastInfo:    "ℹ",
-	ToastSuccess: "✓",
-	ToastWarning: "⚠",
-	ToastError:   "✗",
-	ToastLoading: "⟳",
+	ToastInfo:     "ℹ",
+	ToastSuccess:  "✓",
+	ToastWarning:  "⚠",
+	ToastError:    "✗",
+	ToastLoading:  "⟳",
+	ToastProgress: "⇣",
 }
 
 // GetDefaultIcon returns the default icon for a toast type
@@ -2,6 +2,7 @@ package toast
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/AINative-studio/ainative-code/internal/tui/components"
@@ -18,8 +19,13 @@ const (
 	ToastWarning
 	ToastError
 	ToastLoading
+	ToastProgress
 )
 
+// completionDismissDelay is how long a completed progress toast stays
+// visible before auto-dismissing
+const completionDismissDelay = 750 * time.Millisecond
+
 // String returns the string representation of a ToastType
 func (t ToastType) String() string {
 	switch t {
@@ -33,6 +39,8 @@ func (t ToastType) String() string {
 		return "Error"
 	case ToastLoading:
 		return "Loading"
+	case ToastProgress:
+		return "Progress"
 	default:
 		return "Unknown"
 	}
@@ -101,6 +109,8 @@ func DefaultToastConfig(toastType ToastType) ToastConfig {
 		duration = 10 * time.Second
 	case ToastLoading:
 		duration = 0 // Manual dismiss
+	case ToastProgress:
+		duration = 0 // Dismisses when progress reaches 1.0
 	}
 
 	return ToastConfig{
@@ -118,11 +128,14 @@ type Toast struct {
 	config      ToastConfig
 	createdAt   time.Time
 	expiresAt   time.Time
+	paused      bool      // Whether the expiration timer is paused
+	pausedAt    time.Time // When the timer was paused
 	progress    float64 // For loading toasts
 	dismissed   bool
 	animation   *components.AnimatedComponent // Fade in/out animation
 	opacity     float64                       // Current opacity (0.0 to 1.0)
 	isFadingOut bool                          // Whether toast is fading out
+	focused     bool                          // Whether toast has keyboard focus
 	width       int                           // Toast width
 	height      int                           // Toast height (calculated)
 }
@@ -178,9 +191,69 @@ func (t *Toast) IsExpired() bool {
 		return false // Manual dismiss only
 	}
 
+	if t.paused {
+		return false // Timer is paused
+	}
+
 	return time.Now().After(t.expiresAt)
 }
 
+// IsPaused returns true if the expiration timer is paused
+func (t *Toast) IsPaused() bool {
+	return t.paused
+}
+
+// Remaining returns the time left before the toast expires. It returns 0
+// for manual-dismiss toasts (duration 0) and for dismissed toasts.
+func (t *Toast) Remaining() time.Duration {
+	if t.config.Duration == 0 || t.dismissed {
+		return 0
+	}
+
+	reference := time.Now()
+	if t.paused {
+		reference = t.pausedAt
+	}
+
+	remaining := t.expiresAt.Sub(reference)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// Pause suspends the expiration timer, keeping the toast visible until
+// Resume is called. Loading toasts (duration 0) are unaffected.
+func (t *Toast) Pause() {
+	if t.paused || t.dismissed || t.config.Duration == 0 {
+		return
+	}
+
+	t.paused = true
+	t.pausedAt = time.Now()
+}
+
+// Resume restarts the expiration timer, extending the deadline by however
+// long the toast was paused so the remaining duration is preserved.
+func (t *Toast) Resume() {
+	if !t.paused {
+		return
+	}
+
+	t.expiresAt = t.expiresAt.Add(time.Since(t.pausedAt))
+	t.paused = false
+}
+
+// SetFocused sets whether the toast has keyboard focus
+func (t *Toast) SetFocused(focused bool) {
+	t.focused = focused
+}
+
+// IsFocused returns true if the toast has keyboard focus
+func (t *Toast) IsFocused() bool {
+	return t.focused
+}
+
 // IsDismissed returns true if the toast has been dismissed
 func (t *Toast) IsDismissed() bool {
 	return t.dismissed
@@ -238,6 +311,32 @@ func (t *Toast) GetHeight() int {
 	return lines
 }
 
+// SetProgress updates a progress toast's completion fraction (0.0 to 1.0).
+// Reaching 1.0 schedules an auto-dismiss after a short delay.
+func (t *Toast) SetProgress(fraction float64) {
+	if t.config.Type != ToastProgress || t.dismissed {
+		return
+	}
+
+	if fraction < 0 {
+		fraction = 0
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+	t.progress = fraction
+
+	if fraction >= 1 && t.config.Duration == 0 {
+		t.config.Duration = completionDismissDelay
+		t.expiresAt = time.Now().Add(completionDismissDelay)
+	}
+}
+
+// GetProgress returns the current progress fraction
+func (t *Toast) GetProgress() float64 {
+	return t.progress
+}
+
 // SetValue directly sets the toast opacity value
 func (t *Toast) SetValue(value float64) {
 	t.opacity = value
@@ -285,6 +384,11 @@ func (t *Toast) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, t.tick())
 		}
 
+		// Keep ticking progress toasts so completion can auto-dismiss
+		if t.config.Type == ToastProgress && !t.dismissed {
+			cmds = append(cmds, t.tick())
+		}
+
 		// Check expiration
 		if t.IsExpired() && !t.isFadingOut {
 			cmd := t.Dismiss()
@@ -315,8 +419,8 @@ func (t *Toast) Init() tea.Cmd {
 	// Start fade in animation
 	cmds = append(cmds, t.StartFadeIn())
 
-	// Start tick for loading toasts and expiration checks
-	if t.config.Type == ToastLoading || t.config.Duration > 0 {
+	// Start tick for loading/progress toasts and expiration checks
+	if t.config.Type == ToastLoading || t.config.Type == ToastProgress || t.config.Duration > 0 {
 		cmds = append(cmds, t.tick())
 	}
 
@@ -337,6 +441,11 @@ func (t *Toast) View() string {
 		style = applyOpacity(style, t.opacity)
 	}
 
+	// Highlight the border when the toast has keyboard focus
+	if t.focused {
+		style = style.BorderForeground(lipgloss.Color(FocusedBorderColor))
+	}
+
 	// Build toast content
 	var content string
 
@@ -359,6 +468,11 @@ func (t *Toast) View() string {
 		content = fmt.Sprintf("%s %s", spinner, t.config.Message)
 	}
 
+	// Add progress bar for determinate progress toasts
+	if t.config.Type == ToastProgress {
+		content = fmt.Sprintf("%s %s\n%s", icon, t.config.Message, t.renderProgressBar())
+	}
+
 	// Add action button if present
 	if t.config.Action != nil {
 		actionStyle := lipgloss.NewStyle().
@@ -379,6 +493,24 @@ func (t *Toast) View() string {
 	return style.Render(content)
 }
 
+// renderProgressBar renders a bar sized to the toast width with a
+// trailing percentage
+func (t *Toast) renderProgressBar() string {
+	// Leave room for borders, padding, and the percentage label
+	barWidth := t.width - 10
+	if barWidth < 4 {
+		barWidth = 4
+	}
+
+	filled := int(float64(barWidth)*t.progress + 0.5)
+	if filled > barWidth {
+		filled = barWidth
+	}
+
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", barWidth-filled)
+	return fmt.Sprintf("%s %3.0f%%", bar, t.progress*100)
+}
+
 // applyOpacity applies opacity to a style by adjusting colors
 func applyOpacity(style lipgloss.Style, opacity float64) lipgloss.Style {
 	// This is a simplified opacity implementation
@@ -2,6 +2,7 @@ package toast
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -526,10 +527,10 @@ func TestCalculateToastWidth(t *testing.T) {
 		screenWidth int
 		expected    int
 	}{
-		{40, 36},   // Small screen
-		{60, 40},   // Medium screen
-		{90, 50},   // Large screen
-		{130, 60},  // Extra large screen
+		{40, 36},  // Small screen
+		{60, 40},  // Medium screen
+		{90, 50},  // Large screen
+		{130, 60}, // Extra large screen
 	}
 
 	for _, tt := range tests {
@@ -547,10 +548,10 @@ func TestCalculateMaxToasts(t *testing.T) {
 		minExpected  int
 		maxExpected  int
 	}{
-		{10, 1, 1},   // Very small screen
-		{20, 1, 2},   // Small screen
-		{40, 2, 6},   // Medium screen
-		{80, 5, 14},  // Large screen
+		{10, 1, 1},  // Very small screen
+		{20, 1, 2},  // Small screen
+		{40, 2, 6},  // Medium screen
+		{80, 5, 14}, // Large screen
 	}
 
 	for _, tt := range tests {
@@ -1150,9 +1151,9 @@ func TestToastTickAfterDismissal(t *testing.T) {
 // TestToastTickTimerCreation tests tick timer creation
 func TestToastTickTimerCreation(t *testing.T) {
 	tests := []struct {
-		name     string
-		toastType ToastType
-		duration time.Duration
+		name       string
+		toastType  ToastType
+		duration   time.Duration
 		shouldTick bool
 	}{
 		{"Loading toast", ToastLoading, 0, true},
@@ -1343,10 +1344,10 @@ func TestToastViewAtDifferentOpacities(t *testing.T) {
 		opacity      float64
 		shouldRender bool
 	}{
-		{0.0, false},  // Invisible
-		{0.1, true},   // Barely visible
-		{0.5, true},   // Semi-transparent
-		{1.0, true},   // Fully visible
+		{0.0, false}, // Invisible
+		{0.1, true},  // Barely visible
+		{0.5, true},  // Semi-transparent
+		{1.0, true},  // Fully visible
 	}
 
 	for _, tt := range tests {
@@ -2205,3 +2206,510 @@ func TestToastManagerSetMaxToastsEdgeCases(t *testing.T) {
 	// Existing toasts are not automatically removed
 	// (they would be removed through normal expiration/dismissal)
 }
+
+// TestToastPauseResume tests that pause/resume preserves remaining time
+func TestToastPauseResume(t *testing.T) {
+	config := DefaultToastConfig(ToastError)
+	config.Message = "Long error message"
+	config.Duration = 3 * time.Second
+
+	toast := NewToast(config)
+
+	before := toast.Remaining()
+	if before <= 0 {
+		t.Fatal("Expected positive remaining time")
+	}
+
+	toast.Pause()
+	if !toast.IsPaused() {
+		t.Error("Toast should be paused")
+	}
+
+	// Remaining time should not tick down while paused
+	time.Sleep(50 * time.Millisecond)
+	paused := toast.Remaining()
+	if before-paused > 20*time.Millisecond {
+		t.Errorf("Remaining time dropped while paused: before=%v after=%v", before, paused)
+	}
+
+	toast.Resume()
+	if toast.IsPaused() {
+		t.Error("Toast should not be paused after Resume")
+	}
+
+	resumed := toast.Remaining()
+	if before-resumed > 20*time.Millisecond {
+		t.Errorf("Remaining time not preserved across pause/resume: before=%v after=%v", before, resumed)
+	}
+}
+
+// TestToastPausePreventsExpiration tests that paused toasts don't expire
+func TestToastPausePreventsExpiration(t *testing.T) {
+	config := DefaultToastConfig(ToastInfo)
+	config.Message = "Test"
+	config.Duration = 30 * time.Millisecond
+
+	toast := NewToast(config)
+	toast.Pause()
+
+	// Wait past the original deadline
+	time.Sleep(50 * time.Millisecond)
+
+	if toast.IsExpired() {
+		t.Error("Paused toast should not expire")
+	}
+
+	toast.Resume()
+	if toast.IsExpired() {
+		t.Error("Toast should not be expired immediately after resume")
+	}
+	if toast.Remaining() <= 0 {
+		t.Error("Expected remaining time after resume")
+	}
+}
+
+// TestLoadingToastUnaffectedByPause tests that duration-0 toasts ignore Pause
+func TestLoadingToastUnaffectedByPause(t *testing.T) {
+	config := DefaultToastConfig(ToastLoading)
+	config.Message = "Loading..."
+
+	toast := NewToast(config)
+	toast.Pause()
+
+	if toast.IsPaused() {
+		t.Error("Loading toast should not be pausable")
+	}
+	if toast.Remaining() != 0 {
+		t.Errorf("Loading toast should report zero remaining, got %v", toast.Remaining())
+	}
+	if toast.IsExpired() {
+		t.Error("Loading toast should never expire")
+	}
+}
+
+// TestDismissedToastIgnoresPause tests that dismissed toasts ignore Pause
+func TestDismissedToastIgnoresPause(t *testing.T) {
+	config := DefaultToastConfig(ToastInfo)
+	config.Message = "Test"
+
+	toast := NewToast(config)
+	toast.Dismiss()
+	toast.Pause()
+
+	if toast.IsPaused() {
+		t.Error("Dismissed toast should not be pausable")
+	}
+}
+
+// TestManagerPausesOnBlur tests that the manager pauses toasts on blur
+// and resumes them on focus
+func TestManagerPausesOnBlur(t *testing.T) {
+	manager := NewToastManager()
+	manager.ShowError("Something went wrong")
+	manager.ShowLoading("Working...")
+
+	toasts := manager.GetToasts()
+	if len(toasts) != 2 {
+		t.Fatalf("Expected 2 toasts, got %d", len(toasts))
+	}
+
+	manager.Update(tea.BlurMsg{})
+
+	if !toasts[0].IsPaused() {
+		t.Error("Error toast should be paused after blur")
+	}
+	if toasts[1].IsPaused() {
+		t.Error("Loading toast should not be paused after blur")
+	}
+
+	manager.Update(tea.FocusMsg{})
+
+	if toasts[0].IsPaused() {
+		t.Error("Error toast should be resumed after focus")
+	}
+}
+
+// TestToastHistory tests that shown toasts are recorded in history
+func TestToastHistory(t *testing.T) {
+	manager := NewToastManager()
+
+	manager.ShowError("first error")
+	manager.ShowSuccess("done")
+	manager.ShowInfo("heads up")
+	manager.ShowInfo("queued message") // Over maxToasts, goes to queue
+
+	history := manager.History()
+	if len(history) != 4 {
+		t.Fatalf("Expected 4 history entries, got %d", len(history))
+	}
+
+	if history[0].Type != ToastError || history[0].Message != "first error" {
+		t.Errorf("Unexpected first entry: %+v", history[0])
+	}
+	if history[0].Timestamp.IsZero() {
+		t.Error("History entry should have a timestamp")
+	}
+
+	// Queued toasts are recorded even though they are not yet visible
+	if history[3].Message != "queued message" {
+		t.Errorf("Expected queued toast in history, got %+v", history[3])
+	}
+	if manager.GetQueueLength() != 1 {
+		t.Errorf("Expected 1 queued toast, got %d", manager.GetQueueLength())
+	}
+}
+
+// TestToastHistoryBounded tests that the history evicts oldest entries
+func TestToastHistoryBounded(t *testing.T) {
+	manager := NewToastManager()
+	manager.SetHistorySize(3)
+
+	for i := 0; i < 5; i++ {
+		manager.ShowInfo(fmt.Sprintf("message %d", i))
+	}
+
+	history := manager.History()
+	if len(history) != 3 {
+		t.Fatalf("Expected 3 history entries, got %d", len(history))
+	}
+	if history[0].Message != "message 2" {
+		t.Errorf("Expected oldest entries evicted, got %q first", history[0].Message)
+	}
+	if history[2].Message != "message 4" {
+		t.Errorf("Expected newest entry last, got %q", history[2].Message)
+	}
+}
+
+// TestToastHistoryShrink tests that shrinking the size evicts old entries
+func TestToastHistoryShrink(t *testing.T) {
+	manager := NewToastManager()
+
+	for i := 0; i < 4; i++ {
+		manager.ShowInfo(fmt.Sprintf("message %d", i))
+	}
+
+	manager.SetHistorySize(2)
+	history := manager.History()
+	if len(history) != 2 {
+		t.Fatalf("Expected 2 history entries after shrink, got %d", len(history))
+	}
+	if history[0].Message != "message 2" {
+		t.Errorf("Expected oldest entries evicted, got %q first", history[0].Message)
+	}
+}
+
+// TestClearHistory tests clearing the history
+func TestClearHistory(t *testing.T) {
+	manager := NewToastManager()
+	manager.ShowInfo("message")
+
+	manager.ClearHistory()
+	if len(manager.History()) != 0 {
+		t.Error("Expected empty history after ClearHistory")
+	}
+
+	// History keeps recording after a clear
+	manager.ShowInfo("another")
+	if len(manager.History()) != 1 {
+		t.Error("Expected history to keep recording after clear")
+	}
+}
+
+// TestHistoryCopyIsolation tests that History returns a copy
+func TestHistoryCopyIsolation(t *testing.T) {
+	manager := NewToastManager()
+	manager.ShowInfo("message")
+
+	history := manager.History()
+	history[0].Message = "mutated"
+
+	if manager.History()[0].Message != "message" {
+		t.Error("Mutating the returned slice should not affect the manager")
+	}
+}
+
+// TestProgressToastRendering tests the progress bar at various fractions
+func TestProgressToastRendering(t *testing.T) {
+	tests := []struct {
+		name     string
+		fraction float64
+		want     string
+	}{
+		{"empty bar", 0.0, "  0%"},
+		{"half bar", 0.5, " 50%"},
+		{"full bar", 1.0, "100%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultToastConfig(ToastProgress)
+			config.Message = "Uploading"
+
+			toast := NewToast(config)
+			toast.SetValue(1.0) // Fully faded in
+			toast.SetProgress(tt.fraction)
+
+			view := toast.View()
+			if !strings.Contains(view, tt.want) {
+				t.Errorf("Expected view to contain %q:\n%s", tt.want, view)
+			}
+			if tt.fraction > 0 && !strings.Contains(view, "█") {
+				t.Error("Expected filled bar segment")
+			}
+			if tt.fraction < 1 && !strings.Contains(view, "░") {
+				t.Error("Expected unfilled bar segment")
+			}
+			if tt.fraction == 0 && strings.Contains(view, "█") {
+				t.Error("Empty bar should have no filled segments")
+			}
+		})
+	}
+}
+
+// TestSetProgressClampsAndCompletes tests clamping and completion dismiss
+func TestSetProgressClampsAndCompletes(t *testing.T) {
+	config := DefaultToastConfig(ToastProgress)
+	config.Message = "Uploading"
+
+	toast := NewToast(config)
+
+	toast.SetProgress(-0.5)
+	if toast.GetProgress() != 0 {
+		t.Errorf("Expected progress clamped to 0, got %f", toast.GetProgress())
+	}
+
+	toast.SetProgress(0.5)
+	if toast.IsExpired() {
+		t.Error("In-progress toast should not expire")
+	}
+
+	toast.SetProgress(1.5)
+	if toast.GetProgress() != 1.0 {
+		t.Errorf("Expected progress clamped to 1.0, got %f", toast.GetProgress())
+	}
+
+	// Completion schedules an auto-dismiss after a short delay
+	if toast.IsExpired() {
+		t.Error("Completed toast should not expire before the dismiss delay")
+	}
+	if toast.Remaining() <= 0 {
+		t.Error("Expected a pending dismiss deadline after completion")
+	}
+}
+
+// TestManagerShowProgress tests driving a progress toast through the manager
+func TestManagerShowProgress(t *testing.T) {
+	manager := NewToastManager()
+
+	id, cmd := manager.ShowProgress("Uploading file")
+	if id == "" {
+		t.Fatal("Expected a toast ID")
+	}
+	if cmd == nil {
+		t.Error("Expected an init command for a visible toast")
+	}
+
+	manager.SetProgress(id, 0.5)
+
+	toasts := manager.GetToasts()
+	if len(toasts) != 1 {
+		t.Fatalf("Expected 1 toast, got %d", len(toasts))
+	}
+	if toasts[0].GetProgress() != 0.5 {
+		t.Errorf("Expected progress 0.5, got %f", toasts[0].GetProgress())
+	}
+}
+
+// runToastCmd executes a command (recursing into batches) so tests can
+// observe the messages it produces
+func runToastCmd(cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, c := range batch {
+			runToastCmd(c)
+		}
+	}
+}
+
+// TestToastManagerFocusCycling tests Tab focus cycling with wrap-around
+func TestToastManagerFocusCycling(t *testing.T) {
+	manager := NewToastManager()
+
+	for i := 0; i < 3; i++ {
+		config := DefaultToastConfig(ToastInfo)
+		config.Message = fmt.Sprintf("toast %d", i)
+		manager.ShowToast(config)
+	}
+
+	if manager.FocusedToast() != nil {
+		t.Error("No toast should be focused initially")
+	}
+
+	tab := tea.KeyMsg{Type: tea.KeyTab}
+
+	manager.Update(tab)
+	if manager.FocusedToast() != manager.GetToasts()[0] {
+		t.Error("First Tab should focus the first toast")
+	}
+	if !manager.GetToasts()[0].IsFocused() {
+		t.Error("Focused toast should report IsFocused")
+	}
+
+	manager.Update(tab)
+	manager.Update(tab)
+	if manager.FocusedToast() != manager.GetToasts()[2] {
+		t.Error("Focus should advance to the third toast")
+	}
+
+	// Focus wraps back to the first toast
+	manager.Update(tab)
+	if manager.FocusedToast() != manager.GetToasts()[0] {
+		t.Error("Focus should wrap around to the first toast")
+	}
+}
+
+// TestToastManagerFocusSkipsNonFocusable tests that focus only lands on
+// dismissible or actionable toasts
+func TestToastManagerFocusSkipsNonFocusable(t *testing.T) {
+	manager := NewToastManager()
+
+	blocked := DefaultToastConfig(ToastLoading)
+	blocked.Message = "working..."
+	blocked.Dismissible = false
+	manager.ShowToast(blocked)
+
+	dismissible := DefaultToastConfig(ToastInfo)
+	dismissible.Message = "done"
+	manager.ShowToast(dismissible)
+
+	manager.FocusNext()
+	if manager.FocusedToast() != manager.GetToasts()[1] {
+		t.Error("Focus should skip the non-dismissible toast")
+	}
+}
+
+// TestToastManagerEnterFiresAction tests that Enter triggers the focused
+// toast's action and dismisses it
+func TestToastManagerEnterFiresAction(t *testing.T) {
+	manager := NewToastManager()
+
+	fired := false
+	config := DefaultToastConfig(ToastInfo)
+	config.Message = "undo available"
+	config.Action = &ToastAction{
+		Label:   "Undo",
+		Command: func() tea.Msg { fired = true; return nil },
+	}
+	manager.ShowToast(config)
+
+	manager.Update(tea.KeyMsg{Type: tea.KeyTab})
+	toast := manager.FocusedToast()
+	if toast == nil {
+		t.Fatal("Toast should be focused after Tab")
+	}
+
+	_, cmd := manager.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	runToastCmd(cmd)
+
+	if !fired {
+		t.Error("Enter should fire the focused toast's action command")
+	}
+	if !toast.IsDismissed() {
+		t.Error("Toast should be dismissed after its action fires")
+	}
+	if manager.FocusedToast() != nil {
+		t.Error("Focus should be cleared after activation")
+	}
+}
+
+// TestToastManagerEscDismissesFocused tests that Esc dismisses the
+// focused toast without firing its action
+func TestToastManagerEscDismissesFocused(t *testing.T) {
+	manager := NewToastManager()
+
+	fired := false
+	config := DefaultToastConfig(ToastInfo)
+	config.Message = "undo available"
+	config.Action = &ToastAction{
+		Label:   "Undo",
+		Command: func() tea.Msg { fired = true; return nil },
+	}
+	manager.ShowToast(config)
+
+	manager.Update(tea.KeyMsg{Type: tea.KeyTab})
+	toast := manager.FocusedToast()
+
+	_, cmd := manager.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	runToastCmd(cmd)
+
+	if fired {
+		t.Error("Esc should not fire the action command")
+	}
+	if !toast.IsDismissed() {
+		t.Error("Esc should dismiss the focused toast")
+	}
+	if manager.FocusedToast() != nil {
+		t.Error("Focus should be cleared after dismissal")
+	}
+}
+
+// TestToastManagerFocusIndexAfterRemoval tests that removing a toast
+// keeps focus on the same toast
+func TestToastManagerFocusIndexAfterRemoval(t *testing.T) {
+	manager := NewToastManager()
+
+	for i := 0; i < 3; i++ {
+		config := DefaultToastConfig(ToastInfo)
+		config.Message = fmt.Sprintf("toast %d", i)
+		manager.ShowToast(config)
+	}
+
+	// Focus the second toast, then remove the first
+	manager.FocusNext()
+	manager.FocusNext()
+	focused := manager.FocusedToast()
+
+	manager.RemoveToast(manager.GetToasts()[0].ID())
+
+	if manager.FocusedToast() != focused {
+		t.Error("Focus should follow the toast after an earlier one is removed")
+	}
+
+	// Removing the focused toast clears focus
+	manager.RemoveToast(focused.ID())
+	if manager.FocusedToast() != nil {
+		t.Error("Removing the focused toast should clear focus")
+	}
+}
+
+// TestToastManagerSetFocusKey tests focus key configuration
+func TestToastManagerSetFocusKey(t *testing.T) {
+	manager := NewToastManager()
+
+	if manager.GetFocusKey() != DefaultFocusKey {
+		t.Errorf("Expected default focus key %q, got %q", DefaultFocusKey, manager.GetFocusKey())
+	}
+
+	manager.SetFocusKey("ctrl+t")
+	if manager.GetFocusKey() != "ctrl+t" {
+		t.Error("SetFocusKey should update the focus key")
+	}
+
+	// Empty keys are ignored
+	manager.SetFocusKey("")
+	if manager.GetFocusKey() != "ctrl+t" {
+		t.Error("Empty focus key should be ignored")
+	}
+
+	config := DefaultToastConfig(ToastInfo)
+	config.Message = "hello"
+	manager.ShowToast(config)
+
+	manager.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	if manager.FocusedToast() == nil {
+		t.Error("Configured focus key should cycle focus")
+	}
+}